@@ -21,4 +21,17 @@ const (
 	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
 	// WaitingForBootstrapDataReason used when machine is waiting for bootstrap data to be ready before proceeding.
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+
+	// InstanceAdoptedCondition reports whether the pre-existing instance referenced by spec.providerID
+	// has been validated and adopted by the GCPMachine.
+	InstanceAdoptedCondition = "InstanceAdopted"
+	// InstanceAdoptedReason used when the instance referenced by spec.providerID has been adopted.
+	InstanceAdoptedReason = "InstanceAdopted"
+	// InstanceAdoptionNotFoundReason used when the instance referenced by spec.providerID does not exist.
+	InstanceAdoptionNotFoundReason = "InstanceAdoptionNotFound"
+	// InstanceAdoptionMismatchReason used when the instance referenced by spec.providerID does not match the GCPMachine spec.
+	InstanceAdoptionMismatchReason = "InstanceAdoptionMismatch"
+	// InstanceAdoptionAmbiguousReason used when the instance to adopt cannot be determined unambiguously,
+	// e.g. because it is already owned by another cluster.
+	InstanceAdoptionAmbiguousReason = "InstanceAdoptionAmbiguous"
 )
@@ -26,6 +26,14 @@ type ServiceEndpoints struct {
 	// +optional
 	ComputeServiceEndpoint string `json:"compute,omitempty"`
 
+	// ComputeBetaServiceEndpoint is the custom endpoint url for the beta Compute Service, used by the features
+	// only available in the beta API. It is required to use these features when ComputeServiceEndpoint is set.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=uri
+	// +kubebuilder:validation:Pattern=`^https://`
+	// +optional
+	ComputeBetaServiceEndpoint string `json:"computeBeta,omitempty"`
+
 	// ContainerServiceEndpoint is the custom endpoint url for the Container Service
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Format=uri
@@ -34,6 +42,9 @@ type ServiceEndpoints struct {
 	ContainerServiceEndpoint string `json:"container,omitempty"`
 
 	// IAMServiceEndpoint is the custom endpoint url for the IAM Service
+	//
+	// Deprecated: the IAM Service isn't used anymore, the access tokens of the kubeconfigs are minted from the
+	// credentials of the GCP clients. This field is ignored and will be removed in a future API version.
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Format=uri
 	// +kubebuilder:validation:Pattern=`^https://`
@@ -452,6 +452,10 @@ type GCPMachineStatus struct {
 	// controller's output.
 	// +optional
 	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// Conditions defines current service state of the GCPMachine.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -485,3 +489,13 @@ type GCPMachineList struct {
 func init() {
 	SchemeBuilder.Register(&GCPMachine{}, &GCPMachineList{})
 }
+
+// GetConditions returns the observations of the operational state of the GCPMachine resource.
+func (r *GCPMachine) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the conditions of the GCPMachine resource.
+func (r *GCPMachine) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
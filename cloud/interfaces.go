@@ -30,6 +30,10 @@ import (
 // Cloud alias for cloud.Cloud interface.
 type Cloud = cloud.Cloud
 
+// ComputeService alias for cloud.Service, which exposes the raw compute API
+// for calls that are not covered by the Cloud interface.
+type ComputeService = cloud.Service
+
 // Reconciler is a generic interface used by components offering a type of service.
 type Reconciler interface {
 	Reconcile(ctx context.Context) error
@@ -46,6 +50,8 @@ type ReconcilerWithResult interface {
 type Client interface {
 	Cloud() Cloud
 	NetworkCloud() Cloud
+	ComputeService() *ComputeService
+	ComputeServiceWithBeta(ctx context.Context) (*ComputeService, error)
 }
 
 // ClusterGetter is an interface which can get cluster information.
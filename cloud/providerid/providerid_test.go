@@ -114,3 +114,65 @@ func TestProviderID_NewFromResourceURL(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderID_Parse(t *testing.T) {
+	RegisterTestingT(t)
+
+	testCases := []struct {
+		testname         string
+		providerID       string
+		expectedProject  string
+		expectedLocation string
+		expectedName     string
+		expectError      bool
+	}{
+		{
+			testname:    "empty provider id, should fail",
+			providerID:  "",
+			expectError: true,
+		},
+		{
+			testname:    "wrong prefix, should fail",
+			providerID:  "aws://proj1/eu-west4/vm1",
+			expectError: true,
+		},
+		{
+			testname:    "missing name, should fail",
+			providerID:  "gce://proj1/eu-west4",
+			expectError: true,
+		},
+		{
+			testname:    "empty segment, should fail",
+			providerID:  "gce://proj1//vm1",
+			expectError: true,
+		},
+		{
+			testname:    "too many segments, should fail",
+			providerID:  "gce://proj1/eu-west4/vm1/extra",
+			expectError: true,
+		},
+		{
+			testname:         "valid provider id, should pass",
+			providerID:       "gce://proj1/eu-west4-a/vm1",
+			expectError:      false,
+			expectedProject:  "proj1",
+			expectedLocation: "eu-west4-a",
+			expectedName:     "vm1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testname, func(_ *testing.T) {
+			providerID, err := providerid.Parse(tc.providerID)
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(providerID.Project()).To(Equal(tc.expectedProject))
+				Expect(providerID.Location()).To(Equal(tc.expectedLocation))
+				Expect(providerID.Name()).To(Equal(tc.expectedName))
+				Expect(providerID.String()).To(Equal(tc.providerID))
+			}
+		})
+	}
+}
@@ -24,21 +24,30 @@ import (
 
 	computerest "cloud.google.com/go/compute/apiv1"
 	container "cloud.google.com/go/container/apiv1"
-	credentials "cloud.google.com/go/iam/credentials/apiv1"
 	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	computebeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/gkehub/v1"
 	"google.golang.org/api/option"
+	"google.golang.org/api/transport"
 	"k8s.io/client-go/pkg/version"
 	"k8s.io/client-go/util/flowcontrol"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// cloudPlatformScope is the OAuth2 scope of the access tokens used to authenticate to the GKE clusters.
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
 // GCPServices contains all the gcp services used by the scopes.
 type GCPServices struct {
 	Compute *compute.Service
+	// ComputeBeta is used for the few features only available in the beta compute API.
+	// It is only created when one of these features is used, see ComputeServiceWithBeta.
+	ComputeBeta *computebeta.Service
 }
 
 // GCPRateLimiter implements cloud.RateLimiter.
@@ -73,11 +82,16 @@ func (rl *GCPRateLimiter) Observe(context.Context, error, *cloud.RateLimitKey) {
 }
 
 func newCloud(project string, service GCPServices) cloud.Cloud {
-	return cloud.NewGCE(&cloud.Service{
+	return cloud.NewGCE(newCloudService(project, service))
+}
+
+func newCloudService(project string, service GCPServices) *cloud.Service {
+	return &cloud.Service{
 		GA:            service.Compute,
+		Beta:          service.ComputeBeta,
 		ProjectRouter: &cloud.SingleProjectRouter{ID: project},
 		RateLimiter:   &GCPRateLimiter{},
-	})
+	}
 }
 
 func defaultClientOptions(ctx context.Context, credentialsRef *infrav1.ObjectReference, crClient client.Client) ([]option.ClientOption, error) {
@@ -129,6 +143,30 @@ func newComputeService(ctx context.Context, credentialsRef *infrav1.ObjectRefere
 	return computeSvc, nil
 }
 
+func newComputeBetaService(ctx context.Context, credentialsRef *infrav1.ObjectReference, crClient client.Client, endpoints *infrav1.ServiceEndpoints) (*computebeta.Service, error) {
+	opts, err := defaultClientOptions(ctx, credentialsRef, crClient)
+	if err != nil {
+		return nil, fmt.Errorf("getting default gcp client options: %w", err)
+	}
+
+	if endpoints != nil {
+		switch {
+		case endpoints.ComputeBetaServiceEndpoint != "":
+			opts = append(opts, option.WithEndpoint(endpoints.ComputeBetaServiceEndpoint))
+		case endpoints.ComputeServiceEndpoint != "":
+			// The beta API would otherwise bypass the custom endpoint of the GA API.
+			return nil, errors.New("serviceEndpoints.computeBeta must be set when serviceEndpoints.compute is set")
+		}
+	}
+
+	computeSvc, err := computebeta.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating new compute beta service instance: %w", err)
+	}
+
+	return computeSvc, nil
+}
+
 func newClusterManagerClient(ctx context.Context, credentialsRef *infrav1.ObjectReference, crClient client.Client, endpoints *infrav1.ServiceEndpoints) (*container.ClusterManagerClient, error) {
 	opts, err := defaultClientOptions(ctx, credentialsRef, crClient)
 	if err != nil {
@@ -147,22 +185,53 @@ func newClusterManagerClient(ctx context.Context, credentialsRef *infrav1.Object
 	return managedClusterClient, nil
 }
 
-func newIamCredentialsClient(ctx context.Context, credentialsRef *infrav1.ObjectReference, crClient client.Client, endpoints *infrav1.ServiceEndpoints) (*credentials.IamCredentialsClient, error) {
+// newGKEHubService returns a client of the GKE Hub API, which manages the fleet memberships of the GKE clusters.
+func newGKEHubService(ctx context.Context, credentialsRef *infrav1.ObjectReference, crClient client.Client) (*gkehub.Service, error) {
+	opts, err := defaultClientOptions(ctx, credentialsRef, crClient)
+	if err != nil {
+		return nil, fmt.Errorf("getting default gcp client options: %w", err)
+	}
+
+	gkeHubSvc, err := gkehub.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating new gke hub service instance: %w", err)
+	}
+
+	return gkeHubSvc, nil
+}
+
+// newTokenSource returns a source of access tokens for the same credentials as the GCP clients, so that external
+// account and workload identity credentials are supported too.
+func newTokenSource(ctx context.Context, credentialsRef *infrav1.ObjectReference, crClient client.Client) (oauth2.TokenSource, error) {
 	opts, err := defaultClientOptions(ctx, credentialsRef, crClient)
 	if err != nil {
 		return nil, fmt.Errorf("getting default gcp client options: %w", err)
 	}
+	opts = append(opts, option.WithScopes(cloudPlatformScope))
 
-	if endpoints != nil && endpoints.IAMServiceEndpoint != "" {
-		opts = append(opts, option.WithEndpoint(endpoints.IAMServiceEndpoint))
+	// Fail early on invalid credentials.
+	if _, err := transport.Creds(ctx, opts...); err != nil {
+		return nil, errors.Errorf("failed to get gcp credentials: %v", err)
 	}
 
-	credentialsClient, err := credentials.NewIamCredentialsClient(ctx, opts...)
+	return &freshTokenSource{ctx: ctx, opts: opts}, nil
+}
+
+// freshTokenSource loads the credentials again for every token, so that the tokens embedded in kubeconfigs aren't
+// cached tokens which are about to expire.
+type freshTokenSource struct {
+	ctx  context.Context
+	opts []option.ClientOption
+}
+
+// Token returns a new access token.
+func (s *freshTokenSource) Token() (*oauth2.Token, error) {
+	creds, err := transport.Creds(s.ctx, s.opts...)
 	if err != nil {
-		return nil, errors.Errorf("failed to create gcp ciam credentials client: %v", err)
+		return nil, errors.Errorf("failed to get gcp credentials: %v", err)
 	}
 
-	return credentialsClient, nil
+	return creds.TokenSource.Token()
 }
 
 func newInstanceGroupManagerClient(ctx context.Context, credentialsRef *infrav1.ObjectReference, crClient client.Client, endpoints *infrav1.ServiceEndpoints) (*computerest.InstanceGroupManagersClient, error) {
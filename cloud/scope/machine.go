@@ -36,6 +36,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/providerid"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/shared"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -103,6 +104,16 @@ func (m *MachineScope) NetworkCloud() cloud.Cloud {
 	return m.ClusterGetter.NetworkCloud()
 }
 
+// ComputeService returns the compute service backing Cloud.
+func (m *MachineScope) ComputeService() *cloud.ComputeService {
+	return m.ClusterGetter.ComputeService()
+}
+
+// ComputeServiceWithBeta returns the raw compute API including the beta API.
+func (m *MachineScope) ComputeServiceWithBeta(ctx context.Context) (*cloud.ComputeService, error) {
+	return m.ClusterGetter.ComputeServiceWithBeta(ctx)
+}
+
 // Zone returns the FailureDomain for the GCPMachine.
 func (m *MachineScope) Zone() string {
 	if m.Machine.Spec.FailureDomain == "" {
@@ -131,6 +142,11 @@ func (m *MachineScope) Namespace() string {
 	return m.GCPMachine.Namespace
 }
 
+// ClusterName returns the cluster name.
+func (m *MachineScope) ClusterName() string {
+	return m.ClusterGetter.Name()
+}
+
 // ControlPlaneGroupName returns the control-plane instance group name.
 func (m *MachineScope) ControlPlaneGroupName() string {
 	tag := ptr.Deref(m.ClusterGetter.LoadBalancer().APIServerInstanceGroupTagOverride, infrav1.APIServerRoleTagValue)
@@ -224,6 +240,11 @@ func (m *MachineScope) SetAddresses(addressList []corev1.NodeAddress) {
 	m.GCPMachine.Status.Addresses = addressList
 }
 
+// ConditionSetter returns a condition setter (which is GCPMachine itself).
+func (m *MachineScope) ConditionSetter() conditions.Setter {
+	return m.GCPMachine
+}
+
 // ANCHOR_END: MachineSetter
 
 // ANCHOR: MachineInstanceSpec
@@ -543,7 +564,12 @@ func GetBootstrapData(ctx context.Context, client client.Client, parent client.O
 
 // PatchObject persists the cluster configuration and status.
 func (m *MachineScope) PatchObject() error {
-	return m.patchHelper.Patch(context.TODO(), m.GCPMachine)
+	return m.patchHelper.Patch(
+		context.TODO(),
+		m.GCPMachine,
+		patch.WithOwnedConditions{Conditions: []string{
+			infrav1.InstanceAdoptedCondition,
+		}})
 }
 
 // Close closes the current scope persisting the cluster configuration and status.
@@ -20,12 +20,16 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"github.com/pkg/errors"
 	"golang.org/x/mod/semver"
+	computebeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,6 +43,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// machinePoolTemplateMetadataKey is the instance metadata key holding the name of the GCPMachinePoolTemplate
+// the GCPMachinePool is cloned from.
+const machinePoolTemplateMetadataKey = "capg-machine-pool-template"
+
 // MachinePoolScope defines a scope defined around a machine and its cluster.
 type MachinePoolScope struct {
 	client                     client.Client
@@ -100,6 +108,16 @@ func (m *MachinePoolScope) Cloud() cloud.Cloud {
 	return m.ClusterGetter.Cloud()
 }
 
+// ComputeService returns the raw compute API for calls that are not covered by Cloud.
+func (m *MachinePoolScope) ComputeService() *cloud.ComputeService {
+	return m.ClusterGetter.ComputeService()
+}
+
+// ComputeServiceWithBeta returns the raw compute API including the beta API.
+func (m *MachinePoolScope) ComputeServiceWithBeta(ctx context.Context) (*cloud.ComputeService, error) {
+	return m.ClusterGetter.ComputeServiceWithBeta(ctx)
+}
+
 // Name returns the GCPMachinePool name.
 func (m *MachinePoolScope) Name() string {
 	return m.GCPMachinePool.Name
@@ -142,6 +160,8 @@ func (m *MachinePoolScope) PatchObject(ctx context.Context) error {
 		patch.WithOwnedConditions{Conditions: []string{
 			string(expinfrav1.MIGReadyCondition),
 			string(expinfrav1.InstanceTemplateReadyCondition),
+			string(expinfrav1.MIGUpToDateCondition),
+			string(expinfrav1.AutoscalerReadyCondition),
 		}})
 }
 
@@ -158,18 +178,56 @@ func (m *MachinePoolScope) Close() error {
 	return m.PatchObject(context.TODO())
 }
 
-// InstanceGroupManagerResourceName is the name to use for the instanceGroupManager GCP resource
+// InstanceGroupManagerResourceName is the name to use for the instanceGroupManager GCP resource.
+// A zonal instanceGroupManager is used when the machine pool targets a single zone, a regional one otherwise.
 func (m *MachinePoolScope) InstanceGroupManagerResourceName() (*meta.Key, error) {
 	igmName := m.ClusterName() + "-" + m.Name()
 
 	zones := m.Zones()
-	if len(zones) != 1 {
-		return nil, fmt.Errorf("instanceGroupManager must be created in a single zone, got %d zones (%s)", len(zones), strings.Join(zones, ","))
+	switch len(zones) {
+	case 0:
+		return nil, errors.New("must specify at least one zone")
+	case 1:
+		return meta.ZonalKey(igmName, zones[0]), nil
+	default:
+		return meta.RegionalKey(igmName, m.Region()), nil
+	}
+}
+
+// PreviousInstanceGroupManagerResourceName returns the key of the instanceGroupManager recorded in the status when it
+// differs from the current one, which happens when the machine pool moves between a single zone and several zones.
+// It returns nil otherwise.
+func (m *MachinePoolScope) PreviousInstanceGroupManagerResourceName() (*meta.Key, error) {
+	recorded := m.GCPMachinePool.Status.InstanceGroupManager
+	if recorded == "" {
+		return nil, nil
+	}
+
+	current, err := m.InstanceGroupManagerResourceName()
+	if err != nil {
+		return nil, err
+	}
+	if recorded == gcp.SelfLink("instanceGroupManagers", current) {
+		return nil, nil
+	}
+
+	parts := strings.Split(recorded, "/")
+	if len(parts) != 4 || parts[2] != "instanceGroupManagers" {
+		return nil, errors.Errorf("invalid instanceGroupManager %q in status", recorded)
 	}
-	zone := zones[0]
-	igmKey := meta.ZonalKey(igmName, zone)
+	switch parts[0] {
+	case "zones":
+		return meta.ZonalKey(parts[3], parts[1]), nil
+	case "regions":
+		return meta.RegionalKey(parts[3], parts[1]), nil
+	default:
+		return nil, errors.Errorf("invalid instanceGroupManager %q in status", recorded)
+	}
+}
 
-	return igmKey, nil
+// SetInstanceGroupManagerResourceName records the key of the current instanceGroupManager in the status.
+func (m *MachinePoolScope) SetInstanceGroupManagerResourceName(key *meta.Key) {
+	m.GCPMachinePool.Status.InstanceGroupManager = gcp.SelfLink("instanceGroupManagers", key)
 }
 
 // InstanceGroupManagerResource is the desired state for the instanceGroupManager GCP resource
@@ -186,6 +244,10 @@ func (m *MachinePoolScope) InstanceGroupManagerResource(instanceTemplate *meta.K
 	if p := m.MachinePool.Spec.Replicas; p != nil {
 		replicas = int64(*p)
 	}
+	// The autoscaler owns the target size, the initial size only needs to be within its bounds.
+	if autoscaling := m.GCPMachinePool.Spec.Autoscaling; autoscaling != nil {
+		replicas = max(int64(autoscaling.MinReplicas), min(replicas, int64(autoscaling.MaxReplicas)))
+	}
 
 	desired := &compute.InstanceGroupManager{
 		BaseInstanceName: baseInstanceName,
@@ -194,9 +256,22 @@ func (m *MachinePoolScope) InstanceGroupManagerResource(instanceTemplate *meta.K
 		TargetSize:       replicas,
 	}
 
+	updatePolicy, err := instanceGroupManagerUpdatePolicy(m.GCPMachinePool.Spec.Strategy)
+	if err != nil {
+		return nil, err
+	}
+	desired.UpdatePolicy = updatePolicy
+
 	// DistributionPolicy can only be used if there are multiple zones
 	if len(zones) > 1 {
 		desired.DistributionPolicy = &compute.DistributionPolicy{}
+		if shape := m.GCPMachinePool.Spec.DistributionTargetShape; shape != nil {
+			desired.DistributionPolicy.TargetShape = strings.ToUpper(string(*shape))
+			// Balanced and Any require proactive instance redistribution to be disabled.
+			if *shape != expinfrav1.EvenGCPMachinePoolDistributionTargetShape && desired.UpdatePolicy.InstanceRedistributionType == "" {
+				desired.UpdatePolicy.InstanceRedistributionType = "NONE"
+			}
+		}
 		for _, zone := range zones {
 			zoneSelfLink, err := buildZoneSelfLink(zone)
 			if err != nil {
@@ -214,9 +289,384 @@ func (m *MachinePoolScope) InstanceGroupManagerResource(instanceTemplate *meta.K
 		desired.Zone = zoneSelfLink
 	}
 
+	if autoHealing := m.GCPMachinePool.Spec.AutoHealing; autoHealing != nil {
+		desired.AutoHealingPolicies = []*compute.InstanceGroupManagerAutoHealingPolicy{
+			{
+				HealthCheck:     gcp.SelfLink("healthChecks", m.AutoHealingHealthCheckResourceName()),
+				InitialDelaySec: int64(ptr.Deref(autoHealing.InitialDelaySec, 300)),
+				ForceSendFields: []string{"InitialDelaySec"},
+			},
+		}
+	}
+
+	for _, selection := range m.GCPMachinePool.Spec.InstanceSelections {
+		if desired.InstanceFlexibilityPolicy == nil {
+			desired.InstanceFlexibilityPolicy = &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+				InstanceSelections: map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{},
+			}
+		}
+		desired.InstanceFlexibilityPolicy.InstanceSelections[selection.Name] = compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{
+			MachineTypes: selection.MachineTypes,
+			Rank:         int64(selection.Rank),
+			// Rank 0 is the most preferred rank, it must be sent when patching a selection.
+			ForceSendFields: []string{"Rank"},
+		}
+	}
+
+	if stateful := m.GCPMachinePool.Spec.Stateful; stateful != nil {
+		desired.StatefulPolicy = instanceGroupManagerStatefulPolicy(stateful)
+
+		// Stateful instances must keep their name to be matched with their preserved state,
+		// so they are recreated in place, without surge, and not redistributed across zones.
+		if desired.UpdatePolicy.ReplacementMethod == "" {
+			desired.UpdatePolicy.ReplacementMethod = "RECREATE"
+		}
+		if desired.UpdatePolicy.MaxSurge == nil {
+			desired.UpdatePolicy.MaxSurge = &compute.FixedOrPercent{Fixed: 0, ForceSendFields: []string{"Fixed"}}
+		}
+		if len(zones) > 1 && desired.UpdatePolicy.InstanceRedistributionType == "" {
+			desired.UpdatePolicy.InstanceRedistributionType = "NONE"
+		}
+	}
+
 	return desired, nil
 }
 
+// ProvisioningModelMix is the desired mix of Standard and Spot instances of the instanceGroupManager.
+// It returns nil if the instanceGroupManager does not mix provisioning models.
+func (m *MachinePoolScope) ProvisioningModelMix() *computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix {
+	mix := m.GCPMachinePool.Spec.ProvisioningModelMix
+	if mix == nil {
+		return nil
+	}
+
+	return &computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix{
+		StandardCapacityBase:             int64(ptr.Deref(mix.StandardCapacityBase, 0)),
+		StandardCapacityPercentAboveBase: int64(ptr.Deref(mix.StandardCapacityPercentAboveBase, 0)),
+		ForceSendFields:                  []string{"StandardCapacityBase", "StandardCapacityPercentAboveBase"},
+	}
+}
+
+// TargetSizePolicy is the desired target size policy of the instanceGroupManager.
+// It returns nil if the GCP default, creating instances individually, is used.
+func (m *MachinePoolScope) TargetSizePolicy() *computebeta.InstanceGroupManagerTargetSizePolicy {
+	policy := m.GCPMachinePool.Spec.TargetSizePolicy
+	if policy == nil {
+		return nil
+	}
+
+	mode := policy.Mode
+	if mode == "" {
+		mode = expinfrav1.IndividualGCPMachinePoolTargetSizeMode
+	}
+
+	return &computebeta.InstanceGroupManagerTargetSizePolicy{
+		Mode: strings.ToUpper(string(mode)),
+	}
+}
+
+// instanceGroupManagerStatefulPolicy returns the instanceGroupManager stateful policy for the given stateful configuration.
+func instanceGroupManagerStatefulPolicy(stateful *expinfrav1.GCPMachinePoolStatefulPolicy) *compute.StatefulPolicy {
+	preservedState := &compute.StatefulPolicyPreservedState{}
+	for _, disk := range stateful.Disks {
+		if preservedState.Disks == nil {
+			preservedState.Disks = map[string]compute.StatefulPolicyPreservedStateDiskDevice{}
+		}
+		preservedState.Disks[disk.DeviceName] = compute.StatefulPolicyPreservedStateDiskDevice{
+			AutoDelete: statefulAutoDelete(disk.AutoDelete),
+		}
+	}
+	for _, ip := range stateful.InternalIPs {
+		if preservedState.InternalIPs == nil {
+			preservedState.InternalIPs = map[string]compute.StatefulPolicyPreservedStateNetworkIp{}
+		}
+		preservedState.InternalIPs[ip.InterfaceName] = compute.StatefulPolicyPreservedStateNetworkIp{
+			AutoDelete: statefulAutoDelete(ip.AutoDelete),
+		}
+	}
+	for _, ip := range stateful.ExternalIPs {
+		if preservedState.ExternalIPs == nil {
+			preservedState.ExternalIPs = map[string]compute.StatefulPolicyPreservedStateNetworkIp{}
+		}
+		preservedState.ExternalIPs[ip.InterfaceName] = compute.StatefulPolicyPreservedStateNetworkIp{
+			AutoDelete: statefulAutoDelete(ip.AutoDelete),
+		}
+	}
+
+	return &compute.StatefulPolicy{PreservedState: preservedState}
+}
+
+func statefulAutoDelete(autoDelete expinfrav1.GCPMachinePoolStatefulAutoDelete) string {
+	if autoDelete == expinfrav1.OnPermanentInstanceDeletionGCPMachinePoolStatefulAutoDelete {
+		return "ON_PERMANENT_INSTANCE_DELETION"
+	}
+	return "NEVER"
+}
+
+// AutoHealingHealthCheckResourceName returns the key of the health check used for autohealing.
+func (m *MachinePoolScope) AutoHealingHealthCheckResourceName() *meta.Key {
+	suffix := "-autohealing"
+	return meta.GlobalKey(limitStringLength(m.ClusterName()+"-"+m.Name(), 63-len(suffix)) + suffix)
+}
+
+// AutoHealingHealthCheckResource is the desired state for the autohealing health check GCP resource.
+// It returns nil if autohealing is not enabled.
+func (m *MachinePoolScope) AutoHealingHealthCheckResource() *compute.HealthCheck {
+	autoHealing := m.GCPMachinePool.Spec.AutoHealing
+	if autoHealing == nil {
+		return nil
+	}
+	spec := autoHealing.HealthCheck
+
+	healthCheck := &compute.HealthCheck{
+		Name:               m.AutoHealingHealthCheckResourceName().Name,
+		CheckIntervalSec:   int64(ptr.Deref(spec.CheckIntervalSec, 10)),
+		TimeoutSec:         int64(ptr.Deref(spec.TimeoutSec, 5)),
+		HealthyThreshold:   int64(ptr.Deref(spec.HealthyThreshold, 2)),
+		UnhealthyThreshold: int64(ptr.Deref(spec.UnhealthyThreshold, 3)),
+	}
+
+	port := int64(ptr.Deref(spec.Port, 10250))
+	switch spec.Type {
+	case expinfrav1.HTTPGCPMachinePoolHealthCheckType:
+		healthCheck.Type = "HTTP"
+		healthCheck.HttpHealthCheck = &compute.HTTPHealthCheck{
+			Port:              port,
+			PortSpecification: "USE_FIXED_PORT",
+			RequestPath:       ptr.Deref(spec.RequestPath, "/"),
+		}
+	case expinfrav1.HTTPSGCPMachinePoolHealthCheckType:
+		healthCheck.Type = "HTTPS"
+		healthCheck.HttpsHealthCheck = &compute.HTTPSHealthCheck{
+			Port:              port,
+			PortSpecification: "USE_FIXED_PORT",
+			RequestPath:       ptr.Deref(spec.RequestPath, "/"),
+		}
+	default:
+		healthCheck.Type = "TCP"
+		healthCheck.TcpHealthCheck = &compute.TCPHealthCheck{
+			Port:              port,
+			PortSpecification: "USE_FIXED_PORT",
+		}
+	}
+
+	return healthCheck
+}
+
+// AutoHealingFirewallResourceName returns the key of the firewall rule allowing the autohealing health check probes.
+func (m *MachinePoolScope) AutoHealingFirewallResourceName() *meta.Key {
+	prefix, suffix := "allow-", "-autohealing"
+	return meta.GlobalKey(prefix + limitStringLength(m.ClusterName()+"-"+m.Name(), 63-len(prefix)-len(suffix)) + suffix)
+}
+
+// AutoHealingFirewallResource is the desired state for the firewall rule allowing the autohealing health check probes
+// to reach the instances of the machine pool. It returns nil if autohealing is not enabled, or if the firewall rules of
+// the cluster network are not managed, as with a shared VPC.
+func (m *MachinePoolScope) AutoHealingFirewallResource() *compute.Firewall {
+	healthCheck := m.AutoHealingHealthCheckResource()
+	if healthCheck == nil || m.SkipFirewallRulesManagement() {
+		return nil
+	}
+
+	var port int64
+	switch {
+	case healthCheck.HttpHealthCheck != nil:
+		port = healthCheck.HttpHealthCheck.Port
+	case healthCheck.HttpsHealthCheck != nil:
+		port = healthCheck.HttpsHealthCheck.Port
+	default:
+		port = healthCheck.TcpHealthCheck.Port
+	}
+
+	return &compute.Firewall{
+		Name:        m.AutoHealingFirewallResourceName().Name,
+		Description: "Created by Cluster API GCP Provider",
+		Network:     fmt.Sprintf("projects/%s/global/networks/%s", m.ClusterGetter.NetworkProject(), m.ClusterGetter.NetworkName()),
+		Direction:   "INGRESS",
+		Allowed: []*compute.FirewallAllowed{
+			{
+				IPProtocol: "TCP",
+				Ports:      []string{strconv.FormatInt(port, 10)},
+			},
+		},
+		SourceRanges: []string{
+			"35.191.0.0/16",
+			"130.211.0.0/22",
+		},
+		TargetTags: []string{
+			fmt.Sprintf("%s-%s", m.ClusterGetter.Name(), m.Role()),
+		},
+	}
+}
+
+// SkipFirewallRulesManagement returns true if the firewall rules of the cluster network are not managed.
+func (m *MachinePoolScope) SkipFirewallRulesManagement() bool {
+	return m.ClusterGetter.SkipFirewallRulesManagement()
+}
+
+// ReplicasManagedByAutoscaler returns true if the size of the managed instance group is managed by a GCE autoscaler.
+func (m *MachinePoolScope) ReplicasManagedByAutoscaler() bool {
+	return m.GCPMachinePool.Spec.Autoscaling != nil
+}
+
+// AutoscalerResourceName returns the key of the autoscaler, which has the same name and location as the instanceGroupManager.
+func (m *MachinePoolScope) AutoscalerResourceName() (*meta.Key, error) {
+	return m.InstanceGroupManagerResourceName()
+}
+
+// PreviousAutoscalerResourceName returns the key of the autoscaler of the previous instanceGroupManager, or nil if the
+// instanceGroupManager didn't move.
+func (m *MachinePoolScope) PreviousAutoscalerResourceName() (*meta.Key, error) {
+	return m.PreviousInstanceGroupManagerResourceName()
+}
+
+// AutoscalerResource is the desired state for the autoscaler GCP resource targeting the given instanceGroupManager.
+// It returns nil if autoscaling is not enabled.
+func (m *MachinePoolScope) AutoscalerResource(target string) (*compute.Autoscaler, error) {
+	autoscaling := m.GCPMachinePool.Spec.Autoscaling
+	if autoscaling == nil {
+		return nil, nil
+	}
+
+	key, err := m.AutoscalerResourceName()
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &compute.AutoscalingPolicy{
+		MinNumReplicas:    int64(autoscaling.MinReplicas),
+		MaxNumReplicas:    int64(autoscaling.MaxReplicas),
+		Mode:              autoscalingMode(autoscaling.Mode),
+		CoolDownPeriodSec: int64(ptr.Deref(autoscaling.CoolDownPeriodSec, 60)),
+		ForceSendFields:   []string{"MinNumReplicas", "CoolDownPeriodSec"},
+	}
+	if cpu := autoscaling.CPUUtilization; cpu != nil {
+		policy.CpuUtilization = &compute.AutoscalingPolicyCpuUtilization{
+			UtilizationTarget: float64(cpu.TargetPercent) / 100,
+		}
+		if cpu.PredictiveMethod != nil {
+			policy.CpuUtilization.PredictiveMethod = upperSnakeCase(*cpu.PredictiveMethod)
+		}
+	}
+	if lb := autoscaling.LoadBalancingUtilization; lb != nil {
+		policy.LoadBalancingUtilization = &compute.AutoscalingPolicyLoadBalancingUtilization{
+			UtilizationTarget: float64(lb.TargetPercent) / 100,
+		}
+	}
+	for _, metric := range autoscaling.CustomMetrics {
+		utilization := &compute.AutoscalingPolicyCustomMetricUtilization{
+			Metric: metric.Metric,
+			Filter: ptr.Deref(metric.Filter, ""),
+		}
+		if metric.Target != nil {
+			utilization.UtilizationTarget = metric.Target.AsApproximateFloat64()
+			utilization.UtilizationTargetType = upperSnakeCase(string(ptr.Deref(metric.TargetType, expinfrav1.GaugeGCPMachinePoolCustomMetricTargetType)))
+		}
+		if metric.SingleInstanceAssignment != nil {
+			utilization.SingleInstanceAssignment = metric.SingleInstanceAssignment.AsApproximateFloat64()
+		}
+		policy.CustomMetricUtilizations = append(policy.CustomMetricUtilizations, utilization)
+	}
+	if scaleIn := autoscaling.ScaleIn; scaleIn != nil {
+		maxScaledInReplicas, err := fixedOrPercent(&scaleIn.MaxScaledInReplicas)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maxScaledInReplicas: %w", err)
+		}
+		policy.ScaleInControl = &compute.AutoscalingPolicyScaleInControl{
+			MaxScaledInReplicas: maxScaledInReplicas,
+			TimeWindowSec:       int64(scaleIn.TimeWindowSec),
+		}
+	}
+
+	return &compute.Autoscaler{
+		Name:              key.Name,
+		Target:            target,
+		AutoscalingPolicy: policy,
+	}, nil
+}
+
+func autoscalingMode(mode expinfrav1.GCPMachinePoolAutoscalingMode) string {
+	if mode == "" {
+		return "ON"
+	}
+	return upperSnakeCase(string(mode))
+}
+
+// upperSnakeCase converts an API enum value such as OnlyScaleOut to the GCP representation ONLY_SCALE_OUT.
+func upperSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+// instanceGroupManagerUpdatePolicy returns the instanceGroupManager update policy for the given strategy.
+// Without a strategy, instances are proactively replaced so that template changes roll out to existing instances.
+func instanceGroupManagerUpdatePolicy(strategy *expinfrav1.GCPMachinePoolStrategy) (*compute.InstanceGroupManagerUpdatePolicy, error) {
+	updatePolicy := &compute.InstanceGroupManagerUpdatePolicy{
+		Type: "PROACTIVE",
+	}
+	if strategy == nil {
+		return updatePolicy, nil
+	}
+
+	if strategy.Type != "" {
+		updatePolicy.Type = strings.ToUpper(string(strategy.Type))
+	}
+	if strategy.MinimalAction != nil {
+		updatePolicy.MinimalAction = strings.ToUpper(string(*strategy.MinimalAction))
+	}
+	if strategy.ReplacementMethod != nil {
+		updatePolicy.ReplacementMethod = strings.ToUpper(string(*strategy.ReplacementMethod))
+	}
+	if strategy.InstanceRedistributionType != nil {
+		updatePolicy.InstanceRedistributionType = strings.ToUpper(string(*strategy.InstanceRedistributionType))
+	}
+
+	var err error
+	if updatePolicy.MaxSurge, err = fixedOrPercent(strategy.MaxSurge); err != nil {
+		return nil, fmt.Errorf("invalid maxSurge: %w", err)
+	}
+	if updatePolicy.MaxUnavailable, err = fixedOrPercent(strategy.MaxUnavailable); err != nil {
+		return nil, fmt.Errorf("invalid maxUnavailable: %w", err)
+	}
+	// GCP requires maxSurge to be 0 when instance names are preserved, and defaults it to the number of zones.
+	if updatePolicy.ReplacementMethod == "RECREATE" && updatePolicy.MaxSurge == nil {
+		updatePolicy.MaxSurge = &compute.FixedOrPercent{Fixed: 0, ForceSendFields: []string{"Fixed"}}
+	}
+
+	return updatePolicy, nil
+}
+
+// fixedOrPercent converts an absolute number or a percentage to the GCP representation.
+func fixedOrPercent(value *intstr.IntOrString) (*compute.FixedOrPercent, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	if value.Type == intstr.Int {
+		// Fixed is sent explicitly, as 0 is a meaningful value (e.g. maxSurge with the Recreate replacement method).
+		return &compute.FixedOrPercent{
+			Fixed:           int64(value.IntVal),
+			ForceSendFields: []string{"Fixed"},
+		}, nil
+	}
+
+	percent, err := strconv.ParseInt(strings.TrimSuffix(value.StrVal, "%"), 10, 64)
+	if err != nil || !strings.HasSuffix(value.StrVal, "%") {
+		return nil, fmt.Errorf("%q is not a percentage", value.StrVal)
+	}
+
+	return &compute.FixedOrPercent{
+		Percent:         percent,
+		ForceSendFields: []string{"Percent"},
+	}, nil
+}
+
 // buildZoneSelfLink returns a fully-qualified zone link from a user-provided zone
 func buildZoneSelfLink(zone string) (string, error) {
 	tokens := strings.Split(zone, "/")
@@ -239,6 +689,11 @@ func (m *MachinePoolScope) BaseInstanceTemplateResourceName() (*meta.Key, error)
 	return meta.RegionalKey(namePrefix, region), nil
 }
 
+// InstanceTemplateHistoryLimit returns the number of superseded instanceTemplates to keep.
+func (m *MachinePoolScope) InstanceTemplateHistoryLimit() int {
+	return int(ptr.Deref(m.GCPMachinePool.Spec.InstanceTemplateHistoryLimit, 2))
+}
+
 // limitStringLength returns the string truncated to the specified maximum length.
 func limitStringLength(s string, maxLength int) string {
 	if len(s) > maxLength {
@@ -354,6 +809,16 @@ func (m *MachinePoolScope) InstanceTemplateResource(ctx context.Context) (*compu
 		Value: ptr.To[string](bootstrapData),
 	})
 
+	// GCPMachinePools of a ClusterClass topology are annotated with the GCPMachinePoolTemplate they are cloned from.
+	// It is part of the instance template, so that referencing a new GCPMachinePoolTemplate rolls out a new
+	// instance template, as Machines are rolled out when the template of a MachineDeployment changes.
+	if templateName, ok := m.GCPMachinePool.Annotations[clusterv1.TemplateClonedFromNameAnnotation]; ok {
+		instance.Metadata.Items = append(instance.Metadata.Items, &compute.MetadataItems{
+			Key:   machinePoolTemplateMetadataKey,
+			Value: ptr.To[string](templateName),
+		})
+	}
+
 	instanceTemplate := &compute.InstanceTemplate{
 		Region:     m.Region(),
 		Properties: instance,
@@ -0,0 +1,513 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/stretchr/testify/assert"
+	computebeta "google.golang.org/api/compute/v0.beta"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestInstanceGroupManagerUpdatePolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy *expinfrav1.GCPMachinePoolStrategy
+		want     *compute.InstanceGroupManagerUpdatePolicy
+		wantErr  bool
+	}{
+		{
+			name: "no strategy defaults to proactive updates",
+			want: &compute.InstanceGroupManagerUpdatePolicy{Type: "PROACTIVE"},
+		},
+		{
+			name: "all fields set",
+			strategy: &expinfrav1.GCPMachinePoolStrategy{
+				Type:                       expinfrav1.OpportunisticGCPMachinePoolStrategyType,
+				MaxSurge:                   ptr.To(intstr.FromInt32(0)),
+				MaxUnavailable:             ptr.To(intstr.FromString("20%")),
+				MinimalAction:              ptr.To(expinfrav1.RestartGCPMachinePoolMinimalAction),
+				ReplacementMethod:          ptr.To(expinfrav1.RecreateGCPMachinePoolReplacementMethod),
+				InstanceRedistributionType: ptr.To(expinfrav1.NoneGCPMachinePoolInstanceRedistributionType),
+			},
+			want: &compute.InstanceGroupManagerUpdatePolicy{
+				Type:                       "OPPORTUNISTIC",
+				MaxSurge:                   &compute.FixedOrPercent{Fixed: 0, ForceSendFields: []string{"Fixed"}},
+				MaxUnavailable:             &compute.FixedOrPercent{Percent: 20, ForceSendFields: []string{"Percent"}},
+				MinimalAction:              "RESTART",
+				ReplacementMethod:          "RECREATE",
+				InstanceRedistributionType: "NONE",
+			},
+		},
+		{
+			name: "recreate replacement method defaults maxSurge to 0",
+			strategy: &expinfrav1.GCPMachinePoolStrategy{
+				ReplacementMethod: ptr.To(expinfrav1.RecreateGCPMachinePoolReplacementMethod),
+			},
+			want: &compute.InstanceGroupManagerUpdatePolicy{
+				Type:              "PROACTIVE",
+				MaxSurge:          &compute.FixedOrPercent{Fixed: 0, ForceSendFields: []string{"Fixed"}},
+				ReplacementMethod: "RECREATE",
+			},
+		},
+		{
+			name: "invalid percentage",
+			strategy: &expinfrav1.GCPMachinePoolStrategy{
+				MaxSurge: ptr.To(intstr.FromString("many")),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instanceGroupManagerUpdatePolicy(tt.strategy)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMachinePoolInstanceGroupManagerResource(t *testing.T) {
+	tests := []struct {
+		name               string
+		failureDomains     []string
+		targetShape        *expinfrav1.GCPMachinePoolDistributionTargetShape
+		wantKey            *meta.Key
+		wantZone           string
+		wantPolicy         *compute.DistributionPolicy
+		wantRedistribution string
+	}{
+		{
+			name:           "single zone uses a zonal instanceGroupManager",
+			failureDomains: []string{"us-central1-a"},
+			wantKey:        meta.ZonalKey("my-cluster-my-pool", "us-central1-a"),
+			wantZone:       "zones/us-central1-a",
+		},
+		{
+			name:           "multiple zones use a regional instanceGroupManager",
+			failureDomains: []string{"us-central1-a", "us-central1-b"},
+			targetShape:    ptr.To(expinfrav1.BalancedGCPMachinePoolDistributionTargetShape),
+			wantKey:        meta.RegionalKey("my-cluster-my-pool", "us-central1"),
+			wantPolicy: &compute.DistributionPolicy{
+				TargetShape: "BALANCED",
+				Zones: []*compute.DistributionPolicyZoneConfiguration{
+					{Zone: "zones/us-central1-a"},
+					{Zone: "zones/us-central1-b"},
+				},
+			},
+			wantRedistribution: "NONE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MachinePoolScope{
+				ClusterGetter: &ClusterScope{
+					Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}},
+					GCPCluster: &infrav1.GCPCluster{
+						Spec: infrav1.GCPClusterSpec{Project: "my-proj", Region: "us-central1"},
+					},
+				},
+				MachinePool: &clusterv1.MachinePool{
+					Spec: clusterv1.MachinePoolSpec{FailureDomains: tt.failureDomains},
+				},
+				GCPMachinePool: &expinfrav1.GCPMachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-pool"},
+					Spec:       expinfrav1.GCPMachinePoolSpec{DistributionTargetShape: tt.targetShape},
+				},
+			}
+
+			key, err := m.InstanceGroupManagerResourceName()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantKey, key)
+
+			igm, err := m.InstanceGroupManagerResource(meta.RegionalKey("my-template", "us-central1"))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantZone, igm.Zone)
+			assert.Equal(t, tt.wantPolicy, igm.DistributionPolicy)
+			assert.Equal(t, tt.wantRedistribution, igm.UpdatePolicy.InstanceRedistributionType)
+		})
+	}
+}
+
+func TestMachinePoolPreviousInstanceGroupManagerResourceName(t *testing.T) {
+	tests := []struct {
+		name           string
+		failureDomains []string
+		recorded       string
+		wantKey        *meta.Key
+		wantErr        bool
+	}{
+		{
+			name:           "nothing recorded",
+			failureDomains: []string{"us-central1-a"},
+		},
+		{
+			name:           "same instanceGroupManager",
+			failureDomains: []string{"us-central1-a"},
+			recorded:       "zones/us-central1-a/instanceGroupManagers/my-cluster-my-pool",
+		},
+		{
+			name:           "from a single zone to several zones",
+			failureDomains: []string{"us-central1-a", "us-central1-b"},
+			recorded:       "zones/us-central1-a/instanceGroupManagers/my-cluster-my-pool",
+			wantKey:        meta.ZonalKey("my-cluster-my-pool", "us-central1-a"),
+		},
+		{
+			name:           "from several zones to a single zone",
+			failureDomains: []string{"us-central1-b"},
+			recorded:       "regions/us-central1/instanceGroupManagers/my-cluster-my-pool",
+			wantKey:        meta.RegionalKey("my-cluster-my-pool", "us-central1"),
+		},
+		{
+			name:           "invalid recorded instanceGroupManager",
+			failureDomains: []string{"us-central1-a"},
+			recorded:       "my-cluster-my-pool",
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MachinePoolScope{
+				ClusterGetter: &ClusterScope{
+					Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}},
+					GCPCluster: &infrav1.GCPCluster{
+						Spec: infrav1.GCPClusterSpec{Project: "my-proj", Region: "us-central1"},
+					},
+				},
+				MachinePool: &clusterv1.MachinePool{
+					Spec: clusterv1.MachinePoolSpec{FailureDomains: tt.failureDomains},
+				},
+				GCPMachinePool: &expinfrav1.GCPMachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-pool"},
+					Status:     expinfrav1.GCPMachinePoolStatus{InstanceGroupManager: tt.recorded},
+				},
+			}
+
+			key, err := m.PreviousInstanceGroupManagerResourceName()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantKey, key)
+
+			current, err := m.InstanceGroupManagerResourceName()
+			assert.NoError(t, err)
+			m.SetInstanceGroupManagerResourceName(current)
+			key, err = m.PreviousInstanceGroupManagerResourceName()
+			assert.NoError(t, err)
+			assert.Nil(t, key)
+		})
+	}
+}
+
+func TestMachinePoolAutoHealingAndStatefulPolicy(t *testing.T) {
+	m := &MachinePoolScope{
+		ClusterGetter: &ClusterScope{
+			Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}},
+			GCPCluster: &infrav1.GCPCluster{
+				Spec: infrav1.GCPClusterSpec{Project: "my-proj", Region: "us-central1"},
+			},
+		},
+		MachinePool: &clusterv1.MachinePool{
+			Spec: clusterv1.MachinePoolSpec{FailureDomains: []string{"us-central1-a", "us-central1-b"}},
+		},
+		GCPMachinePool: &expinfrav1.GCPMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pool"},
+			Spec: expinfrav1.GCPMachinePoolSpec{
+				AutoHealing: &expinfrav1.GCPMachinePoolAutoHealing{
+					HealthCheck: expinfrav1.GCPMachinePoolHealthCheck{
+						Type:        expinfrav1.HTTPGCPMachinePoolHealthCheckType,
+						Port:        ptr.To[int32](10256),
+						RequestPath: ptr.To("/healthz"),
+					},
+				},
+				Stateful: &expinfrav1.GCPMachinePoolStatefulPolicy{
+					Disks: []expinfrav1.GCPMachinePoolStatefulDisk{
+						{DeviceName: "persistent-disk-1", AutoDelete: expinfrav1.OnPermanentInstanceDeletionGCPMachinePoolStatefulAutoDelete},
+					},
+					InternalIPs: []expinfrav1.GCPMachinePoolStatefulIP{{InterfaceName: "nic0"}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, meta.GlobalKey("my-cluster-my-pool-autohealing"), m.AutoHealingHealthCheckResourceName())
+	assert.Equal(t, &compute.HealthCheck{
+		Name:               "my-cluster-my-pool-autohealing",
+		Type:               "HTTP",
+		CheckIntervalSec:   10,
+		TimeoutSec:         5,
+		HealthyThreshold:   2,
+		UnhealthyThreshold: 3,
+		HttpHealthCheck: &compute.HTTPHealthCheck{
+			Port:              10256,
+			PortSpecification: "USE_FIXED_PORT",
+			RequestPath:       "/healthz",
+		},
+	}, m.AutoHealingHealthCheckResource())
+
+	assert.Equal(t, &compute.Firewall{
+		Name:         "allow-my-cluster-my-pool-autohealing",
+		Description:  "Created by Cluster API GCP Provider",
+		Network:      "projects/my-proj/global/networks/default",
+		Direction:    "INGRESS",
+		Allowed:      []*compute.FirewallAllowed{{IPProtocol: "TCP", Ports: []string{"10256"}}},
+		SourceRanges: []string{"35.191.0.0/16", "130.211.0.0/22"},
+		TargetTags:   []string{"my-cluster-node"},
+	}, m.AutoHealingFirewallResource())
+
+	igm, err := m.InstanceGroupManagerResource(meta.RegionalKey("my-template", "us-central1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []*compute.InstanceGroupManagerAutoHealingPolicy{
+		{
+			HealthCheck:     "global/healthChecks/my-cluster-my-pool-autohealing",
+			InitialDelaySec: 300,
+			ForceSendFields: []string{"InitialDelaySec"},
+		},
+	}, igm.AutoHealingPolicies)
+	assert.Equal(t, &compute.StatefulPolicy{
+		PreservedState: &compute.StatefulPolicyPreservedState{
+			Disks: map[string]compute.StatefulPolicyPreservedStateDiskDevice{
+				"persistent-disk-1": {AutoDelete: "ON_PERMANENT_INSTANCE_DELETION"},
+			},
+			InternalIPs: map[string]compute.StatefulPolicyPreservedStateNetworkIp{
+				"nic0": {AutoDelete: "NEVER"},
+			},
+		},
+	}, igm.StatefulPolicy)
+
+	// Stateful instances are recreated in place.
+	assert.Equal(t, "RECREATE", igm.UpdatePolicy.ReplacementMethod)
+	assert.Equal(t, &compute.FixedOrPercent{Fixed: 0, ForceSendFields: []string{"Fixed"}}, igm.UpdatePolicy.MaxSurge)
+	assert.Equal(t, "NONE", igm.UpdatePolicy.InstanceRedistributionType)
+
+	m.GCPMachinePool.Spec.AutoHealing = nil
+	assert.Nil(t, m.AutoHealingHealthCheckResource())
+	assert.Nil(t, m.AutoHealingFirewallResource())
+}
+
+func TestMachinePoolAutoscalerResource(t *testing.T) {
+	m := &MachinePoolScope{
+		ClusterGetter: &ClusterScope{
+			Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}},
+			GCPCluster: &infrav1.GCPCluster{
+				Spec: infrav1.GCPClusterSpec{Project: "my-proj", Region: "us-central1"},
+			},
+		},
+		MachinePool: &clusterv1.MachinePool{
+			Spec: clusterv1.MachinePoolSpec{Replicas: ptr.To[int32](20), FailureDomains: []string{"us-central1-a"}},
+		},
+		GCPMachinePool: &expinfrav1.GCPMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pool"},
+			Spec: expinfrav1.GCPMachinePoolSpec{
+				Autoscaling: &expinfrav1.GCPMachinePoolAutoscaling{
+					MinReplicas: 0,
+					MaxReplicas: 10,
+					Mode:        expinfrav1.OnlyScaleOutGCPMachinePoolAutoscalingMode,
+					CPUUtilization: &expinfrav1.GCPMachinePoolCPUUtilization{
+						TargetPercent:    75,
+						PredictiveMethod: ptr.To("OptimizeAvailability"),
+					},
+					CustomMetrics: []expinfrav1.GCPMachinePoolCustomMetric{
+						{
+							Metric:     "custom.googleapis.com/requests",
+							Target:     ptr.To(resource.MustParse("500m")),
+							TargetType: ptr.To(expinfrav1.DeltaPerSecondGCPMachinePoolCustomMetricTargetType),
+						},
+					},
+					ScaleIn: &expinfrav1.GCPMachinePoolScaleInControl{
+						MaxScaledInReplicas: intstr.FromString("20%"),
+						TimeWindowSec:       600,
+					},
+				},
+			},
+		},
+	}
+
+	assert.True(t, m.ReplicasManagedByAutoscaler())
+
+	key, err := m.AutoscalerResourceName()
+	assert.NoError(t, err)
+	assert.Equal(t, meta.ZonalKey("my-cluster-my-pool", "us-central1-a"), key)
+
+	igmSelfLink := "https://www.googleapis.com/compute/v1/projects/my-proj/zones/us-central1-a/instanceGroupManagers/my-cluster-my-pool"
+	autoscaler, err := m.AutoscalerResource(igmSelfLink)
+	assert.NoError(t, err)
+	assert.Equal(t, &compute.Autoscaler{
+		Name:   "my-cluster-my-pool",
+		Target: igmSelfLink,
+		AutoscalingPolicy: &compute.AutoscalingPolicy{
+			MinNumReplicas:    0,
+			MaxNumReplicas:    10,
+			Mode:              "ONLY_SCALE_OUT",
+			CoolDownPeriodSec: 60,
+			CpuUtilization: &compute.AutoscalingPolicyCpuUtilization{
+				UtilizationTarget: 0.75,
+				PredictiveMethod:  "OPTIMIZE_AVAILABILITY",
+			},
+			CustomMetricUtilizations: []*compute.AutoscalingPolicyCustomMetricUtilization{
+				{
+					Metric:                "custom.googleapis.com/requests",
+					UtilizationTarget:     0.5,
+					UtilizationTargetType: "DELTA_PER_SECOND",
+				},
+			},
+			ScaleInControl: &compute.AutoscalingPolicyScaleInControl{
+				MaxScaledInReplicas: &compute.FixedOrPercent{Percent: 20, ForceSendFields: []string{"Percent"}},
+				TimeWindowSec:       600,
+			},
+			ForceSendFields: []string{"MinNumReplicas", "CoolDownPeriodSec"},
+		},
+	}, autoscaler)
+
+	// The initial size of the managed instance group is within the autoscaler bounds.
+	igm, err := m.InstanceGroupManagerResource(meta.GlobalKey("my-template"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), igm.TargetSize)
+
+	m.GCPMachinePool.Spec.Autoscaling = nil
+	assert.False(t, m.ReplicasManagedByAutoscaler())
+	autoscaler, err = m.AutoscalerResource(igmSelfLink)
+	assert.NoError(t, err)
+	assert.Nil(t, autoscaler)
+}
+
+func TestMachinePoolInstanceFlexibility(t *testing.T) {
+	m := &MachinePoolScope{
+		ClusterGetter: &ClusterScope{
+			Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}},
+			GCPCluster: &infrav1.GCPCluster{
+				Spec: infrav1.GCPClusterSpec{Project: "my-proj", Region: "us-central1"},
+			},
+		},
+		MachinePool: &clusterv1.MachinePool{
+			Spec: clusterv1.MachinePoolSpec{FailureDomains: []string{"us-central1-a"}},
+		},
+		GCPMachinePool: &expinfrav1.GCPMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pool"},
+			Spec: expinfrav1.GCPMachinePoolSpec{
+				InstanceSelections: []expinfrav1.GCPMachinePoolInstanceSelection{
+					{Name: "preferred", MachineTypes: []string{"n2-standard-4", "n2d-standard-4"}},
+					{Name: "fallback", MachineTypes: []string{"e2-standard-4"}, Rank: 1},
+				},
+				ProvisioningModelMix: &expinfrav1.GCPMachinePoolProvisioningModelMix{
+					StandardCapacityPercentAboveBase: ptr.To[int32](25),
+				},
+			},
+		},
+	}
+
+	igm, err := m.InstanceGroupManagerResource(meta.GlobalKey("my-template"))
+	assert.NoError(t, err)
+	assert.Equal(t, &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+		InstanceSelections: map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{
+			"preferred": {MachineTypes: []string{"n2-standard-4", "n2d-standard-4"}, Rank: 0, ForceSendFields: []string{"Rank"}},
+			"fallback":  {MachineTypes: []string{"e2-standard-4"}, Rank: 1, ForceSendFields: []string{"Rank"}},
+		},
+	}, igm.InstanceFlexibilityPolicy)
+
+	assert.Equal(t, &computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix{
+		StandardCapacityBase:             0,
+		StandardCapacityPercentAboveBase: 25,
+		ForceSendFields:                  []string{"StandardCapacityBase", "StandardCapacityPercentAboveBase"},
+	}, m.ProvisioningModelMix())
+
+	m.GCPMachinePool.Spec.ProvisioningModelMix = nil
+	assert.Nil(t, m.ProvisioningModelMix())
+
+	assert.Nil(t, m.TargetSizePolicy())
+	m.GCPMachinePool.Spec.TargetSizePolicy = &expinfrav1.GCPMachinePoolTargetSizePolicy{Mode: expinfrav1.BulkGCPMachinePoolTargetSizeMode}
+	assert.Equal(t, &computebeta.InstanceGroupManagerTargetSizePolicy{Mode: "BULK"}, m.TargetSizePolicy())
+}
+
+func TestNewComputeBetaServiceWithCustomComputeEndpoint(t *testing.T) {
+	endpoints := &infrav1.ServiceEndpoints{ComputeServiceEndpoint: "https://compute.example.com/compute/v1/"}
+	_, err := newComputeBetaService(context.Background(), nil, nil, endpoints)
+	assert.ErrorContains(t, err, "serviceEndpoints.computeBeta must be set")
+}
+
+func TestMachinePoolInstanceTemplateResourceFromTemplate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, corev1.AddToScheme(scheme))
+	bootstrapSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pool-bootstrap", Namespace: "default"},
+		Data:       map[string][]byte{"value": []byte("#cloud-config")},
+	}
+
+	m := &MachinePoolScope{
+		client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(bootstrapSecret).Build(),
+		ClusterGetter: &ClusterScope{
+			Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}},
+			GCPCluster: &infrav1.GCPCluster{
+				Spec: infrav1.GCPClusterSpec{Project: "my-proj", Region: "us-central1"},
+			},
+		},
+		MachinePool: &clusterv1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: clusterv1.MachinePoolSpec{
+				Template: clusterv1.MachineTemplateSpec{
+					Spec: clusterv1.MachineSpec{
+						Version:   "v1.33.1",
+						Bootstrap: clusterv1.Bootstrap{DataSecretName: ptr.To("my-pool-bootstrap")},
+					},
+				},
+			},
+		},
+		GCPMachinePool: &expinfrav1.GCPMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pool", Namespace: "default"},
+			Spec:       expinfrav1.GCPMachinePoolSpec{InstanceType: "n2-standard-4"},
+		},
+	}
+
+	templateMetadata := func(it *compute.InstanceTemplate) *string {
+		for _, item := range it.Properties.Metadata.Items {
+			if item.Key == machinePoolTemplateMetadataKey {
+				return item.Value
+			}
+		}
+		return nil
+	}
+
+	it, err := m.InstanceTemplateResource(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, templateMetadata(it))
+
+	// Referencing a new GCPMachinePoolTemplate changes the instance template, so that it is rolled out.
+	m.GCPMachinePool.Annotations = map[string]string{clusterv1.TemplateClonedFromNameAnnotation: "my-pool-template-v2"}
+	it, err = m.InstanceTemplateResource(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, ptr.To("my-pool-template-v2"), templateMetadata(it))
+}
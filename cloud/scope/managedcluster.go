@@ -98,6 +98,25 @@ func (s *ManagedClusterScope) NetworkCloud() cloud.Cloud {
 	return newCloud(s.NetworkProject(), s.GCPServices)
 }
 
+// ComputeService returns the compute service backing Cloud.
+func (s *ManagedClusterScope) ComputeService() *cloud.ComputeService {
+	return newCloudService(s.Project(), s.GCPServices)
+}
+
+// ComputeServiceWithBeta returns the raw compute API including the beta API, whose client is created on first use.
+func (s *ManagedClusterScope) ComputeServiceWithBeta(ctx context.Context) (*cloud.ComputeService, error) {
+	if s.ComputeBeta == nil {
+		computeBetaSvc, err := newComputeBetaService(ctx, s.GCPManagedCluster.Spec.CredentialsRef, s.client, s.GCPManagedCluster.Spec.ServiceEndpoints)
+		if err != nil {
+			return nil, errors.Errorf("failed to create gcp compute beta client: %v", err)
+		}
+
+		s.ComputeBeta = computeBetaSvc
+	}
+
+	return newCloudService(s.Project(), s.GCPServices), nil
+}
+
 // Project returns the current project name.
 func (s *ManagedClusterScope) Project() string {
 	return s.GCPManagedCluster.Spec.Project
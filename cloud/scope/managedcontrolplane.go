@@ -25,9 +25,12 @@ import (
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 
 	container "cloud.google.com/go/container/apiv1"
-	credentials "cloud.google.com/go/iam/credentials/apiv1"
+	"cloud.google.com/go/container/apiv1/containerpb"
 	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
 	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/gkehub/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -42,9 +45,10 @@ const (
 
 // ManagedControlPlaneScopeParams defines the input parameters used to create a new Scope.
 type ManagedControlPlaneScopeParams struct {
-	CredentialsClient      *credentials.IamCredentialsClient
+	TokenSource            oauth2.TokenSource
 	ManagedClusterClient   *container.ClusterManagerClient
 	TagBindingsClient      *resourcemanager.TagBindingsClient
+	GKEHubService          *gkehub.Service
 	Client                 client.Client
 	Cluster                *clusterv1.Cluster
 	GCPManagedCluster      *infrav1exp.GCPManagedCluster
@@ -64,11 +68,6 @@ func NewManagedControlPlaneScope(ctx context.Context, params ManagedControlPlane
 		return nil, errors.New("failed to generate new scope from nil GCPManagedControlPlane")
 	}
 
-	credential, err := getCredentials(ctx, params.GCPManagedCluster.Spec.CredentialsRef, params.Client)
-	if err != nil {
-		return nil, fmt.Errorf("getting gcp credentials: %w", err)
-	}
-
 	if params.ManagedClusterClient == nil {
 		managedClusterClient, err := newClusterManagerClient(ctx, params.GCPManagedCluster.Spec.CredentialsRef, params.Client, params.GCPManagedCluster.Spec.ServiceEndpoints)
 		if err != nil {
@@ -83,13 +82,12 @@ func NewManagedControlPlaneScope(ctx context.Context, params ManagedControlPlane
 		}
 		params.TagBindingsClient = tagBindingsClient
 	}
-	if params.CredentialsClient == nil {
-		var credentialsClient *credentials.IamCredentialsClient
-		credentialsClient, err = newIamCredentialsClient(ctx, params.GCPManagedCluster.Spec.CredentialsRef, params.Client, params.GCPManagedCluster.Spec.ServiceEndpoints)
+	if params.TokenSource == nil {
+		tokenSource, err := newTokenSource(ctx, params.GCPManagedCluster.Spec.CredentialsRef, params.Client)
 		if err != nil {
-			return nil, errors.Errorf("failed to create gcp credentials client: %v", err)
+			return nil, errors.Errorf("failed to create gcp token source: %v", err)
 		}
-		params.CredentialsClient = credentialsClient
+		params.TokenSource = tokenSource
 	}
 
 	helper, err := v1beta1patch.NewHelper(params.GCPManagedControlPlane, params.Client)
@@ -104,8 +102,8 @@ func NewManagedControlPlaneScope(ctx context.Context, params ManagedControlPlane
 		GCPManagedControlPlane: params.GCPManagedControlPlane,
 		mcClient:               params.ManagedClusterClient,
 		tagBindingsClient:      params.TagBindingsClient,
-		credentialsClient:      params.CredentialsClient,
-		credential:             credential,
+		gkeHubService:          params.GKEHubService,
+		tokenSource:            params.TokenSource,
 		patchHelper:            helper,
 	}, nil
 }
@@ -120,8 +118,8 @@ type ManagedControlPlaneScope struct {
 	GCPManagedControlPlane *infrav1exp.GCPManagedControlPlane
 	mcClient               *container.ClusterManagerClient
 	tagBindingsClient      *resourcemanager.TagBindingsClient
-	credentialsClient      *credentials.IamCredentialsClient
-	credential             *Credential
+	gkeHubService          *gkehub.Service
+	tokenSource            oauth2.TokenSource
 
 	AllMachinePools        []clusterv1.MachinePool
 	AllManagedMachinePools []infrav1exp.GCPManagedMachinePool
@@ -137,6 +135,10 @@ func (s *ManagedControlPlaneScope) PatchObject() error {
 			infrav1exp.GKEControlPlaneCreatingCondition,
 			infrav1exp.GKEControlPlaneUpdatingCondition,
 			infrav1exp.GKEControlPlaneDeletingCondition,
+			infrav1exp.GKEControlPlaneImmutableFieldsInSyncCondition,
+			infrav1exp.GKEControlPlaneImportedCondition,
+			infrav1exp.GKEControlPlaneFleetRegisteredCondition,
+			infrav1exp.GKEControlPlaneCredentialsRotatedCondition,
 		}})
 }
 
@@ -144,7 +146,6 @@ func (s *ManagedControlPlaneScope) PatchObject() error {
 func (s *ManagedControlPlaneScope) Close() error {
 	s.mcClient.Close()
 	s.tagBindingsClient.Close()
-	s.credentialsClient.Close()
 	return s.PatchObject()
 }
 
@@ -168,14 +169,29 @@ func (s *ManagedControlPlaneScope) TagBindingsClient() *resourcemanager.TagBindi
 	return s.tagBindingsClient
 }
 
-// CredentialsClient returns a client used to interact with IAM.
-func (s *ManagedControlPlaneScope) CredentialsClient() *credentials.IamCredentialsClient {
-	return s.credentialsClient
+// GKEHubService returns a client used to interact with the fleet memberships of GKE, which is created on first use
+// as only the clusters registered in a fleet need it.
+func (s *ManagedControlPlaneScope) GKEHubService(ctx context.Context) (*gkehub.Service, error) {
+	if s.gkeHubService == nil {
+		gkeHubService, err := newGKEHubService(ctx, s.GCPManagedCluster.Spec.CredentialsRef, s.client)
+		if err != nil {
+			return nil, errors.Errorf("failed to create gcp gke hub service: %v", err)
+		}
+
+		s.gkeHubService = gkeHubService
+	}
+
+	return s.gkeHubService, nil
+}
+
+// ServerConfig returns the GKE server config of the location of the cluster, which lists the available versions.
+func (s *ManagedControlPlaneScope) ServerConfig(ctx context.Context) (*containerpb.ServerConfig, error) {
+	return serverConfigs.get(ctx, s.mcClient, fmt.Sprintf("projects/%s/locations/%s", s.GCPManagedControlPlane.Spec.Project, s.GCPManagedControlPlane.Spec.Location))
 }
 
-// GetCredential returns the credential data.
-func (s *ManagedControlPlaneScope) GetCredential() *Credential {
-	return s.credential
+// TokenSource returns a source of access tokens for the credentials of the GCP clients.
+func (s *ManagedControlPlaneScope) TokenSource() oauth2.TokenSource {
+	return s.tokenSource
 }
 
 // GetAllNodePools gets all node pools for the control plane.
@@ -225,6 +241,11 @@ func (s *ManagedControlPlaneScope) ClusterName() string {
 	return s.GCPManagedControlPlane.Spec.ClusterName
 }
 
+// ClusterResourceLabels returns the resource labels of the cluster.
+func (s *ManagedControlPlaneScope) ClusterResourceLabels() infrav1.Labels {
+	return NodePoolResourceLabels(s.GCPManagedCluster.Spec.AdditionalLabels, s.ClusterName())
+}
+
 // SetEndpoint sets the Endpoint of GCPManagedControlPlane.
 func (s *ManagedControlPlaneScope) SetEndpoint(host string) {
 	s.GCPManagedControlPlane.Spec.Endpoint = clusterv1beta1.APIEndpoint{
@@ -233,11 +254,49 @@ func (s *ManagedControlPlaneScope) SetEndpoint(host string) {
 	}
 }
 
+// PatchClusterControlPlaneEndpoint updates the control plane endpoint of the Cluster to the endpoint of the
+// GCPManagedControlPlane. Cluster API only copies the endpoint while the one of the Cluster is unset, but the endpoint
+// of a GKE cluster changes when its IP is rotated.
+func (s *ManagedControlPlaneScope) PatchClusterControlPlaneEndpoint(ctx context.Context) error {
+	endpoint := clusterv1.APIEndpoint{
+		Host: s.GCPManagedControlPlane.Spec.Endpoint.Host,
+		Port: s.GCPManagedControlPlane.Spec.Endpoint.Port,
+	}
+	if !s.Cluster.Spec.ControlPlaneEndpoint.IsValid() || !endpoint.IsValid() || s.Cluster.Spec.ControlPlaneEndpoint == endpoint {
+		return nil
+	}
+
+	patch := client.MergeFrom(s.Cluster.DeepCopy())
+	s.Cluster.Spec.ControlPlaneEndpoint = endpoint
+	if err := s.client.Patch(ctx, s.Cluster, patch); err != nil {
+		return errors.Wrap(err, "failed to patch the control plane endpoint of the cluster")
+	}
+
+	return nil
+}
+
 // IsAutopilotCluster returns true if this is an autopilot cluster.
 func (s *ManagedControlPlaneScope) IsAutopilotCluster() bool {
 	return s.GCPManagedControlPlane.Spec.EnableAutopilot
 }
 
+// DeletionPolicy returns the deletion policy of the GKE cluster.
+func (s *ManagedControlPlaneScope) DeletionPolicy() infrav1exp.DeletionPolicy {
+	return ClusterDeletionPolicy(s.GCPManagedControlPlane)
+}
+
+// ClusterDeletionPolicy returns the deletion policy of the GKE cluster of the control plane. Imported clusters are
+// orphaned and the other clusters are deleted by default.
+func ClusterDeletionPolicy(controlPlane *infrav1exp.GCPManagedControlPlane) infrav1exp.DeletionPolicy {
+	if controlPlane.Spec.DeletionPolicy != nil {
+		return *controlPlane.Spec.DeletionPolicy
+	}
+	if controlPlane.Spec.Import {
+		return infrav1exp.DeletionPolicyOrphan
+	}
+	return infrav1exp.DeletionPolicyDelete
+}
+
 // GetControlPlaneVersion returns the control plane version from the specification.
 func (s *ManagedControlPlaneScope) GetControlPlaneVersion() *string {
 	if s.GCPManagedControlPlane.Spec.Version != nil {
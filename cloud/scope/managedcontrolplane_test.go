@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPatchClusterControlPlaneEndpoint(t *testing.T) {
+	tests := []struct {
+		name            string
+		clusterEndpoint clusterv1.APIEndpoint
+		endpoint        string
+		expected        clusterv1.APIEndpoint
+	}{
+		{
+			name:            "endpoint changed by an IP rotation",
+			clusterEndpoint: clusterv1.APIEndpoint{Host: "34.1.1.1", Port: APIServerPort},
+			endpoint:        "34.2.2.2",
+			expected:        clusterv1.APIEndpoint{Host: "34.2.2.2", Port: APIServerPort},
+		},
+		{
+			name:            "endpoint unchanged",
+			clusterEndpoint: clusterv1.APIEndpoint{Host: "34.1.1.1", Port: APIServerPort},
+			endpoint:        "34.1.1.1",
+			expected:        clusterv1.APIEndpoint{Host: "34.1.1.1", Port: APIServerPort},
+		},
+		{
+			name:     "endpoint not copied by Cluster API yet",
+			endpoint: "34.1.1.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			assert.NoError(t, clusterv1.AddToScheme(scheme))
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+				Spec:       clusterv1.ClusterSpec{ControlPlaneEndpoint: tt.clusterEndpoint},
+			}
+			s := &ManagedControlPlaneScope{
+				client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster.DeepCopy()).Build(),
+				Cluster: cluster,
+				GCPManagedControlPlane: &expinfrav1.GCPManagedControlPlane{
+					Spec: expinfrav1.GCPManagedControlPlaneSpec{
+						Endpoint: clusterv1beta1.APIEndpoint{Host: tt.endpoint, Port: APIServerPort},
+					},
+				},
+			}
+
+			assert.NoError(t, s.PatchClusterControlPlaneEndpoint(context.Background()))
+			patched := &clusterv1.Cluster{}
+			assert.NoError(t, s.client.Get(context.Background(), client.ObjectKeyFromObject(cluster), patched))
+			assert.Equal(t, tt.expected, patched.Spec.ControlPlaneEndpoint)
+		})
+	}
+}
@@ -36,6 +36,7 @@ import (
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
+	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -89,6 +90,10 @@ func NewManagedMachinePoolScope(ctx context.Context, params ManagedMachinePoolSc
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to init patch helper")
 	}
+	mpHelper, err := patch.NewHelper(params.MachinePool, params.Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init MachinePool patch helper")
+	}
 
 	return &ManagedMachinePoolScope{
 		client:                 params.Client,
@@ -99,13 +104,16 @@ func NewManagedMachinePoolScope(ctx context.Context, params ManagedMachinePoolSc
 		mcClient:               params.ManagedClusterClient,
 		migClient:              params.InstanceGroupManagersClient,
 		patchHelper:            helper,
+
+		capiMachinePoolPatchHelper: mpHelper,
 	}, nil
 }
 
 // ManagedMachinePoolScope defines the basic context for an actuator to operate upon.
 type ManagedMachinePoolScope struct {
-	client      client.Client
-	patchHelper *v1beta1patch.Helper
+	client                     client.Client
+	patchHelper                *v1beta1patch.Helper
+	capiMachinePoolPatchHelper *patch.Helper
 
 	Cluster                *clusterv1.Cluster
 	MachinePool            *clusterv1.MachinePool
@@ -126,9 +134,18 @@ func (s *ManagedMachinePoolScope) PatchObject() error {
 			infrav1exp.GKEMachinePoolCreatingCondition,
 			infrav1exp.GKEMachinePoolUpdatingCondition,
 			infrav1exp.GKEMachinePoolDeletingCondition,
+			infrav1exp.GKEMachinePoolImportedCondition,
 		}})
 }
 
+// PatchCAPIMachinePoolObject persists the capi machinepool configuration and status.
+func (s *ManagedMachinePoolScope) PatchCAPIMachinePoolObject(ctx context.Context) error {
+	return s.capiMachinePoolPatchHelper.Patch(
+		ctx,
+		s.MachinePool,
+	)
+}
+
 // Close closes the current scope persisting the managed control plane configuration and status.
 func (s *ManagedMachinePoolScope) Close() error {
 	s.mcClient.Close()
@@ -151,11 +168,28 @@ func (s *ManagedMachinePoolScope) InstanceGroupManagersClient() *compute.Instanc
 	return s.migClient
 }
 
+// ServerConfig returns the GKE server config of the location of the cluster, which lists the available versions.
+func (s *ManagedMachinePoolScope) ServerConfig(ctx context.Context) (*containerpb.ServerConfig, error) {
+	return serverConfigs.get(ctx, s.mcClient, fmt.Sprintf("projects/%s/locations/%s", s.GCPManagedControlPlane.Spec.Project, s.GCPManagedControlPlane.Spec.Location))
+}
+
 // NodePoolVersion returns the k8s version of the node pool.
 func (s *ManagedMachinePoolScope) NodePoolVersion() string {
 	return s.MachinePool.Spec.Template.Spec.Version
 }
 
+// DeletionPolicy returns the deletion policy of the GKE node pool. By default, the node pools are deleted, unless the
+// cluster is being deleted and its GKE cluster is orphaned, in which case they are left in place with it.
+func (s *ManagedMachinePoolScope) DeletionPolicy() infrav1exp.DeletionPolicy {
+	if s.GCPManagedMachinePool.Spec.DeletionPolicy != nil {
+		return *s.GCPManagedMachinePool.Spec.DeletionPolicy
+	}
+	if !s.Cluster.DeletionTimestamp.IsZero() && ClusterDeletionPolicy(s.GCPManagedControlPlane) == infrav1exp.DeletionPolicyOrphan {
+		return infrav1exp.DeletionPolicyOrphan
+	}
+	return infrav1exp.DeletionPolicyDelete
+}
+
 // NodePoolResourceLabels returns the resource labels of the node pool.
 func NodePoolResourceLabels(additionalLabels infrav1.Labels, clusterName string) infrav1.Labels {
 	if additionalLabels == nil {
@@ -216,6 +250,31 @@ func ConvertToSdkNodePool(nodePool infrav1exp.GCPManagedMachinePool, machinePool
 	if nodePool.Spec.LinuxNodeConfig != nil {
 		sdkNodePool.Config.LinuxNodeConfig = infrav1exp.ConvertToSdkLinuxNodeConfig(nodePool.Spec.LinuxNodeConfig)
 	}
+	if nodePool.Spec.KubeletConfig != nil {
+		sdkNodePool.Config.KubeletConfig = infrav1exp.ConvertToSdkKubeletConfig(nodePool.Spec.KubeletConfig)
+	}
+	if nodePool.Spec.ContainerdConfig != nil {
+		sdkNodePool.Config.ContainerdConfig = infrav1exp.ConvertToSdkContainerdConfig(nodePool.Spec.ContainerdConfig)
+	}
+	if nodePool.Spec.UpgradeSettings != nil {
+		sdkNodePool.UpgradeSettings = infrav1exp.ConvertToSdkUpgradeSettings(nodePool.Spec.UpgradeSettings)
+	}
+	if len(nodePool.Spec.Accelerators) != 0 {
+		sdkNodePool.Config.Accelerators = infrav1exp.ConvertToSdkAccelerators(nodePool.Spec.Accelerators)
+	}
+	sdkNodePool.Config.Spot = nodePool.Spec.Spot
+	sdkNodePool.Config.Preemptible = nodePool.Spec.Preemptible
+	if nodePool.Spec.ReservationAffinity != nil {
+		sdkNodePool.Config.ReservationAffinity = infrav1exp.ConvertToSdkReservationAffinity(nodePool.Spec.ReservationAffinity)
+	}
+	if nodePool.Spec.PlacementPolicy != nil {
+		sdkNodePool.PlacementPolicy = infrav1exp.ConvertToSdkPlacementPolicy(nodePool.Spec.PlacementPolicy)
+	}
+	if nodePool.Spec.EnableQueuedProvisioning {
+		sdkNodePool.QueuedProvisioning = &containerpb.NodePool_QueuedProvisioning{
+			Enabled: true,
+		}
+	}
 	if nodePool.Spec.Management != nil {
 		sdkNodePool.Management = &containerpb.NodeManagement{
 			AutoRepair:  nodePool.Spec.Management.AutoRepair,
@@ -239,6 +298,9 @@ func ConvertToSdkNodePool(nodePool infrav1exp.GCPManagedMachinePool, machinePool
 	if nodePool.Spec.DiskSizeGB != nil {
 		sdkNodePool.Config.DiskSizeGb = int32(*nodePool.Spec.DiskSizeGB) //nolint:gosec
 	}
+	if nodePool.Spec.BootDiskKMSKey != "" {
+		sdkNodePool.Config.BootDiskKmsKey = nodePool.Spec.BootDiskKMSKey
+	}
 	if len(nodePool.Spec.NodeNetwork.Tags) != 0 {
 		sdkNodePool.Config.Tags = nodePool.Spec.NodeNetwork.Tags
 	}
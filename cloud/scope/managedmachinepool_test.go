@@ -1,10 +1,14 @@
 package scope
 
 import (
+	"time"
+
 	"cloud.google.com/go/container/apiv1/containerpb"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
 	"sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
@@ -54,6 +58,45 @@ var _ = Describe("GCPManagedMachinePool Scope", func() {
 		})
 	})
 
+	Context("Test DeletionPolicy", func() {
+		var machinePoolScope ManagedMachinePoolScope
+
+		BeforeEach(func() {
+			machinePoolScope = ManagedMachinePoolScope{
+				Cluster:                &clusterv1.Cluster{},
+				GCPManagedMachinePool:  TestGCPMMP,
+				GCPManagedControlPlane: &v1beta1.GCPManagedControlPlane{},
+			}
+		})
+
+		It("should delete the node pool by default", func() {
+			Expect(machinePoolScope.DeletionPolicy()).To(Equal(v1beta1.DeletionPolicyDelete))
+		})
+
+		It("should use the deletion policy of the node pool", func() {
+			TestGCPMMP.Spec.DeletionPolicy = ptr.To(v1beta1.DeletionPolicyOrphan)
+			Expect(machinePoolScope.DeletionPolicy()).To(Equal(v1beta1.DeletionPolicyOrphan))
+		})
+
+		It("should delete the node pool of an orphaned cluster when the cluster is not deleted", func() {
+			machinePoolScope.GCPManagedControlPlane.Spec.Import = true
+			Expect(machinePoolScope.DeletionPolicy()).To(Equal(v1beta1.DeletionPolicyDelete))
+		})
+
+		It("should orphan the node pool when its orphaned cluster is deleted", func() {
+			machinePoolScope.GCPManagedControlPlane.Spec.Import = true
+			machinePoolScope.Cluster.DeletionTimestamp = ptr.To(metav1.Now())
+			Expect(machinePoolScope.DeletionPolicy()).To(Equal(v1beta1.DeletionPolicyOrphan))
+		})
+
+		It("should delete the node pool of an imported cluster with the Delete policy", func() {
+			machinePoolScope.GCPManagedControlPlane.Spec.Import = true
+			machinePoolScope.GCPManagedControlPlane.Spec.DeletionPolicy = ptr.To(v1beta1.DeletionPolicyDelete)
+			machinePoolScope.Cluster.DeletionTimestamp = ptr.To(metav1.Now())
+			Expect(machinePoolScope.DeletionPolicy()).To(Equal(v1beta1.DeletionPolicyDelete))
+		})
+	})
+
 	Context("Test MachinePool InfrastructureMachineKind", func() {
 		It("should set infrastructure machine kind when empty", func() {
 			TestGCPMMP.Status = v1beta1.GCPManagedMachinePoolStatus{}
@@ -130,12 +173,14 @@ var _ = Describe("GCPManagedMachinePool Scope", func() {
 				},
 			}
 			resourceLabels := infrav1.Labels{"test-key": "test-value"}
+			bootDiskKMSKey := "projects/test-project/locations/us-central1/keyRings/gke/cryptoKeys/boot-disks"
 
 			TestGCPMMP.Spec.MachineType = &machineType
 			TestGCPMMP.Spec.DiskSizeGb = &diskSizeGb
 			TestGCPMMP.Spec.ImageType = &imageType
 			TestGCPMMP.Spec.LocalSsdCount = &localSsdCount
 			TestGCPMMP.Spec.DiskType = &diskType
+			TestGCPMMP.Spec.BootDiskKMSKey = bootDiskKMSKey
 			TestGCPMMP.Spec.Scaling = &scaling
 			TestGCPMMP.Spec.MaxPodsPerNode = &maxPodsPerNode
 			TestGCPMMP.Spec.KubernetesLabels = labels
@@ -156,6 +201,7 @@ var _ = Describe("GCPManagedMachinePool Scope", func() {
 					ImageType:              imageType,
 					LocalSsdCount:          localSsdCount,
 					DiskType:               string(diskType),
+					BootDiskKmsKey:         bootDiskKMSKey,
 					ShieldedInstanceConfig: &containerpb.ShieldedInstanceConfig{},
 				},
 				Autoscaling: v1beta1.ConvertToSdkAutoscaling(&scaling),
@@ -164,5 +210,132 @@ var _ = Describe("GCPManagedMachinePool Scope", func() {
 				},
 			}))
 		})
+
+		It("should default maxSurge of surge upgrade settings", func() {
+			TestGCPMMP.Spec.UpgradeSettings = &v1beta1.NodePoolUpgradeSettings{
+				Strategy: v1beta1.NodePoolUpgradeStrategySurge,
+			}
+
+			sdkNodePool := ConvertToSdkNodePool(*TestGCPMMP, *TestMP, false, TestClusterName)
+
+			strategy := containerpb.NodePoolUpdateStrategy_SURGE
+			Expect(sdkNodePool.GetUpgradeSettings()).To(Equal(&containerpb.NodePool_UpgradeSettings{
+				Strategy: &strategy,
+				MaxSurge: 1,
+			}))
+		})
+
+		It("should convert to SDK node pool with blue-green upgrade settings", func() {
+			batchPercentage := int32(25)
+			TestGCPMMP.Spec.UpgradeSettings = &v1beta1.NodePoolUpgradeSettings{
+				Strategy: v1beta1.NodePoolUpgradeStrategyBlueGreen,
+				BlueGreenSettings: &v1beta1.NodePoolBlueGreenSettings{
+					BatchPercentage:      &batchPercentage,
+					BatchSoakDuration:    &metav1.Duration{Duration: time.Minute},
+					NodePoolSoakDuration: &metav1.Duration{Duration: time.Hour},
+				},
+			}
+
+			sdkNodePool := ConvertToSdkNodePool(*TestGCPMMP, *TestMP, false, TestClusterName)
+
+			strategy := containerpb.NodePoolUpdateStrategy_BLUE_GREEN
+			Expect(sdkNodePool.GetUpgradeSettings()).To(Equal(&containerpb.NodePool_UpgradeSettings{
+				Strategy: &strategy,
+				BlueGreenSettings: &containerpb.BlueGreenSettings{
+					RolloutPolicy: &containerpb.BlueGreenSettings_StandardRolloutPolicy_{
+						StandardRolloutPolicy: &containerpb.BlueGreenSettings_StandardRolloutPolicy{
+							UpdateBatchSize: &containerpb.BlueGreenSettings_StandardRolloutPolicy_BatchPercentage{
+								BatchPercentage: 0.25,
+							},
+							BatchSoakDuration: durationpb.New(time.Minute),
+						},
+					},
+					NodePoolSoakDuration: durationpb.New(time.Hour),
+				},
+			}))
+		})
+
+		It("should convert to SDK node pool with accelerators and placement", func() {
+			TestGCPMMP.Spec.Accelerators = []v1beta1.Accelerator{
+				{
+					Type:             "nvidia-tesla-a100",
+					Count:            1,
+					GPUDriverVersion: ptr.To(v1beta1.GPUDriverVersionDefault),
+					GPUPartitionSize: "1g.5gb",
+				},
+			}
+			TestGCPMMP.Spec.Spot = true
+			TestGCPMMP.Spec.ReservationAffinity = &v1beta1.ReservationAffinity{
+				ConsumeReservationType: v1beta1.ReservationAffinityTypeNoReservation,
+			}
+			TestGCPMMP.Spec.PlacementPolicy = &v1beta1.PlacementPolicy{
+				Type: v1beta1.PlacementPolicyTypeCompact,
+			}
+			TestGCPMMP.Spec.EnableQueuedProvisioning = true
+
+			sdkNodePool := ConvertToSdkNodePool(*TestGCPMMP, *TestMP, false, TestClusterName)
+
+			gpuDriverVersion := containerpb.GPUDriverInstallationConfig_DEFAULT
+			Expect(sdkNodePool.GetConfig().GetAccelerators()).To(Equal([]*containerpb.AcceleratorConfig{
+				{
+					AcceleratorType:  "nvidia-tesla-a100",
+					AcceleratorCount: 1,
+					GpuPartitionSize: "1g.5gb",
+					GpuDriverInstallationConfig: &containerpb.GPUDriverInstallationConfig{
+						GpuDriverVersion: &gpuDriverVersion,
+					},
+				},
+			}))
+			Expect(sdkNodePool.GetConfig().GetSpot()).To(BeTrue())
+			Expect(sdkNodePool.GetConfig().GetReservationAffinity().GetConsumeReservationType()).To(Equal(containerpb.ReservationAffinity_NO_RESERVATION))
+			Expect(sdkNodePool.GetPlacementPolicy().GetType()).To(Equal(containerpb.NodePool_PlacementPolicy_COMPACT))
+			Expect(sdkNodePool.GetQueuedProvisioning().GetEnabled()).To(BeTrue())
+		})
+
+		It("should convert to SDK node pool with kubelet, hugepages and containerd config", func() {
+			TestGCPMMP.Spec.KubeletConfig = &v1beta1.KubeletConfig{
+				CPUManagerPolicy:  ptr.To(v1beta1.CPUManagerPolicyStatic),
+				CPUCFSQuota:       ptr.To(false),
+				CPUCFSQuotaPeriod: &metav1.Duration{Duration: 50 * time.Millisecond},
+				PodPIDsLimit:      ptr.To[int64](4096),
+				TopologyManager: &v1beta1.TopologyManager{
+					Policy: "single-numa-node",
+					Scope:  "pod",
+				},
+			}
+			TestGCPMMP.Spec.LinuxNodeConfig = &v1beta1.LinuxNodeConfig{
+				Hugepages: &v1beta1.HugepagesConfig{
+					HugepageSize2M: ptr.To[int32](1024),
+				},
+			}
+			TestGCPMMP.Spec.ContainerdConfig = &v1beta1.ContainerdConfig{
+				RegistryMirrors: []v1beta1.RegistryMirror{
+					{
+						Server:  "docker.io",
+						Mirrors: []string{"https://mirror.example.com"},
+					},
+				},
+			}
+
+			sdkNodePool := ConvertToSdkNodePool(*TestGCPMMP, *TestMP, false, TestClusterName)
+
+			kubeletConfig := sdkNodePool.GetConfig().GetKubeletConfig()
+			Expect(kubeletConfig.GetCpuManagerPolicy()).To(Equal("static"))
+			Expect(kubeletConfig.GetCpuCfsQuota().GetValue()).To(BeFalse())
+			Expect(kubeletConfig.GetCpuCfsQuotaPeriod()).To(Equal("50ms"))
+			Expect(kubeletConfig.GetPodPidsLimit()).To(Equal(int64(4096)))
+			Expect(kubeletConfig.GetTopologyManager().GetPolicy()).To(Equal("single-numa-node"))
+			Expect(kubeletConfig.GetTopologyManager().GetScope()).To(Equal("pod"))
+			Expect(sdkNodePool.GetConfig().GetLinuxNodeConfig().GetHugepages().GetHugepageSize2M()).To(Equal(int32(1024)))
+			registryHosts := sdkNodePool.GetConfig().GetContainerdConfig().GetRegistryHosts()
+			Expect(registryHosts).To(HaveLen(1))
+			Expect(registryHosts[0].GetServer()).To(Equal("docker.io"))
+			Expect(registryHosts[0].GetHosts()).To(HaveLen(1))
+			Expect(registryHosts[0].GetHosts()[0].GetHost()).To(Equal("https://mirror.example.com"))
+			Expect(registryHosts[0].GetHosts()[0].GetCapabilities()).To(ConsistOf(
+				containerpb.ContainerdConfig_RegistryHostConfig_HOST_CAPABILITY_PULL,
+				containerpb.ContainerdConfig_RegistryHostConfig_HOST_CAPABILITY_RESOLVE,
+			))
+		})
 	})
 })
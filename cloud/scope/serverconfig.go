@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/googleapis/gax-go/v2"
+	"github.com/pkg/errors"
+)
+
+// serverConfigTTL is how long the GKE server config of a location is cached.
+const serverConfigTTL = time.Hour
+
+// serverConfigGetter gets the GKE server config of a location, it is implemented by the cluster manager client.
+type serverConfigGetter interface {
+	GetServerConfig(ctx context.Context, req *containerpb.GetServerConfigRequest, opts ...gax.CallOption) (*containerpb.ServerConfig, error)
+}
+
+type serverConfigEntry struct {
+	config *containerpb.ServerConfig
+	expiry time.Time
+}
+
+// serverConfigCache caches the GKE server configs, which list the versions available in each location, across the
+// reconciliations of all the clusters.
+type serverConfigCache struct {
+	mu      sync.Mutex
+	entries map[string]serverConfigEntry
+	now     func() time.Time
+}
+
+var serverConfigs = newServerConfigCache()
+
+func newServerConfigCache() *serverConfigCache {
+	return &serverConfigCache{
+		entries: map[string]serverConfigEntry{},
+		now:     time.Now,
+	}
+}
+
+// get returns the server config of the location, which is fetched when it isn't cached or the cached one expired.
+// The cache isn't locked while the server config is fetched, so that a slow location doesn't hold up the others.
+func (c *serverConfigCache) get(ctx context.Context, client serverConfigGetter, location string) (*containerpb.ServerConfig, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[location]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expiry) {
+		return entry.config, nil
+	}
+
+	config, err := client.GetServerConfig(ctx, &containerpb.GetServerConfigRequest{Name: location})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get gke server config of %s", location)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[location] = serverConfigEntry{
+		config: config,
+		expiry: c.now().Add(serverConfigTTL),
+	}
+
+	return config, nil
+}
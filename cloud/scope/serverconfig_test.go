@@ -0,0 +1,84 @@
+package scope
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/googleapis/gax-go/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeServerConfigGetter struct {
+	calls int
+}
+
+func (f *fakeServerConfigGetter) GetServerConfig(_ context.Context, req *containerpb.GetServerConfigRequest, _ ...gax.CallOption) (*containerpb.ServerConfig, error) {
+	f.calls++
+	return &containerpb.ServerConfig{DefaultClusterVersion: req.GetName()}, nil
+}
+
+var _ = Describe("GKE server config cache", func() {
+	var (
+		cache  *serverConfigCache
+		getter *fakeServerConfigGetter
+		now    time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		cache = newServerConfigCache()
+		cache.now = func() time.Time { return now }
+		getter = &fakeServerConfigGetter{}
+	})
+
+	It("should cache the server config per location", func() {
+		config, err := cache.get(context.TODO(), getter, "projects/p/locations/us-central1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.GetDefaultClusterVersion()).To(Equal("projects/p/locations/us-central1"))
+
+		_, err = cache.get(context.TODO(), getter, "projects/p/locations/us-central1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getter.calls).To(Equal(1))
+
+		_, err = cache.get(context.TODO(), getter, "projects/p/locations/europe-west1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getter.calls).To(Equal(2))
+	})
+
+	It("should refresh the server config once it expired", func() {
+		_, err := cache.get(context.TODO(), getter, "projects/p/locations/us-central1")
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(serverConfigTTL)
+		_, err = cache.get(context.TODO(), getter, "projects/p/locations/us-central1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getter.calls).To(Equal(2))
+	})
+
+	It("should not hold up other locations while a server config is fetched", func() {
+		blocking := &blockingServerConfigGetter{release: make(chan struct{})}
+		defer close(blocking.release)
+		go func() {
+			_, _ = cache.get(context.TODO(), blocking, "projects/p/locations/us-central1")
+		}()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = cache.get(context.TODO(), getter, "projects/p/locations/europe-west1")
+		}()
+		Eventually(done).Should(BeClosed())
+	})
+})
+
+// blockingServerConfigGetter blocks GetServerConfig until it is released.
+type blockingServerConfigGetter struct {
+	release chan struct{}
+}
+
+func (f *blockingServerConfigGetter) GetServerConfig(_ context.Context, _ *containerpb.GetServerConfigRequest, _ ...gax.CallOption) (*containerpb.ServerConfig, error) {
+	<-f.release
+	return &containerpb.ServerConfig{}, nil
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/compute/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+	"sigs.k8s.io/cluster-api-provider-gcp/pkg/gcp"
+)
+
+// Reconcile reconciles the GCP autoscaler resource of the given instanceGroupManager.
+// It returns nil if autoscaling is not enabled.
+func (s *Service) Reconcile(ctx context.Context, igm *compute.InstanceGroupManager) (*compute.Autoscaler, error) {
+	log := log.FromContext(ctx)
+
+	desired, err := s.scope.AutoscalerResource(igm.SelfLink)
+	if err != nil {
+		return nil, err
+	}
+	if desired == nil {
+		return nil, nil
+	}
+
+	key, err := s.scope.AutoscalerResourceName()
+	if err != nil {
+		return nil, err
+	}
+
+	selfLink := gcp.FormatKey("autoscalers", key)
+	log = log.WithValues("autoscaler", selfLink)
+	log.Info("Reconciling autoscaler resources")
+
+	actual, err := s.autoscalers.Get(ctx, key)
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for autoscaler")
+			return nil, fmt.Errorf("getting autoscaler %v: %w", selfLink, err)
+		}
+
+		log.V(2).Info("Creating autoscaler")
+		if err := s.autoscalers.Insert(ctx, key, desired); err != nil {
+			log.Error(err, "Error creating autoscaler")
+			return nil, fmt.Errorf("creating autoscaler %v: %w", selfLink, err)
+		}
+
+		return s.get(ctx, selfLink, key)
+	}
+
+	if actual.Target != desired.Target || autoscalingPolicyNeedsUpdate(desired.AutoscalingPolicy, actual.AutoscalingPolicy) {
+		log.V(2).Info("Updating autoscaler", "autoscalingPolicy", desired.AutoscalingPolicy)
+		if err := s.autoscalers.Update(ctx, key, desired); err != nil {
+			log.Error(err, "Error updating autoscaler")
+			return nil, fmt.Errorf("updating autoscaler %v: %w", selfLink, err)
+		}
+
+		return s.get(ctx, selfLink, key)
+	}
+
+	return actual, nil
+}
+
+// Delete deletes the GCP autoscaler resource.
+func (s *Service) Delete(ctx context.Context) error {
+	key, err := s.scope.AutoscalerResourceName()
+	if err != nil {
+		return err
+	}
+
+	return s.delete(ctx, key)
+}
+
+// DeletePrevious deletes the autoscaler of the previous instanceGroupManager of the machine pool, if any.
+func (s *Service) DeletePrevious(ctx context.Context) error {
+	key, err := s.scope.PreviousAutoscalerResourceName()
+	if err != nil || key == nil {
+		return err
+	}
+
+	return s.delete(ctx, key)
+}
+
+func (s *Service) delete(ctx context.Context, key *meta.Key) error {
+	log := log.FromContext(ctx)
+
+	selfLink := gcp.FormatKey("autoscalers", key)
+	log = log.WithValues("autoscaler", selfLink)
+	log.Info("Deleting autoscaler resources")
+
+	// The autoscaler name is derived from the cluster and machine pool name, so if it exists, we assume we own it.
+	if err := s.autoscalers.Delete(ctx, key); err != nil {
+		if gcperrors.IsNotFound(err) {
+			log.V(2).Info("autoscaler not found, assuming already deleted")
+			return nil
+		}
+		log.Error(err, "Error deleting autoscaler")
+		return fmt.Errorf("deleting autoscaler %v: %w", selfLink, err)
+	}
+
+	return nil
+}
+
+// get fetches the autoscaler to return the complete object after it was created or updated.
+func (s *Service) get(ctx context.Context, selfLink string, key *meta.Key) (*compute.Autoscaler, error) {
+	autoscaler, err := s.autoscalers.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("getting autoscaler %v: %w", selfLink, err)
+	}
+
+	return autoscaler, nil
+}
+
+// autoscalingPolicyNeedsUpdate returns true if the actual autoscaling policy differs from the desired one.
+func autoscalingPolicyNeedsUpdate(desired, actual *compute.AutoscalingPolicy) bool {
+	if actual == nil {
+		return true
+	}
+
+	if desired.MinNumReplicas != actual.MinNumReplicas ||
+		desired.MaxNumReplicas != actual.MaxNumReplicas ||
+		desired.CoolDownPeriodSec != actual.CoolDownPeriodSec ||
+		desired.Mode != actual.Mode {
+		return true
+	}
+
+	return cpuUtilizationNeedsUpdate(desired, actual) ||
+		loadBalancingUtilizationNeedsUpdate(desired.LoadBalancingUtilization, actual.LoadBalancingUtilization) ||
+		customMetricUtilizationsNeedsUpdate(desired.CustomMetricUtilizations, actual.CustomMetricUtilizations) ||
+		scaleInControlNeedsUpdate(desired.ScaleInControl, actual.ScaleInControl)
+}
+
+// cpuUtilizationNeedsUpdate returns true if the actual CPU utilization target differs from the desired one.
+// GCP scales on a default CPU utilization target when no other signal is set, so an actual
+// target is only removed when the desired policy uses other signals.
+func cpuUtilizationNeedsUpdate(desired, actual *compute.AutoscalingPolicy) bool {
+	if desired.CpuUtilization == nil {
+		return actual.CpuUtilization != nil &&
+			(desired.LoadBalancingUtilization != nil || len(desired.CustomMetricUtilizations) > 0)
+	}
+	if actual.CpuUtilization == nil {
+		return true
+	}
+
+	predictiveMethod := func(method string) string {
+		if method == "" {
+			return "NONE"
+		}
+		return method
+	}
+
+	return desired.CpuUtilization.UtilizationTarget != actual.CpuUtilization.UtilizationTarget ||
+		predictiveMethod(desired.CpuUtilization.PredictiveMethod) != predictiveMethod(actual.CpuUtilization.PredictiveMethod)
+}
+
+func loadBalancingUtilizationNeedsUpdate(desired, actual *compute.AutoscalingPolicyLoadBalancingUtilization) bool {
+	if desired == nil || actual == nil {
+		return (desired == nil) != (actual == nil)
+	}
+
+	return desired.UtilizationTarget != actual.UtilizationTarget
+}
+
+func customMetricUtilizationsNeedsUpdate(desired, actual []*compute.AutoscalingPolicyCustomMetricUtilization) bool {
+	if len(desired) != len(actual) {
+		return true
+	}
+	for i := range desired {
+		if desired[i].Metric != actual[i].Metric ||
+			desired[i].Filter != actual[i].Filter ||
+			desired[i].UtilizationTarget != actual[i].UtilizationTarget ||
+			desired[i].UtilizationTargetType != actual[i].UtilizationTargetType ||
+			desired[i].SingleInstanceAssignment != actual[i].SingleInstanceAssignment {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scaleInControlNeedsUpdate(desired, actual *compute.AutoscalingPolicyScaleInControl) bool {
+	if desired == nil {
+		return actual != nil && (actual.MaxScaledInReplicas != nil || actual.TimeWindowSec != 0)
+	}
+	if actual == nil || actual.MaxScaledInReplicas == nil || desired.TimeWindowSec != actual.TimeWindowSec {
+		return true
+	}
+
+	return desired.MaxScaledInReplicas.Fixed != actual.MaxScaledInReplicas.Fixed ||
+		desired.MaxScaledInReplicas.Percent != actual.MaxScaledInReplicas.Percent
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalers
+
+import (
+	"testing"
+
+	"google.golang.org/api/compute/v1"
+)
+
+func TestAutoscalingPolicyNeedsUpdate(t *testing.T) {
+	desired := &compute.AutoscalingPolicy{
+		MinNumReplicas:    1,
+		MaxNumReplicas:    10,
+		Mode:              "ON",
+		CoolDownPeriodSec: 60,
+		LoadBalancingUtilization: &compute.AutoscalingPolicyLoadBalancingUtilization{
+			UtilizationTarget: 0.8,
+		},
+		ScaleInControl: &compute.AutoscalingPolicyScaleInControl{
+			MaxScaledInReplicas: &compute.FixedOrPercent{Fixed: 2},
+			TimeWindowSec:       600,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		actual *compute.AutoscalingPolicy
+		want   bool
+	}{
+		{
+			name: "up to date",
+			actual: &compute.AutoscalingPolicy{
+				MinNumReplicas:    1,
+				MaxNumReplicas:    10,
+				Mode:              "ON",
+				CoolDownPeriodSec: 60,
+				LoadBalancingUtilization: &compute.AutoscalingPolicyLoadBalancingUtilization{
+					UtilizationTarget: 0.8,
+				},
+				ScaleInControl: &compute.AutoscalingPolicyScaleInControl{
+					MaxScaledInReplicas: &compute.FixedOrPercent{Fixed: 2, Calculated: 2},
+					TimeWindowSec:       600,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "different max replicas",
+			actual: &compute.AutoscalingPolicy{
+				MinNumReplicas:    1,
+				MaxNumReplicas:    5,
+				Mode:              "ON",
+				CoolDownPeriodSec: 60,
+				LoadBalancingUtilization: &compute.AutoscalingPolicyLoadBalancingUtilization{
+					UtilizationTarget: 0.8,
+				},
+				ScaleInControl: &compute.AutoscalingPolicyScaleInControl{
+					MaxScaledInReplicas: &compute.FixedOrPercent{Fixed: 2},
+					TimeWindowSec:       600,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "CPU utilization to remove",
+			actual: &compute.AutoscalingPolicy{
+				MinNumReplicas:    1,
+				MaxNumReplicas:    10,
+				Mode:              "ON",
+				CoolDownPeriodSec: 60,
+				CpuUtilization: &compute.AutoscalingPolicyCpuUtilization{
+					UtilizationTarget: 0.6,
+				},
+				LoadBalancingUtilization: &compute.AutoscalingPolicyLoadBalancingUtilization{
+					UtilizationTarget: 0.8,
+				},
+				ScaleInControl: &compute.AutoscalingPolicyScaleInControl{
+					MaxScaledInReplicas: &compute.FixedOrPercent{Fixed: 2},
+					TimeWindowSec:       600,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "no scale-in control",
+			actual: &compute.AutoscalingPolicy{
+				MinNumReplicas:    1,
+				MaxNumReplicas:    10,
+				Mode:              "ON",
+				CoolDownPeriodSec: 60,
+				LoadBalancingUtilization: &compute.AutoscalingPolicyLoadBalancingUtilization{
+					UtilizationTarget: 0.8,
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := autoscalingPolicyNeedsUpdate(desired, tt.actual); got != tt.want {
+				t.Errorf("autoscalingPolicyNeedsUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoscalingPolicyNeedsUpdateDefaultCPUUtilization(t *testing.T) {
+	// GCP scales on a default CPU utilization target when no other signal is set.
+	desired := &compute.AutoscalingPolicy{MinNumReplicas: 0, MaxNumReplicas: 3, Mode: "ON", CoolDownPeriodSec: 60}
+	actual := &compute.AutoscalingPolicy{
+		MinNumReplicas:    0,
+		MaxNumReplicas:    3,
+		Mode:              "ON",
+		CoolDownPeriodSec: 60,
+		CpuUtilization:    &compute.AutoscalingPolicyCpuUtilization{UtilizationTarget: 0.6, PredictiveMethod: "NONE"},
+	}
+
+	if autoscalingPolicyNeedsUpdate(desired, actual) {
+		t.Errorf("autoscalingPolicyNeedsUpdate() = true, want false")
+	}
+}
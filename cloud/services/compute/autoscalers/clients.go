@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalers
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	compute "google.golang.org/api/compute/v1"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+// autoscalers is a client for zonal and regional autoscalers, which are not supported by
+// the k8s-cloud-provider client, using the compute API directly.
+type autoscalers struct {
+	service *cloud.ComputeService
+}
+
+func (c *autoscalers) projectID(ctx context.Context) string {
+	return c.service.ProjectRouter.ProjectID(ctx, meta.VersionGA, "autoscalers")
+}
+
+// Get returns the autoscaler.
+func (c *autoscalers) Get(ctx context.Context, key *meta.Key) (*compute.Autoscaler, error) {
+	if key.Type() == meta.Regional {
+		return c.service.GA.RegionAutoscalers.Get(c.projectID(ctx), key.Region, key.Name).Context(ctx).Do()
+	}
+
+	return c.service.GA.Autoscalers.Get(c.projectID(ctx), key.Zone, key.Name).Context(ctx).Do()
+}
+
+// Insert creates the autoscaler and waits for the operation to complete.
+func (c *autoscalers) Insert(ctx context.Context, key *meta.Key, obj *compute.Autoscaler) error {
+	obj.Name = key.Name
+
+	var op *compute.Operation
+	var err error
+	if key.Type() == meta.Regional {
+		op, err = c.service.GA.RegionAutoscalers.Insert(c.projectID(ctx), key.Region, obj).Context(ctx).Do()
+	} else {
+		op, err = c.service.GA.Autoscalers.Insert(c.projectID(ctx), key.Zone, obj).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
+
+// Update replaces the autoscaler and waits for the operation to complete.
+func (c *autoscalers) Update(ctx context.Context, key *meta.Key, obj *compute.Autoscaler) error {
+	obj.Name = key.Name
+
+	var op *compute.Operation
+	var err error
+	if key.Type() == meta.Regional {
+		op, err = c.service.GA.RegionAutoscalers.Update(c.projectID(ctx), key.Region, obj).Autoscaler(key.Name).Context(ctx).Do()
+	} else {
+		op, err = c.service.GA.Autoscalers.Update(c.projectID(ctx), key.Zone, obj).Autoscaler(key.Name).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
+
+// Delete deletes the autoscaler and waits for the operation to complete.
+func (c *autoscalers) Delete(ctx context.Context, key *meta.Key) error {
+	var op *compute.Operation
+	var err error
+	if key.Type() == meta.Regional {
+		op, err = c.service.GA.RegionAutoscalers.Delete(c.projectID(ctx), key.Region, key.Name).Context(ctx).Do()
+	} else {
+		op, err = c.service.GA.Autoscalers.Delete(c.projectID(ctx), key.Zone, key.Name).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
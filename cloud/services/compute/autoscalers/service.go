@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package autoscalers implements reconciliation for autoscaler GCP resources.
+package autoscalers
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	compute "google.golang.org/api/compute/v1"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type autoscalersClient interface {
+	Get(ctx context.Context, key *meta.Key) (*compute.Autoscaler, error)
+	Insert(ctx context.Context, key *meta.Key, obj *compute.Autoscaler) error
+	Update(ctx context.Context, key *meta.Key, obj *compute.Autoscaler) error
+	Delete(ctx context.Context, key *meta.Key) error
+}
+
+// Scope is an interfaces that hold used methods.
+type Scope interface {
+	ComputeService() *cloud.ComputeService
+
+	// AutoscalerResource returns the desired autoscaler targeting the given instanceGroupManager, or nil if autoscaling is disabled
+	AutoscalerResource(target string) (*compute.Autoscaler, error)
+
+	// AutoscalerResourceName returns the key of the autoscaler
+	AutoscalerResourceName() (*meta.Key, error)
+
+	// PreviousAutoscalerResourceName returns the key of the autoscaler of the previous instanceGroupManager, or nil
+	PreviousAutoscalerResourceName() (*meta.Key, error)
+}
+
+// Service implements autoscalers reconciler.
+type Service struct {
+	scope       Scope
+	autoscalers autoscalersClient
+}
+
+// New returns Service from given scope.
+func New(scope Scope) *Service {
+	return &Service{
+		scope: scope,
+		autoscalers: &autoscalers{
+			service: scope.ComputeService(),
+		},
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroupmanagers
+
+import (
+	"context"
+
+	k8scloud "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	computebeta "google.golang.org/api/compute/v0.beta"
+	compute "google.golang.org/api/compute/v1"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+// instanceGroupManagers extends the k8s-cloud-provider InstanceGroupManagers client with
+// regional instanceGroupManagers and the calls it does not support, using the compute API directly.
+// Zonal keys are served by the k8s-cloud-provider client, regional keys by the compute API.
+type instanceGroupManagers struct {
+	k8scloud.InstanceGroupManagers
+	service *cloud.ComputeService
+	// betaService returns the compute API including the beta API, only created when the beta API is used.
+	betaService func(ctx context.Context) (*cloud.ComputeService, error)
+}
+
+func (c *instanceGroupManagers) projectID(ctx context.Context) string {
+	return c.service.ProjectRouter.ProjectID(ctx, meta.VersionGA, "instanceGroupManagers")
+}
+
+// Get returns the instanceGroupManager.
+func (c *instanceGroupManagers) Get(ctx context.Context, key *meta.Key, options ...k8scloud.Option) (*compute.InstanceGroupManager, error) {
+	if key.Type() != meta.Regional {
+		return c.InstanceGroupManagers.Get(ctx, key, options...)
+	}
+
+	return c.service.GA.RegionInstanceGroupManagers.Get(c.projectID(ctx), key.Region, key.Name).Context(ctx).Do()
+}
+
+// Insert creates the instanceGroupManager and waits for the operation to complete.
+func (c *instanceGroupManagers) Insert(ctx context.Context, key *meta.Key, obj *compute.InstanceGroupManager, options ...k8scloud.Option) error {
+	if key.Type() != meta.Regional {
+		return c.InstanceGroupManagers.Insert(ctx, key, obj, options...)
+	}
+
+	obj.Name = key.Name
+	op, err := c.service.GA.RegionInstanceGroupManagers.Insert(c.projectID(ctx), key.Region, obj).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
+
+// Delete deletes the instanceGroupManager and waits for the operation to complete.
+func (c *instanceGroupManagers) Delete(ctx context.Context, key *meta.Key, options ...k8scloud.Option) error {
+	if key.Type() != meta.Regional {
+		return c.InstanceGroupManagers.Delete(ctx, key, options...)
+	}
+
+	op, err := c.service.GA.RegionInstanceGroupManagers.Delete(c.projectID(ctx), key.Region, key.Name).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
+
+// Resize resizes the instanceGroupManager and waits for the operation to complete.
+func (c *instanceGroupManagers) Resize(ctx context.Context, key *meta.Key, size int64, options ...k8scloud.Option) error {
+	if key.Type() != meta.Regional {
+		return c.InstanceGroupManagers.Resize(ctx, key, size, options...)
+	}
+
+	op, err := c.service.GA.RegionInstanceGroupManagers.Resize(c.projectID(ctx), key.Region, key.Name, size).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
+
+// SetInstanceTemplate sets the instance template of the instanceGroupManager and waits for the operation to complete.
+func (c *instanceGroupManagers) SetInstanceTemplate(ctx context.Context, key *meta.Key, req *compute.InstanceGroupManagersSetInstanceTemplateRequest, options ...k8scloud.Option) error {
+	if key.Type() != meta.Regional {
+		return c.InstanceGroupManagers.SetInstanceTemplate(ctx, key, req, options...)
+	}
+
+	op, err := c.service.GA.RegionInstanceGroupManagers.SetInstanceTemplate(c.projectID(ctx), key.Region, key.Name, &compute.RegionInstanceGroupManagersSetTemplateRequest{
+		InstanceTemplate: req.InstanceTemplate,
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
+
+// Patch patches the instanceGroupManager and waits for the operation to complete.
+func (c *instanceGroupManagers) Patch(ctx context.Context, key *meta.Key, obj *compute.InstanceGroupManager) error {
+	var op *compute.Operation
+	var err error
+	if key.Type() == meta.Regional {
+		op, err = c.service.GA.RegionInstanceGroupManagers.Patch(c.projectID(ctx), key.Region, key.Name, obj).Context(ctx).Do()
+	} else {
+		op, err = c.service.GA.InstanceGroupManagers.Patch(c.projectID(ctx), key.Zone, key.Name, obj).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
+
+// GetBeta returns the instanceGroupManager from the beta API, for fields not available in the GA API.
+func (c *instanceGroupManagers) GetBeta(ctx context.Context, key *meta.Key) (*computebeta.InstanceGroupManager, error) {
+	service, err := c.betaService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if key.Type() == meta.Regional {
+		return service.Beta.RegionInstanceGroupManagers.Get(c.projectID(ctx), key.Region, key.Name).Context(ctx).Do()
+	}
+
+	return service.Beta.InstanceGroupManagers.Get(c.projectID(ctx), key.Zone, key.Name).Context(ctx).Do()
+}
+
+// PatchBeta patches the instanceGroupManager through the beta API and waits for the operation to complete.
+func (c *instanceGroupManagers) PatchBeta(ctx context.Context, key *meta.Key, obj *computebeta.InstanceGroupManager) error {
+	service, err := c.betaService(ctx)
+	if err != nil {
+		return err
+	}
+	var op *computebeta.Operation
+	if key.Type() == meta.Regional {
+		op, err = service.Beta.RegionInstanceGroupManagers.Patch(c.projectID(ctx), key.Region, key.Name, obj).Context(ctx).Do()
+	} else {
+		op, err = service.Beta.InstanceGroupManagers.Patch(c.projectID(ctx), key.Zone, key.Name, obj).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+
+	return service.WaitForCompletion(ctx, op)
+}
+
+// DeleteInstances deletes the given instances from the instanceGroupManager, decreasing its target size,
+// and waits for the operation to complete.
+func (c *instanceGroupManagers) DeleteInstances(ctx context.Context, key *meta.Key, req *compute.InstanceGroupManagersDeleteInstancesRequest, options ...k8scloud.Option) error {
+	if key.Type() != meta.Regional {
+		return c.InstanceGroupManagers.DeleteInstances(ctx, key, req, options...)
+	}
+
+	op, err := c.service.GA.RegionInstanceGroupManagers.DeleteInstances(c.projectID(ctx), key.Region, key.Name, &compute.RegionInstanceGroupManagersDeleteInstancesRequest{
+		Instances:                      req.Instances,
+		SkipInstancesOnValidationError: req.SkipInstancesOnValidationError,
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
+
+// ListManagedInstances lists the instances managed by the instanceGroupManager, along with
+// their current action and the instance template version they run.
+func (c *instanceGroupManagers) ListManagedInstances(ctx context.Context, key *meta.Key) ([]*compute.ManagedInstance, error) {
+	var instances []*compute.ManagedInstance
+	if key.Type() == meta.Regional {
+		call := c.service.GA.RegionInstanceGroupManagers.ListManagedInstances(c.projectID(ctx), key.Region, key.Name)
+		if err := call.Pages(ctx, func(page *compute.RegionInstanceGroupManagersListInstancesResponse) error {
+			instances = append(instances, page.ManagedInstances...)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		return instances, nil
+	}
+
+	call := c.service.GA.InstanceGroupManagers.ListManagedInstances(c.projectID(ctx), key.Zone, key.Name)
+	if err := call.Pages(ctx, func(page *compute.InstanceGroupManagersListManagedInstancesResponse) error {
+		instances = append(instances, page.ManagedInstances...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
+// instanceGroups extends the k8s-cloud-provider InstanceGroups client with regional instanceGroups,
+// using the compute API directly.
+type instanceGroups struct {
+	k8scloud.InstanceGroups
+	service *cloud.ComputeService
+}
+
+// ListInstances lists the instances in the instanceGroup.
+// Filters are only supported for zonal instanceGroups.
+func (c *instanceGroups) ListInstances(ctx context.Context, key *meta.Key, req *compute.InstanceGroupsListInstancesRequest, fl *filter.F, options ...k8scloud.Option) ([]*compute.InstanceWithNamedPorts, error) {
+	if key.Type() != meta.Regional {
+		return c.InstanceGroups.ListInstances(ctx, key, req, fl, options...)
+	}
+
+	projectID := c.service.ProjectRouter.ProjectID(ctx, meta.VersionGA, "instanceGroups")
+	call := c.service.GA.RegionInstanceGroups.ListInstances(projectID, key.Region, key.Name, &compute.RegionInstanceGroupsListInstancesRequest{
+		InstanceState: req.InstanceState,
+	})
+
+	var instances []*compute.InstanceWithNamedPorts
+	if err := call.Pages(ctx, func(page *compute.RegionInstanceGroupsListInstances) error {
+		instances = append(instances, page.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
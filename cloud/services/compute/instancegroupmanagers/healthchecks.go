@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroupmanagers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/api/compute/v1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcileHealthCheck creates or updates the health check used for autohealing.
+func (s *Service) reconcileHealthCheck(ctx context.Context, desired *compute.HealthCheck) error {
+	log := log.FromContext(ctx)
+
+	key := s.scope.AutoHealingHealthCheckResourceName()
+	log = log.WithValues("healthCheck", key.Name)
+
+	log.V(2).Info("Looking for autohealing healthCheck")
+	actual, err := s.healthChecks.Get(ctx, key)
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			return fmt.Errorf("getting healthCheck %q: %w", key.Name, err)
+		}
+
+		log.V(2).Info("Creating autohealing healthCheck")
+		if err := s.healthChecks.Insert(ctx, key, desired); err != nil {
+			return fmt.Errorf("creating healthCheck %q: %w", key.Name, err)
+		}
+		return nil
+	}
+
+	if healthCheckNeedsUpdate(desired, actual) {
+		log.V(2).Info("Updating autohealing healthCheck")
+		if err := s.healthChecks.Update(ctx, key, desired); err != nil {
+			return fmt.Errorf("updating healthCheck %q: %w", key.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileHealthCheckFirewall creates or updates the firewall rule allowing the autohealing health check probes.
+func (s *Service) reconcileHealthCheckFirewall(ctx context.Context, desired *compute.Firewall) error {
+	log := log.FromContext(ctx)
+
+	key := s.scope.AutoHealingFirewallResourceName()
+	log = log.WithValues("firewall", key.Name)
+
+	log.V(2).Info("Looking for autohealing firewall")
+	actual, err := s.firewalls.Get(ctx, key)
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			return fmt.Errorf("getting firewall %q: %w", key.Name, err)
+		}
+
+		log.V(2).Info("Creating autohealing firewall")
+		if err := s.firewalls.Insert(ctx, key, desired); err != nil {
+			return fmt.Errorf("creating firewall %q: %w", key.Name, err)
+		}
+		return nil
+	}
+
+	if firewallNeedsUpdate(desired, actual) {
+		log.V(2).Info("Updating autohealing firewall")
+		if err := s.firewalls.Update(ctx, key, desired); err != nil {
+			return fmt.Errorf("updating firewall %q: %w", key.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteHealthCheck deletes the health check used for autohealing and the firewall rule allowing its probes, if they
+// exist. The health check must not be referenced by the instanceGroupManager anymore.
+func (s *Service) deleteHealthCheck(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	key := s.scope.AutoHealingHealthCheckResourceName()
+
+	log.V(2).Info("Deleting autohealing healthCheck", "healthCheck", key.Name)
+	if err := s.healthChecks.Delete(ctx, key); err != nil && !gcperrors.IsNotFound(err) {
+		return fmt.Errorf("deleting healthCheck %q: %w", key.Name, err)
+	}
+
+	if s.scope.SkipFirewallRulesManagement() {
+		return nil
+	}
+
+	firewallKey := s.scope.AutoHealingFirewallResourceName()
+
+	log.V(2).Info("Deleting autohealing firewall", "firewall", firewallKey.Name)
+	if err := s.firewalls.Delete(ctx, firewallKey); err != nil && !gcperrors.IsNotFound(err) {
+		return fmt.Errorf("deleting firewall %q: %w", firewallKey.Name, err)
+	}
+
+	return nil
+}
+
+// firewallNeedsUpdate returns true if the ports or the target tags of the actual firewall rule differ from the desired
+// ones.
+func firewallNeedsUpdate(desired, actual *compute.Firewall) bool {
+	if len(actual.Allowed) != 1 || !slices.Equal(desired.Allowed[0].Ports, actual.Allowed[0].Ports) {
+		return true
+	}
+
+	return !slices.Equal(desired.TargetTags, actual.TargetTags)
+}
+
+// healthCheckNeedsUpdate returns true if the settings of the actual health check differ from the desired ones.
+func healthCheckNeedsUpdate(desired, actual *compute.HealthCheck) bool {
+	if desired.Type != actual.Type ||
+		desired.CheckIntervalSec != actual.CheckIntervalSec ||
+		desired.TimeoutSec != actual.TimeoutSec ||
+		desired.HealthyThreshold != actual.HealthyThreshold ||
+		desired.UnhealthyThreshold != actual.UnhealthyThreshold {
+		return true
+	}
+
+	switch desired.Type {
+	case "HTTP":
+		return actual.HttpHealthCheck == nil ||
+			desired.HttpHealthCheck.Port != actual.HttpHealthCheck.Port ||
+			desired.HttpHealthCheck.RequestPath != actual.HttpHealthCheck.RequestPath
+	case "HTTPS":
+		return actual.HttpsHealthCheck == nil ||
+			desired.HttpsHealthCheck.Port != actual.HttpsHealthCheck.Port ||
+			desired.HttpsHealthCheck.RequestPath != actual.HttpsHealthCheck.RequestPath
+	default:
+		return actual.TcpHealthCheck == nil ||
+			desired.TcpHealthCheck.Port != actual.TcpHealthCheck.Port
+	}
+}
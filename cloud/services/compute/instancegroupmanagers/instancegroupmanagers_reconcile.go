@@ -19,13 +19,18 @@ package instancegroupmanagers
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	computebeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
 	"sigs.k8s.io/cluster-api-provider-gcp/pkg/gcp"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/resourceurl"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -33,6 +38,19 @@ import (
 func (s *Service) Reconcile(ctx context.Context, instanceTemplateKey *meta.Key) (*compute.InstanceGroupManager, error) {
 	log := log.FromContext(ctx)
 	log.Info("Reconciling instanceGroupManager resources")
+
+	// The autohealing health check must exist before the instanceGroupManager references it.
+	if healthCheck := s.scope.AutoHealingHealthCheckResource(); healthCheck != nil {
+		if err := s.reconcileHealthCheck(ctx, healthCheck); err != nil {
+			return nil, err
+		}
+	}
+	if firewall := s.scope.AutoHealingFirewallResource(); firewall != nil {
+		if err := s.reconcileHealthCheckFirewall(ctx, firewall); err != nil {
+			return nil, err
+		}
+	}
+
 	igm, err := s.createOrGet(ctx, instanceTemplateKey)
 	if err != nil {
 		return nil, err
@@ -44,13 +62,33 @@ func (s *Service) Reconcile(ctx context.Context, instanceTemplateKey *meta.Key)
 
 // Delete deletes the GCP instanceGroupManager resource.
 func (s *Service) Delete(ctx context.Context) error {
-	log := log.FromContext(ctx)
-
 	igmKey, err := s.scope.InstanceGroupManagerResourceName()
 	if err != nil {
 		return err
 	}
 
+	if err := s.delete(ctx, igmKey); err != nil {
+		return err
+	}
+
+	return s.deleteHealthCheck(ctx)
+}
+
+// DeletePrevious deletes the previous instanceGroupManager of the machine pool and its instances, if any. The
+// instanceGroupManager moves between a zonal and a regional one when the machine pool moves between a single zone and
+// several zones.
+func (s *Service) DeletePrevious(ctx context.Context) error {
+	igmKey, err := s.scope.PreviousInstanceGroupManagerResourceName()
+	if err != nil || igmKey == nil {
+		return err
+	}
+
+	return s.delete(ctx, igmKey)
+}
+
+func (s *Service) delete(ctx context.Context, igmKey *meta.Key) error {
+	log := log.FromContext(ctx)
+
 	selfLink := gcp.FormatKey("instanceGroupManagers", igmKey)
 
 	log = log.WithValues("instanceGroupManager", selfLink)
@@ -114,7 +152,17 @@ func (s *Service) createOrGet(ctx context.Context, instanceTemplateKey *meta.Key
 		}
 
 		log.V(2).Info("Creating instanceGroupManager")
-		if err := s.instanceGroupManagers.Insert(ctx, igmKey, desired); err != nil {
+		insert := desired
+		if s.scope.ProvisioningModelMix() != nil || s.scope.TargetSizePolicy() != nil {
+			// The provisioning model mix and the target size policy are set separately, through the beta API.
+			// The instanceGroupManager is created empty so that all instances are created according to them,
+			// and resized below.
+			withoutInstances := *desired
+			withoutInstances.TargetSize = 0
+			withoutInstances.ForceSendFields = append(slices.Clone(desired.ForceSendFields), "TargetSize")
+			insert = &withoutInstances
+		}
+		if err := s.instanceGroupManagers.Insert(ctx, igmKey, insert); err != nil {
 			log.Error(err, "creating instanceGroupManager")
 			return nil, fmt.Errorf("creating instanceGroupManager %v: %w", selfLink, err)
 		}
@@ -129,7 +177,12 @@ func (s *Service) createOrGet(ctx context.Context, instanceTemplateKey *meta.Key
 		}
 	}
 
-	if desired.TargetSize != actual.TargetSize {
+	if err := s.reconcileBetaSettings(ctx, igmKey, actual); err != nil {
+		return nil, err
+	}
+
+	// When autoscaling is enabled, the autoscaler resizes the instanceGroupManager.
+	if !s.scope.ReplicasManagedByAutoscaler() && desired.TargetSize != actual.TargetSize {
 		log.V(2).Info("resizing instanceGroupManager", "targetSize", desired.TargetSize)
 		if err := s.instanceGroupManagers.Resize(ctx, igmKey, desired.TargetSize); err != nil {
 			log.Error(err, "resizing instanceGroupManager")
@@ -139,6 +192,70 @@ func (s *Service) createOrGet(ctx context.Context, instanceTemplateKey *meta.Key
 		actual.TargetSize = desired.TargetSize
 	}
 
+	// The update policy is applied before the instance template, so that a template change
+	// is rolled out with the desired policy.
+	// The update policy and the distribution target shape are patched together, as some
+	// target shapes require proactive instance redistribution to be disabled.
+	// The stateful policy is patched along, as stateful groups require instances to be recreated.
+	var patch *compute.InstanceGroupManager
+	newPatch := func() *compute.InstanceGroupManager {
+		if patch == nil {
+			patch = &compute.InstanceGroupManager{}
+		}
+		return patch
+	}
+	if updatePolicyNeedsUpdate(desired.UpdatePolicy, actual.UpdatePolicy) {
+		newPatch().UpdatePolicy = desired.UpdatePolicy
+	}
+	if targetShapeNeedsUpdate(desired.DistributionPolicy, actual.DistributionPolicy) {
+		newPatch().DistributionPolicy = &compute.DistributionPolicy{TargetShape: desired.DistributionPolicy.TargetShape}
+	}
+	removeAutoHealing := len(desired.AutoHealingPolicies) == 0 && len(actual.AutoHealingPolicies) > 0
+	if autoHealingPoliciesNeedsUpdate(desired.AutoHealingPolicies, actual.AutoHealingPolicies) {
+		newPatch().AutoHealingPolicies = desired.AutoHealingPolicies
+		if removeAutoHealing {
+			patch.ForceSendFields = append(patch.ForceSendFields, "AutoHealingPolicies")
+		}
+	}
+	if statefulPolicy := statefulPolicyPatch(desired.StatefulPolicy, actual.StatefulPolicy); statefulPolicy != nil {
+		newPatch().StatefulPolicy = statefulPolicy
+	}
+	if flexibilityPolicy := instanceFlexibilityPolicyPatch(desired.InstanceFlexibilityPolicy, actual.InstanceFlexibilityPolicy); flexibilityPolicy != nil {
+		newPatch().InstanceFlexibilityPolicy = flexibilityPolicy
+	}
+	if patch != nil {
+		log.V(2).Info("patching instanceGroupManager", "updatePolicy", patch.UpdatePolicy, "distributionPolicy", patch.DistributionPolicy,
+			"autoHealingPolicies", patch.AutoHealingPolicies, "statefulPolicy", patch.StatefulPolicy, "instanceFlexibilityPolicy", patch.InstanceFlexibilityPolicy)
+		if err := s.instanceGroupManagers.Patch(ctx, igmKey, patch); err != nil {
+			log.Error(err, "patching instanceGroupManager")
+			return nil, fmt.Errorf("patching instanceGroupManager %v: %w", selfLink, err)
+		}
+
+		if patch.UpdatePolicy != nil {
+			actual.UpdatePolicy = patch.UpdatePolicy
+		}
+		if patch.DistributionPolicy != nil {
+			if actual.DistributionPolicy == nil {
+				actual.DistributionPolicy = &compute.DistributionPolicy{}
+			}
+			actual.DistributionPolicy.TargetShape = patch.DistributionPolicy.TargetShape
+		}
+		actual.AutoHealingPolicies = desired.AutoHealingPolicies
+		if patch.StatefulPolicy != nil {
+			actual.StatefulPolicy = desired.StatefulPolicy
+		}
+		if patch.InstanceFlexibilityPolicy != nil {
+			actual.InstanceFlexibilityPolicy = desired.InstanceFlexibilityPolicy
+		}
+	}
+
+	// The autohealing health check can only be deleted once the instanceGroupManager no longer references it.
+	if removeAutoHealing {
+		if err := s.deleteHealthCheck(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if desired.InstanceTemplate != actual.InstanceTemplate {
 		log.V(2).Info("updating instanceTemplate for instanceGroupManager", "desired.instanceTemplate", desired.InstanceTemplate, "actual.instanceTemplate", actual.InstanceTemplate)
 		if err := s.instanceGroupManagers.SetInstanceTemplate(ctx, igmKey, &compute.InstanceGroupManagersSetInstanceTemplateRequest{
@@ -148,13 +265,244 @@ func (s *Service) createOrGet(ctx context.Context, instanceTemplateKey *meta.Key
 			return nil, fmt.Errorf("updating instanceTemplate for instanceGroupManager %v: %w", selfLink, err)
 		}
 
-		actual.InstanceTemplate = desired.InstanceTemplate
+		// Fetch the instanceGroupManager again, so that the returned status reflects the new version target.
+		actual, err = s.instanceGroupManagers.Get(ctx, igmKey)
+		if err != nil {
+			return nil, fmt.Errorf("getting instanceGroupManager %v: %w", selfLink, err)
+		}
 	}
 
 	return actual, nil
 }
 
+// updatePolicyNeedsUpdate returns true if a field set in the desired update policy differs from the actual one.
+// Fields that are not set are defaulted by GCP, so they are not compared.
+func updatePolicyNeedsUpdate(desired, actual *compute.InstanceGroupManagerUpdatePolicy) bool {
+	if desired == nil {
+		return false
+	}
+	if actual == nil {
+		return true
+	}
+
+	changed := func(desired, actual string) bool {
+		return desired != "" && desired != actual
+	}
+	if changed(desired.Type, actual.Type) ||
+		changed(desired.MinimalAction, actual.MinimalAction) ||
+		changed(desired.ReplacementMethod, actual.ReplacementMethod) ||
+		changed(desired.InstanceRedistributionType, actual.InstanceRedistributionType) {
+		return true
+	}
+
+	return fixedOrPercentNeedsUpdate(desired.MaxSurge, actual.MaxSurge) ||
+		fixedOrPercentNeedsUpdate(desired.MaxUnavailable, actual.MaxUnavailable)
+}
+
+// targetShapeNeedsUpdate returns true if the desired distribution target shape is set and differs from the actual one.
+func targetShapeNeedsUpdate(desired, actual *compute.DistributionPolicy) bool {
+	if desired == nil || desired.TargetShape == "" {
+		return false
+	}
+
+	return actual == nil || desired.TargetShape != actual.TargetShape
+}
+
+func fixedOrPercentNeedsUpdate(desired, actual *compute.FixedOrPercent) bool {
+	if desired == nil {
+		return false
+	}
+	if actual == nil {
+		return true
+	}
+
+	return desired.Fixed != actual.Fixed || desired.Percent != actual.Percent
+}
+
+// autoHealingPoliciesNeedsUpdate returns true if the actual autohealing policies differ from the desired ones.
+// Desired health checks are partial URLs, while actual ones are full URLs.
+func autoHealingPoliciesNeedsUpdate(desired, actual []*compute.InstanceGroupManagerAutoHealingPolicy) bool {
+	if len(desired) != len(actual) {
+		return true
+	}
+	for i := range desired {
+		if !strings.HasSuffix(actual[i].HealthCheck, desired[i].HealthCheck) ||
+			desired[i].InitialDelaySec != actual[i].InitialDelaySec {
+			return true
+		}
+	}
+
+	return false
+}
+
+// statefulPolicyPatch returns the stateful policy to patch, or nil if the actual stateful policy is up to date.
+// The stateful policy is patched with JSON merge semantics: preserved disks and IPs that are no longer
+// desired are removed by sending them as null.
+func statefulPolicyPatch(desired, actual *compute.StatefulPolicy) *compute.StatefulPolicy {
+	desiredState := &compute.StatefulPolicyPreservedState{}
+	if desired != nil && desired.PreservedState != nil {
+		desiredState = desired.PreservedState
+	}
+	actualState := &compute.StatefulPolicyPreservedState{}
+	if actual != nil && actual.PreservedState != nil {
+		actualState = actual.PreservedState
+	}
+
+	patchState := &compute.StatefulPolicyPreservedState{}
+	var diskNullFields, internalIPNullFields, externalIPNullFields []string
+	patchState.Disks, diskNullFields = mapPatch(desiredState.Disks, actualState.Disks, "Disks", func(a, b compute.StatefulPolicyPreservedStateDiskDevice) bool {
+		return a.AutoDelete == b.AutoDelete
+	})
+	patchState.InternalIPs, internalIPNullFields = mapPatch(desiredState.InternalIPs, actualState.InternalIPs, "InternalIPs", networkIPEqual)
+	patchState.ExternalIPs, externalIPNullFields = mapPatch(desiredState.ExternalIPs, actualState.ExternalIPs, "ExternalIPs", networkIPEqual)
+	patchState.NullFields = slices.Concat(diskNullFields, internalIPNullFields, externalIPNullFields)
+	// Null map entries are only sent if their map is sent, which is not the case for empty maps.
+	for field, nullFields := range map[string][]string{"Disks": diskNullFields, "InternalIPs": internalIPNullFields, "ExternalIPs": externalIPNullFields} {
+		if len(nullFields) > 0 {
+			patchState.ForceSendFields = append(patchState.ForceSendFields, field)
+		}
+	}
+
+	if len(patchState.Disks) == 0 && len(patchState.InternalIPs) == 0 && len(patchState.ExternalIPs) == 0 && len(patchState.NullFields) == 0 {
+		return nil
+	}
+
+	return &compute.StatefulPolicy{PreservedState: patchState}
+}
+
+// instanceFlexibilityPolicyPatch returns the instance flexibility policy to patch, or nil if the actual
+// instance selections are up to date. Instance selections that are no longer desired are removed by sending them as null.
+func instanceFlexibilityPolicyPatch(desired, actual *compute.InstanceGroupManagerInstanceFlexibilityPolicy) *compute.InstanceGroupManagerInstanceFlexibilityPolicy {
+	var desiredSelections, actualSelections map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection
+	if desired != nil {
+		desiredSelections = desired.InstanceSelections
+	}
+	if actual != nil {
+		actualSelections = actual.InstanceSelections
+	}
+
+	selections, nullFields := mapPatch(desiredSelections, actualSelections, "InstanceSelections", func(a, b compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection) bool {
+		return a.Rank == b.Rank && slices.Equal(a.MachineTypes, b.MachineTypes)
+	})
+	if len(selections) == 0 && len(nullFields) == 0 {
+		return nil
+	}
+
+	patch := &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+		InstanceSelections: selections,
+		NullFields:         nullFields,
+	}
+	// Null map entries are only sent if their map is sent, which is not the case for empty maps.
+	if len(nullFields) > 0 {
+		patch.ForceSendFields = []string{"InstanceSelections"}
+	}
+
+	return patch
+}
+
+// reconcileBetaSettings sets the mix of Standard and Spot instances and the target size policy of the
+// instanceGroupManager. They are only available in the beta API, so they are read and patched separately.
+// The GA API reports an instance flexibility policy whenever a provisioning model mix is set, so the
+// beta instanceGroupManager is only fetched when a mix is desired or may have to be removed, or when
+// a target size policy is desired. The target size policy is immutable, so it is never removed.
+func (s *Service) reconcileBetaSettings(ctx context.Context, igmKey *meta.Key, actual *compute.InstanceGroupManager) error {
+	log := log.FromContext(ctx)
+
+	desiredMix := s.scope.ProvisioningModelMix()
+	desiredTargetSizePolicy := s.scope.TargetSizePolicy()
+	if desiredMix == nil && actual.InstanceFlexibilityPolicy == nil && desiredTargetSizePolicy == nil {
+		return nil
+	}
+
+	betaIGM, err := s.instanceGroupManagers.GetBeta(ctx, igmKey)
+	if err != nil {
+		return fmt.Errorf("getting instanceGroupManager %v: %w", igmKey.Name, err)
+	}
+
+	patch, needsUpdate := &computebeta.InstanceGroupManager{}, false
+	var actualMix *computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix
+	if betaIGM.InstanceFlexibilityPolicy != nil {
+		actualMix = betaIGM.InstanceFlexibilityPolicy.ProvisioningModelMix
+	}
+	if provisioningModelMixNeedsUpdate(desiredMix, actualMix) {
+		patch.InstanceFlexibilityPolicy = &computebeta.InstanceGroupManagerInstanceFlexibilityPolicy{
+			ProvisioningModelMix: desiredMix,
+		}
+		if desiredMix == nil {
+			patch.InstanceFlexibilityPolicy.NullFields = []string{"ProvisioningModelMix"}
+		}
+		needsUpdate = true
+	}
+	if targetSizePolicyNeedsUpdate(desiredTargetSizePolicy, betaIGM.TargetSizePolicy) {
+		patch.TargetSizePolicy = desiredTargetSizePolicy
+		needsUpdate = true
+	}
+	if !needsUpdate {
+		return nil
+	}
+
+	log.V(2).Info("patching instanceGroupManager beta settings", "provisioningModelMix", desiredMix, "targetSizePolicy", desiredTargetSizePolicy)
+	if err := s.instanceGroupManagers.PatchBeta(ctx, igmKey, patch); err != nil {
+		log.Error(err, "patching instanceGroupManager beta settings")
+		return fmt.Errorf("patching beta settings of instanceGroupManager %v: %w", igmKey.Name, err)
+	}
+
+	return nil
+}
+
+func provisioningModelMixNeedsUpdate(desired, actual *computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix) bool {
+	if desired == nil || actual == nil {
+		return (desired == nil) != (actual == nil)
+	}
+
+	return desired.StandardCapacityBase != actual.StandardCapacityBase ||
+		desired.StandardCapacityPercentAboveBase != actual.StandardCapacityPercentAboveBase
+}
+
+// targetSizePolicyNeedsUpdate returns true if the desired target size policy differs from the actual one,
+// which GCP reports with an unspecified mode by default.
+func targetSizePolicyNeedsUpdate(desired, actual *computebeta.InstanceGroupManagerTargetSizePolicy) bool {
+	if desired == nil {
+		return false
+	}
+
+	actualMode := "INDIVIDUAL"
+	if actual != nil && actual.Mode != "" && actual.Mode != "UNSPECIFIED_MODE" {
+		actualMode = actual.Mode
+	}
+
+	return desired.Mode != actualMode
+}
+
+func networkIPEqual(a, b compute.StatefulPolicyPreservedStateNetworkIp) bool {
+	return a.AutoDelete == b.AutoDelete
+}
+
+// mapPatch returns the map entries to set, and the null fields for the entries to remove.
+func mapPatch[T any](desired, actual map[string]T, field string, equal func(a, b T) bool) (map[string]T, []string) {
+	var entries map[string]T
+	for name, entry := range desired {
+		if actualEntry, ok := actual[name]; !ok || !equal(entry, actualEntry) {
+			if entries == nil {
+				entries = map[string]T{}
+			}
+			entries[name] = entry
+		}
+	}
+
+	var nullFields []string
+	for name := range actual {
+		if _, ok := desired[name]; !ok {
+			nullFields = append(nullFields, field+"."+name)
+		}
+	}
+	sort.Strings(nullFields)
+
+	return entries, nullFields
+}
+
 // ListInstances lists instances in the the instanceGroup linked to the passed instanceGroupManager.
+// For a regional instanceGroupManager, instances are listed across all of its zones.
 func (s *Service) ListInstances(ctx context.Context, instanceGroupManager *compute.InstanceGroupManager) ([]*compute.InstanceWithNamedPorts, error) {
 	log := log.FromContext(ctx)
 
@@ -164,9 +512,12 @@ func (s *Service) ListInstances(ctx context.Context, instanceGroupManager *compu
 		instanceGroup = strings.TrimPrefix(instanceGroup, "https://www.googleapis.com/")
 		instanceGroup = strings.TrimPrefix(instanceGroup, "compute/v1/")
 		tokens := strings.Split(instanceGroup, "/")
-		if len(tokens) == 6 && tokens[0] == "projects" && tokens[2] == "zones" && tokens[4] == "instanceGroups" {
+		switch {
+		case len(tokens) == 6 && tokens[0] == "projects" && tokens[2] == "zones" && tokens[4] == "instanceGroups":
 			igKey = meta.ZonalKey(tokens[5], tokens[3])
-		} else {
+		case len(tokens) == 6 && tokens[0] == "projects" && tokens[2] == "regions" && tokens[4] == "instanceGroups":
+			igKey = meta.RegionalKey(tokens[5], tokens[3])
+		default:
 			return nil, fmt.Errorf("unexpected format for instanceGroup: %q", instanceGroup)
 		}
 	}
@@ -183,3 +534,76 @@ func (s *Service) ListInstances(ctx context.Context, instanceGroupManager *compu
 
 	return instances, nil
 }
+
+// ListManagedInstances lists the instances managed by the instanceGroupManager.
+// For a regional instanceGroupManager, instances are listed across all of its zones.
+func (s *Service) ListManagedInstances(ctx context.Context) ([]*compute.ManagedInstance, error) {
+	igmKey, err := s.scope.InstanceGroupManagerResourceName()
+	if err != nil {
+		return nil, err
+	}
+
+	instances, err := s.instanceGroupManagers.ListManagedInstances(ctx, igmKey)
+	if err != nil {
+		return nil, fmt.Errorf("listing managed instances of instanceGroupManager %q: %w", igmKey.Name, err)
+	}
+
+	return instances, nil
+}
+
+// ListInstanceDetails returns the compute instances backing the passed managed instances, keyed by instance selfLink.
+// Managed instances that do not exist yet (e.g. still being created) are not part of the result.
+func (s *Service) ListInstanceDetails(ctx context.Context, managedInstances []*compute.ManagedInstance) (map[string]*compute.Instance, error) {
+	names := map[string][]string{}
+	for _, mi := range managedInstances {
+		if mi.Instance == "" {
+			continue
+		}
+		u, err := resourceurl.Parse(mi.Instance)
+		if err != nil {
+			return nil, fmt.Errorf("parsing instance url %q: %w", mi.Instance, err)
+		}
+		names[u.Location] = append(names[u.Location], regexp.QuoteMeta(u.Name))
+	}
+
+	details := map[string]*compute.Instance{}
+	for zone, zoneNames := range names {
+		fl := filter.Regexp("name", "^("+strings.Join(zoneNames, "|")+")$")
+		instances, err := s.instances.List(ctx, zone, fl)
+		if err != nil {
+			return nil, fmt.Errorf("listing instances in zone %q: %w", zone, err)
+		}
+		for _, instance := range instances {
+			details[instance.SelfLink] = instance
+		}
+	}
+
+	return details, nil
+}
+
+// DeleteInstances deletes the passed instances from the instanceGroupManager.
+// The target size of the instanceGroupManager is decreased accordingly, so the instances are not recreated.
+// Instances that are no longer part of the instanceGroupManager are skipped.
+func (s *Service) DeleteInstances(ctx context.Context, instanceURLs ...string) error {
+	log := log.FromContext(ctx)
+
+	igmKey, err := s.scope.InstanceGroupManagerResourceName()
+	if err != nil {
+		return err
+	}
+
+	log.Info("Deleting instances from instanceGroupManager", "instanceGroupManager", igmKey.Name, "instances", instanceURLs)
+	req := &compute.InstanceGroupManagersDeleteInstancesRequest{
+		Instances:                      instanceURLs,
+		SkipInstancesOnValidationError: true,
+	}
+	if err := s.instanceGroupManagers.DeleteInstances(ctx, igmKey, req); err != nil {
+		if gcperrors.IsNotFound(err) {
+			log.V(2).Info("instanceGroupManager not found, assuming instances already deleted", "instanceGroupManager", igmKey.Name)
+			return nil
+		}
+		return fmt.Errorf("deleting instances from instanceGroupManager %q: %w", igmKey.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,598 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroupmanagers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	k8scloud "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/google/go-cmp/cmp"
+	computebeta "google.golang.org/api/compute/v0.beta"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// fakeInstanceGroupManagers records the deletions of instanceGroupManagers and of their instances.
+type fakeInstanceGroupManagers struct {
+	instanceGroupManagersClient
+	existing         map[meta.Key]bool
+	deleted          []meta.Key
+	deletedInstances []string
+	beta             *computebeta.InstanceGroupManager
+	betaPatches      []*computebeta.InstanceGroupManager
+}
+
+func (f *fakeInstanceGroupManagers) Get(_ context.Context, key *meta.Key, _ ...k8scloud.Option) (*compute.InstanceGroupManager, error) {
+	if !f.existing[*key] {
+		return nil, &googleapi.Error{Code: http.StatusNotFound}
+	}
+	return &compute.InstanceGroupManager{Name: key.Name}, nil
+}
+
+func (f *fakeInstanceGroupManagers) Delete(_ context.Context, key *meta.Key, _ ...k8scloud.Option) error {
+	if !f.existing[*key] {
+		return &googleapi.Error{Code: http.StatusNotFound}
+	}
+	delete(f.existing, *key)
+	f.deleted = append(f.deleted, *key)
+	return nil
+}
+
+func (f *fakeInstanceGroupManagers) DeleteInstances(_ context.Context, key *meta.Key, req *compute.InstanceGroupManagersDeleteInstancesRequest, _ ...k8scloud.Option) error {
+	if !f.existing[*key] {
+		return &googleapi.Error{Code: http.StatusNotFound}
+	}
+	f.deletedInstances = append(f.deletedInstances, req.Instances...)
+	return nil
+}
+
+func (f *fakeInstanceGroupManagers) GetBeta(_ context.Context, _ *meta.Key) (*computebeta.InstanceGroupManager, error) {
+	return f.beta, nil
+}
+
+func (f *fakeInstanceGroupManagers) PatchBeta(_ context.Context, _ *meta.Key, obj *computebeta.InstanceGroupManager) error {
+	f.betaPatches = append(f.betaPatches, obj)
+	return nil
+}
+
+// fakeHealthChecks records the deletions of health checks.
+type fakeHealthChecks struct {
+	healthChecksClient
+	deleted []meta.Key
+}
+
+func (f *fakeHealthChecks) Delete(_ context.Context, key *meta.Key, _ ...k8scloud.Option) error {
+	f.deleted = append(f.deleted, *key)
+	return nil
+}
+
+// fakeFirewalls records the deletions of firewall rules.
+type fakeFirewalls struct {
+	firewallsClient
+	deleted []meta.Key
+}
+
+func (f *fakeFirewalls) Delete(_ context.Context, key *meta.Key, _ ...k8scloud.Option) error {
+	f.deleted = append(f.deleted, *key)
+	return nil
+}
+
+// fakeScope returns the keys of the current and previous instanceGroupManagers, and the desired beta settings.
+type fakeScope struct {
+	Scope
+	current          *meta.Key
+	previous         *meta.Key
+	mix              *computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix
+	targetSizePolicy *computebeta.InstanceGroupManagerTargetSizePolicy
+	skipFirewalls    bool
+}
+
+func (f *fakeScope) AutoHealingHealthCheckResourceName() *meta.Key {
+	return meta.GlobalKey("my-cluster-my-pool-autohealing")
+}
+
+func (f *fakeScope) AutoHealingFirewallResourceName() *meta.Key {
+	return meta.GlobalKey("allow-my-cluster-my-pool-autohealing")
+}
+
+func (f *fakeScope) SkipFirewallRulesManagement() bool {
+	return f.skipFirewalls
+}
+
+func (f *fakeScope) InstanceGroupManagerResourceName() (*meta.Key, error) {
+	return f.current, nil
+}
+
+func (f *fakeScope) PreviousInstanceGroupManagerResourceName() (*meta.Key, error) {
+	return f.previous, nil
+}
+
+func (f *fakeScope) ProvisioningModelMix() *computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix {
+	return f.mix
+}
+
+func (f *fakeScope) TargetSizePolicy() *computebeta.InstanceGroupManagerTargetSizePolicy {
+	return f.targetSizePolicy
+}
+
+func TestDeletePrevious(t *testing.T) {
+	zonal := meta.ZonalKey("my-cluster-my-pool", "us-central1-a")
+	regional := meta.RegionalKey("my-cluster-my-pool", "us-central1")
+
+	tests := []struct {
+		name        string
+		previous    *meta.Key
+		existing    map[meta.Key]bool
+		wantDeleted []meta.Key
+	}{
+		{
+			name:     "instanceGroupManager did not move",
+			existing: map[meta.Key]bool{*regional: true},
+		},
+		{
+			name:        "zonal instanceGroupManager left behind",
+			previous:    zonal,
+			existing:    map[meta.Key]bool{*zonal: true, *regional: true},
+			wantDeleted: []meta.Key{*zonal},
+		},
+		{
+			name:     "previous instanceGroupManager already deleted",
+			previous: zonal,
+			existing: map[meta.Key]bool{*regional: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			igms := &fakeInstanceGroupManagers{existing: tt.existing}
+			s := &Service{
+				scope:                 &fakeScope{current: regional, previous: tt.previous},
+				instanceGroupManagers: igms,
+			}
+
+			if err := s.DeletePrevious(context.Background()); err != nil {
+				t.Fatalf("DeletePrevious() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantDeleted, igms.deleted); diff != "" {
+				t.Errorf("DeletePrevious() deleted mismatch (-want +got):\n%s", diff)
+			}
+			if !igms.existing[*regional] {
+				t.Errorf("DeletePrevious() deleted the current instanceGroupManager")
+			}
+		})
+	}
+}
+
+func TestDeleteHealthCheck(t *testing.T) {
+	tests := []struct {
+		name              string
+		skipFirewalls     bool
+		wantFirewallsGone []meta.Key
+	}{
+		{
+			name:              "firewall rules are managed",
+			wantFirewallsGone: []meta.Key{*meta.GlobalKey("allow-my-cluster-my-pool-autohealing")},
+		},
+		{
+			name:          "firewall rules are not managed",
+			skipFirewalls: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			healthChecks, firewalls := &fakeHealthChecks{}, &fakeFirewalls{}
+			s := &Service{
+				scope:        &fakeScope{skipFirewalls: tt.skipFirewalls},
+				healthChecks: healthChecks,
+				firewalls:    firewalls,
+			}
+
+			if err := s.deleteHealthCheck(context.Background()); err != nil {
+				t.Fatalf("deleteHealthCheck() error = %v", err)
+			}
+			if diff := cmp.Diff([]meta.Key{*meta.GlobalKey("my-cluster-my-pool-autohealing")}, healthChecks.deleted); diff != "" {
+				t.Errorf("deleteHealthCheck() deleted healthChecks mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantFirewallsGone, firewalls.deleted); diff != "" {
+				t.Errorf("deleteHealthCheck() deleted firewalls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFirewallNeedsUpdate(t *testing.T) {
+	desired := &compute.Firewall{
+		Allowed:    []*compute.FirewallAllowed{{IPProtocol: "TCP", Ports: []string{"10250"}}},
+		TargetTags: []string{"my-cluster-node"},
+	}
+
+	tests := []struct {
+		name   string
+		actual *compute.Firewall
+		want   bool
+	}{
+		{
+			name: "up to date",
+			actual: &compute.Firewall{
+				Allowed:    []*compute.FirewallAllowed{{IPProtocol: "tcp", Ports: []string{"10250"}}},
+				TargetTags: []string{"my-cluster-node"},
+			},
+			want: false,
+		},
+		{
+			name: "different port",
+			actual: &compute.Firewall{
+				Allowed:    []*compute.FirewallAllowed{{IPProtocol: "tcp", Ports: []string{"10256"}}},
+				TargetTags: []string{"my-cluster-node"},
+			},
+			want: true,
+		},
+		{
+			name: "different target tags",
+			actual: &compute.Firewall{
+				Allowed:    []*compute.FirewallAllowed{{IPProtocol: "tcp", Ports: []string{"10250"}}},
+				TargetTags: []string{"my-cluster-control-plane"},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := firewallNeedsUpdate(desired, tt.actual); got != tt.want {
+				t.Errorf("firewallNeedsUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoHealingPoliciesNeedsUpdate(t *testing.T) {
+	desired := []*compute.InstanceGroupManagerAutoHealingPolicy{
+		{HealthCheck: "global/healthChecks/my-cluster-my-pool-autohealing", InitialDelaySec: 300},
+	}
+
+	tests := []struct {
+		name   string
+		actual []*compute.InstanceGroupManagerAutoHealingPolicy
+		want   bool
+	}{
+		{
+			name: "up to date",
+			actual: []*compute.InstanceGroupManagerAutoHealingPolicy{
+				{HealthCheck: "https://www.googleapis.com/compute/v1/projects/my-project/global/healthChecks/my-cluster-my-pool-autohealing", InitialDelaySec: 300},
+			},
+			want: false,
+		},
+		{
+			name: "different initial delay",
+			actual: []*compute.InstanceGroupManagerAutoHealingPolicy{
+				{HealthCheck: "https://www.googleapis.com/compute/v1/projects/my-project/global/healthChecks/my-cluster-my-pool-autohealing", InitialDelaySec: 60},
+			},
+			want: true,
+		},
+		{
+			name: "no autohealing",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := autoHealingPoliciesNeedsUpdate(desired, tt.actual); got != tt.want {
+				t.Errorf("autoHealingPoliciesNeedsUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatefulPolicyPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  *compute.StatefulPolicy
+		actual   *compute.StatefulPolicy
+		wantJSON string
+	}{
+		{
+			name:    "no stateful policy",
+			desired: nil,
+			actual:  &compute.StatefulPolicy{},
+		},
+		{
+			name: "up to date",
+			desired: &compute.StatefulPolicy{PreservedState: &compute.StatefulPolicyPreservedState{
+				Disks: map[string]compute.StatefulPolicyPreservedStateDiskDevice{"persistent-disk-1": {AutoDelete: "NEVER"}},
+			}},
+			actual: &compute.StatefulPolicy{PreservedState: &compute.StatefulPolicyPreservedState{
+				Disks: map[string]compute.StatefulPolicyPreservedStateDiskDevice{"persistent-disk-1": {AutoDelete: "NEVER"}},
+			}},
+		},
+		{
+			name: "add and change entries",
+			desired: &compute.StatefulPolicy{PreservedState: &compute.StatefulPolicyPreservedState{
+				Disks:       map[string]compute.StatefulPolicyPreservedStateDiskDevice{"persistent-disk-1": {AutoDelete: "ON_PERMANENT_INSTANCE_DELETION"}},
+				InternalIPs: map[string]compute.StatefulPolicyPreservedStateNetworkIp{"nic0": {AutoDelete: "NEVER"}},
+			}},
+			actual: &compute.StatefulPolicy{PreservedState: &compute.StatefulPolicyPreservedState{
+				Disks: map[string]compute.StatefulPolicyPreservedStateDiskDevice{"persistent-disk-1": {AutoDelete: "NEVER"}},
+			}},
+			wantJSON: `{"preservedState":{"disks":{"persistent-disk-1":{"autoDelete":"ON_PERMANENT_INSTANCE_DELETION"}},"internalIPs":{"nic0":{"autoDelete":"NEVER"}}}}`,
+		},
+		{
+			name:    "remove entries",
+			desired: nil,
+			actual: &compute.StatefulPolicy{PreservedState: &compute.StatefulPolicyPreservedState{
+				Disks:       map[string]compute.StatefulPolicyPreservedStateDiskDevice{"persistent-disk-1": {AutoDelete: "NEVER"}},
+				ExternalIPs: map[string]compute.StatefulPolicyPreservedStateNetworkIp{"nic0": {AutoDelete: "NEVER"}},
+			}},
+			wantJSON: `{"preservedState":{"disks":{"persistent-disk-1":null},"externalIPs":{"nic0":null}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := statefulPolicyPatch(tt.desired, tt.actual)
+			if tt.wantJSON == "" {
+				if patch != nil {
+					t.Fatalf("statefulPolicyPatch() = %+v, want nil", patch)
+				}
+				return
+			}
+
+			got, err := json.Marshal(patch)
+			if err != nil {
+				t.Fatalf("marshaling patch: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantJSON, string(got)); diff != "" {
+				t.Errorf("statefulPolicyPatch() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceFlexibilityPolicyPatch(t *testing.T) {
+	preferred := compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{
+		MachineTypes:    []string{"n2-standard-4"},
+		Rank:            0,
+		ForceSendFields: []string{"Rank"},
+	}
+	fallback := compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{
+		MachineTypes:    []string{"e2-standard-4"},
+		Rank:            1,
+		ForceSendFields: []string{"Rank"},
+	}
+
+	tests := []struct {
+		name     string
+		desired  *compute.InstanceGroupManagerInstanceFlexibilityPolicy
+		actual   *compute.InstanceGroupManagerInstanceFlexibilityPolicy
+		wantJSON string
+	}{
+		{
+			name: "no instance selections",
+		},
+		{
+			name: "up to date",
+			desired: &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+				InstanceSelections: map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{"preferred": preferred},
+			},
+			actual: &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+				InstanceSelections: map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{
+					"preferred": {MachineTypes: []string{"n2-standard-4"}},
+				},
+			},
+		},
+		{
+			name: "add selection and change rank",
+			desired: &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+				InstanceSelections: map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{"preferred": preferred, "fallback": fallback},
+			},
+			actual: &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+				InstanceSelections: map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{
+					"preferred": {MachineTypes: []string{"n2-standard-4"}, Rank: 2},
+				},
+			},
+			wantJSON: `{"instanceSelections":{"fallback":{"machineTypes":["e2-standard-4"],"rank":1},"preferred":{"machineTypes":["n2-standard-4"],"rank":0}}}`,
+		},
+		{
+			name: "remove selection",
+			desired: &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+				InstanceSelections: map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{"preferred": preferred},
+			},
+			actual: &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+				InstanceSelections: map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{
+					"preferred": {MachineTypes: []string{"n2-standard-4"}},
+					"fallback":  {MachineTypes: []string{"e2-standard-4"}, Rank: 1},
+				},
+			},
+			wantJSON: `{"instanceSelections":{"fallback":null}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := instanceFlexibilityPolicyPatch(tt.desired, tt.actual)
+			if tt.wantJSON == "" {
+				if patch != nil {
+					t.Fatalf("instanceFlexibilityPolicyPatch() = %+v, want nil", patch)
+				}
+				return
+			}
+
+			got, err := json.Marshal(patch)
+			if err != nil {
+				t.Fatalf("marshaling patch: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantJSON, string(got)); diff != "" {
+				t.Errorf("instanceFlexibilityPolicyPatch() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProvisioningModelMixNeedsUpdate(t *testing.T) {
+	mix := func(base, percent int64) *computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix {
+		return &computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix{StandardCapacityBase: base, StandardCapacityPercentAboveBase: percent}
+	}
+
+	tests := []struct {
+		name    string
+		desired *computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix
+		actual  *computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix
+		want    bool
+	}{
+		{name: "no mix", want: false},
+		{name: "up to date", desired: mix(2, 20), actual: mix(2, 20), want: false},
+		{name: "different percentage", desired: mix(2, 20), actual: mix(2, 50), want: true},
+		{name: "mix to set", desired: mix(2, 20), want: true},
+		{name: "mix to remove", actual: mix(2, 20), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := provisioningModelMixNeedsUpdate(tt.desired, tt.actual); got != tt.want {
+				t.Errorf("provisioningModelMixNeedsUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTargetSizePolicyNeedsUpdate(t *testing.T) {
+	policy := func(mode string) *computebeta.InstanceGroupManagerTargetSizePolicy {
+		return &computebeta.InstanceGroupManagerTargetSizePolicy{Mode: mode}
+	}
+
+	tests := []struct {
+		name    string
+		desired *computebeta.InstanceGroupManagerTargetSizePolicy
+		actual  *computebeta.InstanceGroupManagerTargetSizePolicy
+		want    bool
+	}{
+		{name: "no policy", want: false},
+		{name: "policy set outside of CAPG", actual: policy("BULK"), want: false},
+		{name: "up to date", desired: policy("BULK"), actual: policy("BULK"), want: false},
+		{name: "default individual mode", desired: policy("INDIVIDUAL"), want: false},
+		{name: "unspecified individual mode", desired: policy("INDIVIDUAL"), actual: policy("UNSPECIFIED_MODE"), want: false},
+		{name: "bulk mode to set", desired: policy("BULK"), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := targetSizePolicyNeedsUpdate(tt.desired, tt.actual); got != tt.want {
+				t.Errorf("targetSizePolicyNeedsUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileBetaSettings(t *testing.T) {
+	igmKey := meta.ZonalKey("my-cluster-my-pool", "us-central1-a")
+	mix := &computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix{StandardCapacityBase: 2}
+	bulk := &computebeta.InstanceGroupManagerTargetSizePolicy{Mode: "BULK"}
+
+	tests := []struct {
+		name        string
+		scope       *fakeScope
+		actual      *compute.InstanceGroupManager
+		beta        *computebeta.InstanceGroupManager
+		wantPatches []*computebeta.InstanceGroupManager
+	}{
+		{
+			name:   "no beta settings",
+			scope:  &fakeScope{},
+			actual: &compute.InstanceGroupManager{},
+		},
+		{
+			name:   "target size policy to set",
+			scope:  &fakeScope{targetSizePolicy: bulk},
+			actual: &compute.InstanceGroupManager{},
+			beta:   &computebeta.InstanceGroupManager{},
+			wantPatches: []*computebeta.InstanceGroupManager{
+				{TargetSizePolicy: bulk},
+			},
+		},
+		{
+			name:   "provisioning model mix and target size policy to set",
+			scope:  &fakeScope{mix: mix, targetSizePolicy: bulk},
+			actual: &compute.InstanceGroupManager{},
+			beta:   &computebeta.InstanceGroupManager{},
+			wantPatches: []*computebeta.InstanceGroupManager{
+				{
+					InstanceFlexibilityPolicy: &computebeta.InstanceGroupManagerInstanceFlexibilityPolicy{ProvisioningModelMix: mix},
+					TargetSizePolicy:          bulk,
+				},
+			},
+		},
+		{
+			name:   "up to date",
+			scope:  &fakeScope{mix: mix, targetSizePolicy: bulk},
+			actual: &compute.InstanceGroupManager{InstanceFlexibilityPolicy: &compute.InstanceGroupManagerInstanceFlexibilityPolicy{}},
+			beta: &computebeta.InstanceGroupManager{
+				InstanceFlexibilityPolicy: &computebeta.InstanceGroupManagerInstanceFlexibilityPolicy{ProvisioningModelMix: mix},
+				TargetSizePolicy:          bulk,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			igms := &fakeInstanceGroupManagers{beta: tt.beta}
+			s := &Service{
+				scope:                 tt.scope,
+				instanceGroupManagers: igms,
+			}
+
+			if err := s.reconcileBetaSettings(context.Background(), igmKey, tt.actual); err != nil {
+				t.Fatalf("reconcileBetaSettings() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantPatches, igms.betaPatches); diff != "" {
+				t.Errorf("reconcileBetaSettings() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteInstances(t *testing.T) {
+	igmKey := meta.ZonalKey("my-cluster-my-pool", "us-central1-a")
+	instance := "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/my-pool-abcd"
+
+	tests := []struct {
+		name          string
+		existing      map[meta.Key]bool
+		wantInstances []string
+	}{
+		{
+			name:          "instance is deleted from the instanceGroupManager",
+			existing:      map[meta.Key]bool{*igmKey: true},
+			wantInstances: []string{instance},
+		},
+		{
+			name: "instanceGroupManager already deleted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			igms := &fakeInstanceGroupManagers{existing: tt.existing}
+			s := &Service{
+				scope:                 &fakeScope{current: igmKey},
+				instanceGroupManagers: igms,
+			}
+
+			if err := s.DeleteInstances(context.Background(), instance); err != nil {
+				t.Fatalf("DeleteInstances() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantInstances, igms.deletedInstances); diff != "" {
+				t.Errorf("DeleteInstances() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
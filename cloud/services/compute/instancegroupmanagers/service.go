@@ -21,7 +21,9 @@ import (
 	"context"
 
 	k8scloud "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	computebeta "google.golang.org/api/compute/v0.beta"
 	compute "google.golang.org/api/compute/v1"
 
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
@@ -33,24 +35,83 @@ type instanceGroupManagersClient interface {
 	Delete(ctx context.Context, key *meta.Key, options ...k8scloud.Option) error
 	Resize(context.Context, *meta.Key, int64, ...k8scloud.Option) error
 	SetInstanceTemplate(context.Context, *meta.Key, *compute.InstanceGroupManagersSetInstanceTemplateRequest, ...k8scloud.Option) error
+	Patch(ctx context.Context, key *meta.Key, obj *compute.InstanceGroupManager) error
+	GetBeta(ctx context.Context, key *meta.Key) (*computebeta.InstanceGroupManager, error)
+	PatchBeta(ctx context.Context, key *meta.Key, obj *computebeta.InstanceGroupManager) error
+	DeleteInstances(context.Context, *meta.Key, *compute.InstanceGroupManagersDeleteInstancesRequest, ...k8scloud.Option) error
+	ListManagedInstances(ctx context.Context, key *meta.Key) ([]*compute.ManagedInstance, error)
+}
+
+type healthChecksClient interface {
+	Get(ctx context.Context, key *meta.Key, options ...k8scloud.Option) (*compute.HealthCheck, error)
+	Insert(ctx context.Context, key *meta.Key, obj *compute.HealthCheck, options ...k8scloud.Option) error
+	Update(context.Context, *meta.Key, *compute.HealthCheck, ...k8scloud.Option) error
+	Delete(ctx context.Context, key *meta.Key, options ...k8scloud.Option) error
+}
+
+type firewallsClient interface {
+	Get(ctx context.Context, key *meta.Key, options ...k8scloud.Option) (*compute.Firewall, error)
+	Insert(ctx context.Context, key *meta.Key, obj *compute.Firewall, options ...k8scloud.Option) error
+	Update(ctx context.Context, key *meta.Key, obj *compute.Firewall, options ...k8scloud.Option) error
+	Delete(ctx context.Context, key *meta.Key, options ...k8scloud.Option) error
+}
+
+type instancesClient interface {
+	List(ctx context.Context, zone string, fl *filter.F, options ...k8scloud.Option) ([]*compute.Instance, error)
+}
+
+type instanceGroupsClient interface {
+	ListInstances(context.Context, *meta.Key, *compute.InstanceGroupsListInstancesRequest, *filter.F, ...k8scloud.Option) ([]*compute.InstanceWithNamedPorts, error)
 }
 
 // Scope is an interfaces that hold used methods.
 type Scope interface {
 	Cloud() cloud.Cloud
+	ComputeService() *cloud.ComputeService
+	ComputeServiceWithBeta(ctx context.Context) (*cloud.ComputeService, error)
 
 	// InstanceGroupManagerResource returns the desired instanceGroupManager
 	InstanceGroupManagerResource(instanceTemplateKey *meta.Key) (*compute.InstanceGroupManager, error)
 
 	// InstanceGroupManagerResourceName returns the instanceGroupManager selfLink
 	InstanceGroupManagerResourceName() (*meta.Key, error)
+
+	// PreviousInstanceGroupManagerResourceName returns the key of the previous instanceGroupManager, or nil
+	PreviousInstanceGroupManagerResourceName() (*meta.Key, error)
+
+	// AutoHealingHealthCheckResource returns the desired autohealing health check, or nil if autohealing is disabled
+	AutoHealingHealthCheckResource() *compute.HealthCheck
+
+	// AutoHealingHealthCheckResourceName returns the key of the autohealing health check
+	AutoHealingHealthCheckResourceName() *meta.Key
+
+	// AutoHealingFirewallResource returns the desired firewall rule allowing the autohealing health check probes, or nil
+	AutoHealingFirewallResource() *compute.Firewall
+
+	// AutoHealingFirewallResourceName returns the key of the firewall rule allowing the autohealing health check probes
+	AutoHealingFirewallResourceName() *meta.Key
+
+	// SkipFirewallRulesManagement returns true if the firewall rules of the cluster network are not managed
+	SkipFirewallRulesManagement() bool
+
+	// ReplicasManagedByAutoscaler returns true if the instanceGroupManager is resized by an autoscaler
+	ReplicasManagedByAutoscaler() bool
+
+	// ProvisioningModelMix returns the desired mix of Standard and Spot instances, or nil if provisioning models are not mixed
+	ProvisioningModelMix() *computebeta.InstanceGroupManagerInstanceFlexibilityPolicyProvisioningModelMix
+
+	// TargetSizePolicy returns the desired target size policy, or nil if instances are created individually
+	TargetSizePolicy() *computebeta.InstanceGroupManagerTargetSizePolicy
 }
 
 // Service implements managed instance groups reconciler.
 type Service struct {
 	scope                 Scope
 	instanceGroupManagers instanceGroupManagersClient
-	instanceGroups        k8scloud.InstanceGroups
+	instanceGroups        instanceGroupsClient
+	instances             instancesClient
+	healthChecks          healthChecksClient
+	firewalls             firewallsClient
 }
 
 // var _ cloud.Reconciler = &Service{}
@@ -60,8 +121,18 @@ func New(scope Scope) *Service {
 	cloudScope := scope.Cloud()
 
 	return &Service{
-		scope:                 scope,
-		instanceGroupManagers: cloudScope.InstanceGroupManagers(),
-		instanceGroups:        cloudScope.InstanceGroups(),
+		scope: scope,
+		instanceGroupManagers: &instanceGroupManagers{
+			InstanceGroupManagers: cloudScope.InstanceGroupManagers(),
+			service:               scope.ComputeService(),
+			betaService:           scope.ComputeServiceWithBeta,
+		},
+		instanceGroups: &instanceGroups{
+			InstanceGroups: cloudScope.InstanceGroups(),
+			service:        scope.ComputeService(),
+		},
+		instances:    cloudScope.Instances(),
+		healthChecks: cloudScope.HealthChecks(),
+		firewalls:    cloudScope.Firewalls(),
 	}
 }
@@ -19,6 +19,8 @@ package instances
 import (
 	"context"
 	"fmt"
+	"path"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -27,11 +29,14 @@ import (
 	"google.golang.org/api/compute/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/providerid"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -59,7 +64,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		}
 	}
 
-	machineName := s.scope.Name()
+	machineName := instance.Name
 	zone := s.scope.Zone()
 	project := s.scope.Project()
 
@@ -84,7 +89,9 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		Address: machineName,
 	})
 
-	s.scope.SetProviderID()
+	if s.scope.GetProviderID() == "" {
+		s.scope.SetProviderID()
+	}
 	s.scope.SetAddresses(addresses)
 	s.scope.SetInstanceStatus(infrav1.InstanceStatus(instance.Status))
 
@@ -101,10 +108,14 @@ func (s *Service) Reconcile(ctx context.Context) error {
 func (s *Service) Delete(ctx context.Context) error {
 	log := log.FromContext(ctx)
 	log.Info("Deleting instance resources")
-	instanceSpec := s.scope.InstanceSpec(log)
-	instanceName := instanceSpec.Name
-	instanceKey := meta.ZonalKey(instanceName, s.scope.Zone())
-	log.V(2).Info("Looking for instance before deleting", "name", instanceName, "zone", s.scope.Zone())
+	instanceKey, err := s.instanceKey()
+	if err != nil {
+		// An instance that spec.providerID can't reference was never created nor adopted.
+		log.Info("Skipping instance deletion, spec.providerID does not reference an instance of the cluster", "providerID", s.scope.GetProviderID(), "reason", err.Error())
+		return nil
+	}
+	instanceName := instanceKey.Name
+	log.V(2).Info("Looking for instance before deleting", "name", instanceName, "zone", instanceKey.Zone)
 	instance, err := s.instances.Get(ctx, instanceKey)
 	if err != nil {
 		if !gcperrors.IsNotFound(err) {
@@ -115,13 +126,19 @@ func (s *Service) Delete(ctx context.Context) error {
 		return nil
 	}
 
+	// Instances whose adoption was refused are not owned by the cluster and are left alone.
+	if !infrav1.Labels(instance.Labels).HasOwned(s.scope.ClusterName()) {
+		log.Info("Skipping deletion of instance not owned by the cluster", "name", instanceName, "zone", instanceKey.Zone)
+		return nil
+	}
+
 	if s.scope.IsControlPlane() {
 		if err := s.deregisterControlPlaneInstance(ctx, instance); err != nil {
 			return err
 		}
 	}
 
-	log.V(2).Info("Deleting instance", "name", instanceName, "zone", s.scope.Zone())
+	log.V(2).Info("Deleting instance", "name", instanceName, "zone", instanceKey.Zone)
 	return gcperrors.IgnoreNotFound(s.instances.Delete(ctx, instanceKey))
 }
 
@@ -135,22 +152,36 @@ func (s *Service) createOrGetInstance(ctx context.Context) (*compute.Instance, e
 	}
 
 	instanceSpec := s.scope.InstanceSpec(log)
-	instanceName := instanceSpec.Name
-	instanceKey := meta.ZonalKey(instanceName, s.scope.Zone())
 	instanceSpec.Metadata.Items = append(instanceSpec.Metadata.Items, &compute.MetadataItems{
 		Key:   "user-data",
 		Value: ptr.To[string](bootstrapData),
 	})
 
-	log.V(2).Info("Looking for instance", "name", instanceName, "zone", s.scope.Zone())
+	instanceKey, err := s.instanceKey()
+	if err != nil {
+		conditions.Set(s.scope.ConditionSetter(), metav1.Condition{
+			Type:    infrav1.InstanceAdoptedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.InstanceAdoptionMismatchReason,
+			Message: err.Error(),
+		})
+		return nil, err
+	}
+	instanceName := instanceKey.Name
+
+	log.V(2).Info("Looking for instance", "name", instanceName, "zone", instanceKey.Zone)
 	instance, err := s.instances.Get(ctx, instanceKey)
 	if err != nil {
 		if !gcperrors.IsNotFound(err) {
-			log.Error(err, "Error looking for instance", "name", instanceName, "zone", s.scope.Zone())
+			log.Error(err, "Error looking for instance", "name", instanceName, "zone", instanceKey.Zone)
 			return nil, err
 		}
 
-		log.V(2).Info("Creating an instance", "name", instanceName, "zone", s.scope.Zone())
+		if instanceName != instanceSpec.Name || instanceKey.Zone != s.scope.Zone() {
+			return nil, s.refuseAdoption(infrav1.InstanceAdoptionNotFoundReason, "instance %s referenced by spec.providerID does not exist", s.scope.GetProviderID())
+		}
+
+		log.V(2).Info("Creating an instance", "name", instanceName, "zone", instanceKey.Zone)
 		if err := s.instances.Insert(ctx, instanceKey, instanceSpec); err != nil {
 			log.Error(err, "Error creating an instance", "name", instanceName, "zone", s.scope.Zone())
 			return nil, err
@@ -160,11 +191,114 @@ func (s *Service) createOrGetInstance(ctx context.Context) (*compute.Instance, e
 		if err != nil {
 			return nil, err
 		}
+
+		return instance, nil
+	}
+
+	if !infrav1.Labels(instance.Labels).HasOwned(s.scope.ClusterName()) {
+		if err := s.adoptInstance(ctx, instanceKey, instance, instanceSpec); err != nil {
+			return nil, err
+		}
 	}
 
 	return instance, nil
 }
 
+// instanceKey returns the key of the instance backing the machine. When spec.providerID is set it
+// is used to locate the instance, so that adopted instances whose name differs from the GCPMachine
+// can be found; otherwise the key is derived from the GCPMachine name and zone.
+func (s *Service) instanceKey() (*meta.Key, error) {
+	providerID := s.scope.GetProviderID()
+	if providerID == "" {
+		return meta.ZonalKey(s.scope.Name(), s.scope.Zone()), nil
+	}
+
+	parsed, err := providerid.Parse(providerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing spec.providerID")
+	}
+	if parsed.Project() != s.scope.Project() {
+		return nil, errors.Errorf("project %s in spec.providerID does not match cluster project %s", parsed.Project(), s.scope.Project())
+	}
+
+	return meta.ZonalKey(parsed.Name(), parsed.Location()), nil
+}
+
+// adoptInstance validates that an existing instance that isn't owned by the cluster yet matches the
+// GCPMachine and, if it does, takes ownership of it by adding the CAPG ownership labels. The instance
+// is only adopted when it has been explicitly referenced by spec.providerID.
+func (s *Service) adoptInstance(ctx context.Context, instanceKey *meta.Key, instance *compute.Instance, instanceSpec *compute.Instance) error {
+	log := log.FromContext(ctx).WithValues("name", instance.Name, "zone", instanceKey.Zone)
+	clusterName := s.scope.ClusterName()
+
+	if s.scope.GetProviderID() == "" {
+		return s.refuseAdoption(infrav1.InstanceAdoptionAmbiguousReason, "instance %s already exists and is not owned by cluster %s, set spec.providerID to adopt it", instance.Name, clusterName)
+	}
+
+	for key, value := range instance.Labels {
+		if strings.HasPrefix(key, infrav1.NameGCPProviderOwned) && key != infrav1.ClusterTagKey(clusterName) && infrav1.ResourceLifecycle(value) == infrav1.ResourceLifecycleOwned {
+			return s.refuseAdoption(infrav1.InstanceAdoptionAmbiguousReason, "instance %s is already owned by cluster %s", instance.Name, strings.TrimPrefix(key, infrav1.NameGCPProviderOwned))
+		}
+	}
+
+	if instanceKey.Zone != s.scope.Zone() {
+		return s.refuseAdoption(infrav1.InstanceAdoptionMismatchReason, "instance %s is in zone %s, but the machine failure domain is %s", instance.Name, instanceKey.Zone, s.scope.Zone())
+	}
+
+	if actual, desired := path.Base(instance.MachineType), path.Base(instanceSpec.MachineType); actual != desired {
+		return s.refuseAdoption(infrav1.InstanceAdoptionMismatchReason, "instance %s has machine type %s, but spec.instanceType is %s", instance.Name, actual, desired)
+	}
+
+	if instance.Name != instanceSpec.Name {
+		// Refuse to pick between the referenced instance and one that carries the GCPMachine name.
+		_, err := s.instances.Get(ctx, meta.ZonalKey(instanceSpec.Name, instanceKey.Zone))
+		switch {
+		case err == nil:
+			return s.refuseAdoption(infrav1.InstanceAdoptionAmbiguousReason, "both instance %s referenced by spec.providerID and instance %s matching the GCPMachine name exist", instance.Name, instanceSpec.Name)
+		case !gcperrors.IsNotFound(err):
+			return err
+		}
+	}
+
+	labels := infrav1.Labels(instance.Labels).AddLabels(infrav1.Build(infrav1.BuildParams{
+		ClusterName: clusterName,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Role:        ptr.To[string](s.scope.Role()),
+	}))
+
+	log.Info("Adopting existing instance")
+	if err := s.instanceLabels.SetLabels(ctx, instanceKey, &compute.InstancesSetLabelsRequest{
+		Labels:           labels,
+		LabelFingerprint: instance.LabelFingerprint,
+	}); err != nil {
+		log.Error(err, "Error adding ownership labels to instance")
+		return err
+	}
+	instance.Labels = labels
+
+	conditions.Set(s.scope.ConditionSetter(), metav1.Condition{
+		Type:   infrav1.InstanceAdoptedCondition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.InstanceAdoptedReason,
+	})
+
+	return nil
+}
+
+// refuseAdoption marks the InstanceAdopted condition as false and returns an error describing why the
+// instance was not adopted.
+func (s *Service) refuseAdoption(reason, format string, args ...any) error {
+	message := fmt.Sprintf(format, args...)
+	conditions.Set(s.scope.ConditionSetter(), metav1.Condition{
+		Type:    infrav1.InstanceAdoptedCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	})
+
+	return errors.Errorf("refusing to adopt instance: %s", message)
+}
+
 func (s *Service) registerControlPlaneInstance(ctx context.Context, instance *compute.Instance) error {
 	log := log.FromContext(ctx)
 	instancegroupName := s.scope.ControlPlaneGroupName()
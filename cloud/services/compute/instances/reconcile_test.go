@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -201,13 +202,32 @@ func TestService_createOrGetInstance(t *testing.T) {
 				Objects: map[meta.Key]*cloud.MockInstancesObj{
 					{Name: "my-machine", Zone: "us-central1-c"}: {Obj: &compute.Instance{
 						Name: "my-machine",
+						Labels: map[string]string{
+							"capg-cluster-my-cluster": "owned",
+						},
 					}},
 				},
 			},
 			want: &compute.Instance{
 				Name: "my-machine",
+				Labels: map[string]string{
+					"capg-cluster-my-cluster": "owned",
+				},
 			},
 		},
+		{
+			name:  "instance already exist but is not owned (should return an error)",
+			scope: func() Scope { return machineScope },
+			mockInstance: &cloud.MockInstances{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+				Objects: map[meta.Key]*cloud.MockInstancesObj{
+					{Name: "my-machine", Zone: "us-central1-c"}: {Obj: &compute.Instance{
+						Name: "my-machine",
+					}},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name:  "error getting instance with non 404 error code (should return an error)",
 			scope: func() Scope { return machineScope },
@@ -1104,3 +1124,273 @@ func TestService_createOrGetInstance(t *testing.T) {
 		})
 	}
 }
+
+type fakeInstanceLabels struct {
+	labels map[string]string
+}
+
+func (f *fakeInstanceLabels) SetLabels(_ context.Context, _ *meta.Key, req *compute.InstancesSetLabelsRequest) error {
+	f.labels = req.Labels
+	return nil
+}
+
+func TestService_createOrGetInstance_adoption(t *testing.T) {
+	fakec := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(fakeBootstrapSecret).
+		Build()
+
+	clusterScope, err := scope.NewClusterScope(context.TODO(), scope.ClusterScopeParams{
+		Client:     fakec,
+		Cluster:    fakeCluster,
+		GCPCluster: fakeGCPCluster,
+		GCPServices: scope.GCPServices{
+			Compute: &compute.Service{},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newMachineScope := func(providerID string) Scope {
+		gcpMachine := getFakeGCPMachine()
+		gcpMachine.Spec.InstanceType = "n1-standard-2"
+		if providerID != "" {
+			gcpMachine.Spec.ProviderID = ptr.To[string](providerID)
+		}
+		machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+			Client:        fakec,
+			Machine:       fakeMachine,
+			GCPMachine:    gcpMachine,
+			ClusterGetter: clusterScope,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return machineScope
+	}
+
+	tests := []struct {
+		name         string
+		providerID   string
+		instances    map[meta.Key]*cloud.MockInstancesObj
+		wantLabels   map[string]string
+		wantReason   string
+		wantAdoption bool
+		wantErr      bool
+	}{
+		{
+			name:       "instance referenced by providerID is adopted",
+			providerID: "gce://my-proj/us-central1-c/existing-vm",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "existing-vm", Zone: "us-central1-c"}: {Obj: &compute.Instance{
+					Name:        "existing-vm",
+					MachineType: "zones/us-central1-c/machineTypes/n1-standard-2",
+					Labels:      map[string]string{"team": "infra"},
+				}},
+			},
+			wantLabels: map[string]string{
+				"team":                    "infra",
+				"capg-role":               "node",
+				"capg-cluster-my-cluster": "owned",
+			},
+			wantReason:   infrav1.InstanceAdoptedReason,
+			wantAdoption: true,
+		},
+		{
+			name:       "instance referenced by providerID does not exist",
+			providerID: "gce://my-proj/us-central1-c/missing-vm",
+			wantReason: infrav1.InstanceAdoptionNotFoundReason,
+			wantErr:    true,
+		},
+		{
+			name:       "providerID in another project",
+			providerID: "gce://other-proj/us-central1-c/existing-vm",
+			wantReason: infrav1.InstanceAdoptionMismatchReason,
+			wantErr:    true,
+		},
+		{
+			name:       "machine type does not match",
+			providerID: "gce://my-proj/us-central1-c/existing-vm",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "existing-vm", Zone: "us-central1-c"}: {Obj: &compute.Instance{
+					Name:        "existing-vm",
+					MachineType: "zones/us-central1-c/machineTypes/e2-medium",
+				}},
+			},
+			wantReason: infrav1.InstanceAdoptionMismatchReason,
+			wantErr:    true,
+		},
+		{
+			name:       "instance owned by another cluster",
+			providerID: "gce://my-proj/us-central1-c/existing-vm",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "existing-vm", Zone: "us-central1-c"}: {Obj: &compute.Instance{
+					Name:        "existing-vm",
+					MachineType: "zones/us-central1-c/machineTypes/n1-standard-2",
+					Labels:      map[string]string{"capg-cluster-other-cluster": "owned"},
+				}},
+			},
+			wantReason: infrav1.InstanceAdoptionAmbiguousReason,
+			wantErr:    true,
+		},
+		{
+			name:       "instance matching the GCPMachine name also exists",
+			providerID: "gce://my-proj/us-central1-c/existing-vm",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "existing-vm", Zone: "us-central1-c"}: {Obj: &compute.Instance{
+					Name:        "existing-vm",
+					MachineType: "zones/us-central1-c/machineTypes/n1-standard-2",
+				}},
+				{Name: "my-machine", Zone: "us-central1-c"}: {Obj: &compute.Instance{
+					Name: "my-machine",
+				}},
+			},
+			wantReason: infrav1.InstanceAdoptionAmbiguousReason,
+			wantErr:    true,
+		},
+		{
+			name: "unowned instance with the GCPMachine name and no providerID",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "my-machine", Zone: "us-central1-c"}: {Obj: &compute.Instance{
+					Name:        "my-machine",
+					MachineType: "zones/us-central1-c/machineTypes/n1-standard-2",
+				}},
+			},
+			wantReason: infrav1.InstanceAdoptionAmbiguousReason,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			machineScope := newMachineScope(tt.providerID)
+			labels := &fakeInstanceLabels{}
+			s := New(machineScope)
+			s.instances = &cloud.MockInstances{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "my-proj"},
+				Objects:       tt.instances,
+			}
+			s.instanceLabels = labels
+
+			_, err := s.createOrGetInstance(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.createOrGetInstance() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if d := cmp.Diff(tt.wantLabels, labels.labels); d != "" {
+				t.Errorf("Service.createOrGetInstance() labels mismatch (-want +got):\n%s", d)
+			}
+
+			condition := conditions.Get(machineScope.ConditionSetter(), infrav1.InstanceAdoptedCondition)
+			if condition == nil {
+				t.Fatalf("expected %s condition to be set", infrav1.InstanceAdoptedCondition)
+			}
+			if condition.Reason != tt.wantReason {
+				t.Errorf("expected condition reason %q, got %q", tt.wantReason, condition.Reason)
+			}
+			if (condition.Status == metav1.ConditionTrue) != tt.wantAdoption {
+				t.Errorf("expected condition status adopted=%t, got %s", tt.wantAdoption, condition.Status)
+			}
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	fakec := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(fakeBootstrapSecret).
+		Build()
+
+	clusterScope, err := scope.NewClusterScope(context.TODO(), scope.ClusterScopeParams{
+		Client:     fakec,
+		Cluster:    fakeCluster,
+		GCPCluster: fakeGCPCluster,
+		GCPServices: scope.GCPServices{
+			Compute: &compute.Service{},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ownedLabels := map[string]string{"capg-cluster-my-cluster": "owned"}
+	tests := []struct {
+		name        string
+		providerID  string
+		instances   map[meta.Key]*cloud.MockInstancesObj
+		wantDeleted bool
+	}{
+		{
+			name:       "owned instance referenced by providerID is deleted",
+			providerID: "gce://my-proj/us-central1-c/existing-vm",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "existing-vm", Zone: "us-central1-c"}: {Obj: &compute.Instance{Name: "existing-vm", Labels: ownedLabels}},
+			},
+			wantDeleted: true,
+		},
+		{
+			name: "owned instance with the GCPMachine name is deleted",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "my-machine", Zone: "us-central1-c"}: {Obj: &compute.Instance{Name: "my-machine", Labels: ownedLabels}},
+			},
+			wantDeleted: true,
+		},
+		{
+			name:       "instance whose adoption was refused is kept",
+			providerID: "gce://my-proj/us-central1-c/existing-vm",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "existing-vm", Zone: "us-central1-c"}: {Obj: &compute.Instance{Name: "existing-vm", Labels: map[string]string{"team": "infra"}}},
+			},
+		},
+		{
+			name:       "instance owned by another cluster is kept",
+			providerID: "gce://my-proj/us-central1-c/existing-vm",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "existing-vm", Zone: "us-central1-c"}: {Obj: &compute.Instance{Name: "existing-vm", Labels: map[string]string{"capg-cluster-other-cluster": "owned"}}},
+			},
+		},
+		{
+			name:       "providerID in another project",
+			providerID: "gce://other-proj/us-central1-c/existing-vm",
+			instances: map[meta.Key]*cloud.MockInstancesObj{
+				{Name: "existing-vm", Zone: "us-central1-c"}: {Obj: &compute.Instance{Name: "existing-vm", Labels: ownedLabels}},
+			},
+		},
+		{
+			name:       "unparseable providerID",
+			providerID: "aws:///us-east-1a/i-0123456789",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gcpMachine := getFakeGCPMachine()
+			if tt.providerID != "" {
+				gcpMachine.Spec.ProviderID = ptr.To[string](tt.providerID)
+			}
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:        fakec,
+				Machine:       fakeMachine,
+				GCPMachine:    gcpMachine,
+				ClusterGetter: clusterScope,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := New(machineScope)
+			instances := &cloud.MockInstances{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "my-proj"},
+				Objects:       tt.instances,
+			}
+			s.instances = instances
+			count := len(tt.instances)
+
+			if err := s.Delete(context.TODO()); err != nil {
+				t.Fatalf("Service.Delete() error = %v", err)
+			}
+			if deleted := len(instances.Objects) < count; deleted != tt.wantDeleted {
+				t.Errorf("Service.Delete() deleted instance = %t, want %t", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
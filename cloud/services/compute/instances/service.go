@@ -26,6 +26,7 @@ import (
 	"google.golang.org/api/compute/v1"
 
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 type instancesInterface interface {
@@ -34,6 +35,10 @@ type instancesInterface interface {
 	Delete(ctx context.Context, key *meta.Key, options ...k8scloud.Option) error
 }
 
+type instanceLabelsInterface interface {
+	SetLabels(ctx context.Context, key *meta.Key, req *compute.InstancesSetLabelsRequest) error
+}
+
 type instancegroupsInterface interface {
 	AddInstances(ctx context.Context, key *meta.Key, req *compute.InstanceGroupsAddInstancesRequest, options ...k8scloud.Option) error
 	ListInstances(ctx context.Context, key *meta.Key, req *compute.InstanceGroupsListInstancesRequest, fl *filter.F, options ...k8scloud.Option) ([]*compute.InstanceWithNamedPorts, error)
@@ -43,13 +48,16 @@ type instancegroupsInterface interface {
 // Scope is an interfaces that hold used methods.
 type Scope interface {
 	cloud.Machine
+	ClusterName() string
 	InstanceSpec(log logr.Logger) *compute.Instance
+	ConditionSetter() conditions.Setter
 }
 
 // Service implements instances reconciler.
 type Service struct {
 	scope          Scope
 	instances      instancesInterface
+	instanceLabels instanceLabelsInterface
 	instancegroups instancegroupsInterface
 }
 
@@ -60,6 +68,24 @@ func New(scope Scope) *Service {
 	return &Service{
 		scope:          scope,
 		instances:      scope.Cloud().Instances(),
+		instanceLabels: &instanceLabels{service: scope.ComputeService()},
 		instancegroups: scope.Cloud().InstanceGroups(),
 	}
 }
+
+// instanceLabels sets instance labels through the compute API directly, as
+// the k8s-cloud-provider Instances client does not support it.
+type instanceLabels struct {
+	service *cloud.ComputeService
+}
+
+// SetLabels sets the labels of the instance and waits for the operation to complete.
+func (c *instanceLabels) SetLabels(ctx context.Context, key *meta.Key, req *compute.InstancesSetLabelsRequest) error {
+	projectID := c.service.ProjectRouter.ProjectID(ctx, meta.VersionGA, "instances")
+	op, err := c.service.GA.Instances.SetLabels(projectID, key.Zone, key.Name, req).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return c.service.WaitForCompletion(ctx, op)
+}
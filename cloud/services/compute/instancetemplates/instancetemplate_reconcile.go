@@ -24,11 +24,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
+	"sort"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
@@ -75,11 +78,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	for _, instanceTemplate := range instanceTemplates {
 		instanceName := instanceTemplate.Name
 
-		if instanceTemplate.Properties == nil || instanceTemplate.Properties.Labels == nil {
-			continue
-		}
-		labels := v1beta1.Labels(instanceTemplate.Properties.Labels)
-		if !labels.HasOwned(s.scope.ClusterName()) {
+		if !s.isOwned(baseKey, instanceTemplate) {
 			continue
 		}
 
@@ -103,6 +102,108 @@ func (s *Service) Delete(ctx context.Context) error {
 	return joined
 }
 
+// GarbageCollect deletes the superseded instanceTemplates of the machine pool, keeping the current one and
+// the most recently created ones up to the history limit.
+// InstanceTemplates still used by an instanceGroupManager are never deleted.
+func (s *Service) GarbageCollect(ctx context.Context, current *meta.Key) error {
+	log := log.FromContext(ctx)
+
+	baseKey, err := s.scope.BaseInstanceTemplateResourceName()
+	if err != nil {
+		return err
+	}
+
+	log = log.WithValues("instanceTemplatesPrefix", gcp.FormatKey("instanceTemplates", baseKey))
+
+	log.V(2).Info("Looking for superseded instanceTemplates")
+	var predicate *filter.F
+	instanceTemplates, err := s.instanceTemplates.List(ctx, predicate)
+	if err != nil {
+		log.Error(err, "looking for superseded instanceTemplates")
+		return err
+	}
+
+	var superseded []*compute.InstanceTemplate
+	for _, instanceTemplate := range instanceTemplates {
+		if instanceTemplate.Name == current.Name || !s.isOwned(baseKey, instanceTemplate) {
+			continue
+		}
+		superseded = append(superseded, instanceTemplate)
+	}
+
+	limit := s.scope.InstanceTemplateHistoryLimit()
+	if len(superseded) <= limit {
+		return nil
+	}
+
+	// Keep the most recently created instanceTemplates for rollback.
+	sort.Slice(superseded, func(i, j int) bool {
+		return superseded[i].CreationTimestamp > superseded[j].CreationTimestamp
+	})
+
+	instanceGroupManagers, err := s.instanceGroupManagers.AggregatedList(ctx)
+	if err != nil {
+		log.Error(err, "listing instanceGroupManagers")
+		return fmt.Errorf("listing instanceGroupManagers: %w", err)
+	}
+	inUse := referencedInstanceTemplates(instanceGroupManagers)
+
+	var errs []error
+	for _, instanceTemplate := range superseded[limit:] {
+		if inUse.Has(instanceTemplate.Name) {
+			log.V(2).Info("Keeping instanceTemplate used by an instanceGroupManager", "selfLink", instanceTemplate.SelfLink)
+			continue
+		}
+
+		log.V(2).Info("Deleting superseded instanceTemplate", "selfLink", instanceTemplate.SelfLink)
+		if err := s.instanceTemplates.Delete(ctx, meta.GlobalKey(instanceTemplate.Name)); err != nil && !gcperrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	joined := errors.Join(errs...)
+	log.Error(joined, "failed to delete superseded instanceTemplates")
+	return joined
+}
+
+// isOwned returns true if the instanceTemplate was created by this machine pool.
+// InstanceTemplate names are the base name followed by a 16 character hash.
+func (s *Service) isOwned(baseKey *meta.Key, instanceTemplate *compute.InstanceTemplate) bool {
+	if instanceTemplate.Properties == nil || instanceTemplate.Properties.Labels == nil {
+		return false
+	}
+	if !v1beta1.Labels(instanceTemplate.Properties.Labels).HasOwned(s.scope.ClusterName()) {
+		return false
+	}
+
+	suffix, ok := strings.CutPrefix(instanceTemplate.Name, baseKey.Name)
+	if !ok || len(suffix) != 16 {
+		return false
+	}
+	_, err := hex.DecodeString(suffix)
+	return err == nil
+}
+
+// referencedInstanceTemplates returns the names of the instanceTemplates used by the instanceGroupManagers.
+func referencedInstanceTemplates(instanceGroupManagers []*compute.InstanceGroupManager) sets.Set[string] {
+	names := sets.New[string]()
+	for _, igm := range instanceGroupManagers {
+		if igm.InstanceTemplate != "" {
+			names.Insert(path.Base(igm.InstanceTemplate))
+		}
+		for _, version := range igm.Versions {
+			if version.InstanceTemplate != "" {
+				names.Insert(path.Base(version.InstanceTemplate))
+			}
+		}
+	}
+	return names
+}
+
 func (s *Service) createOrGetInstanceTemplate(ctx context.Context) (*compute.InstanceTemplate, *meta.Key, error) {
 	log := log.FromContext(ctx)
 
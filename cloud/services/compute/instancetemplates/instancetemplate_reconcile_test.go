@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetemplates
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	capgcloud "sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type fakeScope struct {
+	historyLimit int
+}
+
+func (f *fakeScope) Cloud() capgcloud.Cloud                    { return nil }
+func (f *fakeScope) ComputeService() *capgcloud.ComputeService { return nil }
+func (f *fakeScope) ClusterName() string                       { return "my-cluster" }
+func (f *fakeScope) InstanceTemplateHistoryLimit() int         { return f.historyLimit }
+
+func (f *fakeScope) InstanceTemplateResource(_ context.Context) (*compute.InstanceTemplate, error) {
+	return &compute.InstanceTemplate{}, nil
+}
+
+func (f *fakeScope) BaseInstanceTemplateResourceName() (*meta.Key, error) {
+	return meta.RegionalKey("my-pool-", "us-central1"), nil
+}
+
+type fakeInstanceGroupManagers struct {
+	igms []*compute.InstanceGroupManager
+}
+
+func (f *fakeInstanceGroupManagers) AggregatedList(_ context.Context) ([]*compute.InstanceGroupManager, error) {
+	return f.igms, nil
+}
+
+func ownedTemplate(name, creationTimestamp string) *cloud.MockInstanceTemplatesObj {
+	return &cloud.MockInstanceTemplatesObj{Obj: &compute.InstanceTemplate{
+		Name:              name,
+		CreationTimestamp: creationTimestamp,
+		Properties: &compute.InstanceProperties{
+			Labels: map[string]string{"capg-cluster-my-cluster": "owned"},
+		},
+	}}
+}
+
+func TestService_GarbageCollect(t *testing.T) {
+	templates := func() map[meta.Key]*cloud.MockInstanceTemplatesObj {
+		return map[meta.Key]*cloud.MockInstanceTemplatesObj{
+			*meta.GlobalKey("my-pool-0000000000000001"): ownedTemplate("my-pool-0000000000000001", "2025-01-01T00:00:00.000-07:00"),
+			*meta.GlobalKey("my-pool-0000000000000002"): ownedTemplate("my-pool-0000000000000002", "2025-01-02T00:00:00.000-07:00"),
+			*meta.GlobalKey("my-pool-0000000000000003"): ownedTemplate("my-pool-0000000000000003", "2025-01-03T00:00:00.000-07:00"),
+			*meta.GlobalKey("my-pool-0000000000000004"): ownedTemplate("my-pool-0000000000000004", "2025-01-04T00:00:00.000-07:00"),
+			// Owned by another machine pool of the cluster.
+			*meta.GlobalKey("my-pool-b-000000000000001"): ownedTemplate("my-pool-b-000000000000001", "2025-01-01T00:00:00.000-07:00"),
+			// Not owned by the cluster.
+			*meta.GlobalKey("my-pool-0000000000000000"): {Obj: &compute.InstanceTemplate{
+				Name:              "my-pool-0000000000000000",
+				CreationTimestamp: "2024-01-01T00:00:00.000-07:00",
+			}},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		historyLimit int
+		igms         []*compute.InstanceGroupManager
+		want         []string
+	}{
+		{
+			name:         "keeps the current and the most recent superseded instanceTemplates",
+			historyLimit: 1,
+			want: []string{
+				"my-pool-0000000000000000",
+				"my-pool-0000000000000003",
+				"my-pool-0000000000000004",
+				"my-pool-b-000000000000001",
+			},
+		},
+		{
+			name:         "keeps instanceTemplates used by an instanceGroupManager",
+			historyLimit: 0,
+			igms: []*compute.InstanceGroupManager{
+				{
+					InstanceTemplate: "https://www.googleapis.com/compute/v1/projects/my-proj/global/instanceTemplates/my-pool-0000000000000004",
+					Versions: []*compute.InstanceGroupManagerVersion{
+						{InstanceTemplate: "https://www.googleapis.com/compute/v1/projects/my-proj/global/instanceTemplates/my-pool-0000000000000001"},
+					},
+				},
+			},
+			want: []string{
+				"my-pool-0000000000000000",
+				"my-pool-0000000000000001",
+				"my-pool-0000000000000004",
+				"my-pool-b-000000000000001",
+			},
+		},
+		{
+			name:         "nothing to delete within the history limit",
+			historyLimit: 5,
+			want: []string{
+				"my-pool-0000000000000000",
+				"my-pool-0000000000000001",
+				"my-pool-0000000000000002",
+				"my-pool-0000000000000003",
+				"my-pool-0000000000000004",
+				"my-pool-b-000000000000001",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mock := cloud.NewMockInstanceTemplates(&cloud.SingleProjectRouter{ID: "my-proj"}, templates())
+			s := &Service{
+				scope:                 &fakeScope{historyLimit: tt.historyLimit},
+				instanceTemplates:     mock,
+				instanceGroupManagers: &fakeInstanceGroupManagers{igms: tt.igms},
+			}
+
+			if err := s.GarbageCollect(ctx, meta.GlobalKey("my-pool-0000000000000004")); err != nil {
+				t.Fatalf("Service.GarbageCollect() error = %v", err)
+			}
+
+			var got []string
+			for key := range mock.Objects {
+				got = append(got, key.Name)
+			}
+			sort.Strings(got)
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Errorf("Service.GarbageCollect() mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
+}
@@ -34,9 +34,14 @@ type instancetemplatesInterface interface {
 	Delete(ctx context.Context, key *meta.Key, options ...k8scloud.Option) error
 }
 
+type instanceGroupManagersInterface interface {
+	AggregatedList(ctx context.Context) ([]*compute.InstanceGroupManager, error)
+}
+
 // Scope is an interfaces that hold used methods.
 type Scope interface {
 	Cloud() cloud.Cloud
+	ComputeService() *cloud.ComputeService
 
 	// ClusterName returns the name of the cluster.
 	ClusterName() string
@@ -46,12 +51,16 @@ type Scope interface {
 
 	// BaseInstanceTemplateResourceName returns the base instanceTemplate selfLink
 	BaseInstanceTemplateResourceName() (*meta.Key, error)
+
+	// InstanceTemplateHistoryLimit returns the number of superseded instanceTemplates to keep
+	InstanceTemplateHistoryLimit() int
 }
 
 // Service implements managed instance groups reconciler.
 type Service struct {
-	scope             Scope
-	instanceTemplates instancetemplatesInterface
+	scope                 Scope
+	instanceTemplates     instancetemplatesInterface
+	instanceGroupManagers instanceGroupManagersInterface
 }
 
 // var _ cloud.Reconciler = &Service{}
@@ -61,7 +70,31 @@ func New(scope Scope) *Service {
 	cloudScope := scope.Cloud()
 
 	return &Service{
-		scope:             scope,
-		instanceTemplates: cloudScope.InstanceTemplates(),
+		scope:                 scope,
+		instanceTemplates:     cloudScope.InstanceTemplates(),
+		instanceGroupManagers: &instanceGroupManagers{service: scope.ComputeService()},
+	}
+}
+
+// instanceGroupManagers lists instanceGroupManagers across all zones and regions through the
+// compute API directly, as the k8s-cloud-provider client only lists them per zone.
+type instanceGroupManagers struct {
+	service *cloud.ComputeService
+}
+
+// AggregatedList returns the zonal and regional instanceGroupManagers of the project.
+func (c *instanceGroupManagers) AggregatedList(ctx context.Context) ([]*compute.InstanceGroupManager, error) {
+	projectID := c.service.ProjectRouter.ProjectID(ctx, meta.VersionGA, "instanceGroupManagers")
+
+	var igms []*compute.InstanceGroupManager
+	if err := c.service.GA.InstanceGroupManagers.AggregatedList(projectID).Pages(ctx, func(page *compute.InstanceGroupManagerAggregatedList) error {
+		for _, scoped := range page.Items {
+			igms = append(igms, scoped.InstanceGroupManagers...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
+
+	return igms, nil
 }
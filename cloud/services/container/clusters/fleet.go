@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"google.golang.org/api/gkehub/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+const (
+	// connectGatewayEndpoint is the endpoint of the Connect Gateway API, which proxies requests to the fleet members.
+	connectGatewayEndpoint = "https://connectgateway.googleapis.com/v1/"
+
+	// membershipStateReady is the state of a fleet membership once the cluster is registered.
+	membershipStateReady = "READY"
+)
+
+// reconcileFleetMembership registers the cluster in the fleet of the spec, and unregisters it when the fleet is
+// removed from the spec. It returns the fleet membership once the registration is complete, or nil otherwise.
+func (s *Service) reconcileFleetMembership(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) (*gkehub.Membership, error) {
+	status := &s.scope.GCPManagedControlPlane.Status
+	fleet := s.scope.GCPManagedControlPlane.Spec.Fleet
+	name := s.fleetMembershipName()
+	if status.FleetMembership != "" && (fleet == nil || status.FleetMembership != name) {
+		if err := s.unregisterFleetMembership(ctx, log); err != nil {
+			return nil, err
+		}
+	}
+	if fleet == nil {
+		v1beta1conditions.Delete(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneFleetRegisteredCondition)
+		return nil, nil
+	}
+
+	gkeHubService, err := s.scope.GKEHubService(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneFleetRegisteredCondition, infrav1exp.GKEControlPlaneFleetRegistrationFailedReason, clusterv1beta1.ConditionSeverityError, "%v", err)
+		return nil, err
+	}
+	membership, err := gkeHubService.Projects.Locations.Memberships.Get(name).Context(ctx).Do()
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneFleetRegisteredCondition, infrav1exp.GKEControlPlaneFleetRegistrationFailedReason, clusterv1beta1.ConditionSeverityError, "getting fleet membership: %v", err)
+			return nil, fmt.Errorf("getting fleet membership %s: %w", name, err)
+		}
+
+		log.Info("Registering cluster in fleet", "membership", name)
+		_, err := gkeHubService.Projects.Locations.Memberships.Create(s.fleetMembershipParent(), s.desiredFleetMembership(cluster)).MembershipId(s.fleetMembershipID()).Context(ctx).Do()
+		if err != nil {
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneFleetRegisteredCondition, infrav1exp.GKEControlPlaneFleetRegistrationFailedReason, clusterv1beta1.ConditionSeverityError, "creating fleet membership: %v", err)
+			return nil, fmt.Errorf("creating fleet membership %s: %w", name, err)
+		}
+		status.FleetMembership = name
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneFleetRegisteredCondition, infrav1exp.GKEControlPlaneFleetRegisteringReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return nil, nil
+	}
+
+	if resourceLink := membershipResourceLink(membership); resourceLink != s.clusterResourceLink() {
+		log.Info("Fleet membership belongs to another cluster", "membership", name, "resourceLink", resourceLink)
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneFleetRegisteredCondition, infrav1exp.GKEControlPlaneFleetRegistrationFailedReason, clusterv1beta1.ConditionSeverityWarning, "fleet membership %s belongs to %s", name, resourceLink)
+		return nil, nil
+	}
+	status.FleetMembership = name
+	if membership.State == nil || membership.State.Code != membershipStateReady {
+		log.Info("Fleet registration in progress", "membership", name)
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneFleetRegisteredCondition, infrav1exp.GKEControlPlaneFleetRegisteringReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return nil, nil
+	}
+	v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneFleetRegisteredCondition)
+
+	return membership, nil
+}
+
+// unregisterFleetMembership deletes the fleet membership of the cluster, if any.
+func (s *Service) unregisterFleetMembership(ctx context.Context, log *logr.Logger) error {
+	status := &s.scope.GCPManagedControlPlane.Status
+	if status.FleetMembership == "" {
+		return nil
+	}
+
+	log.Info("Unregistering cluster from fleet", "membership", status.FleetMembership)
+	if err := s.deleteFleetMembership(ctx, status.FleetMembership); err != nil {
+		return fmt.Errorf("deleting fleet membership %s: %w", status.FleetMembership, err)
+	}
+	status.FleetMembership = ""
+
+	return nil
+}
+
+func (s *Service) deleteFleetMembership(ctx context.Context, name string) error {
+	gkeHubService, err := s.scope.GKEHubService(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = gkeHubService.Projects.Locations.Memberships.Delete(name).Context(ctx).Do()
+	return gcperrors.IgnoreNotFound(err)
+}
+
+// fleetProject returns the fleet host project, which defaults to the project of the cluster.
+func (s *Service) fleetProject() string {
+	if fleet := s.scope.GCPManagedControlPlane.Spec.Fleet; fleet != nil && fleet.Project != "" {
+		return fleet.Project
+	}
+	return s.scope.GCPManagedControlPlane.Spec.Project
+}
+
+// fleetMembershipParent returns the location of the fleet memberships in the fleet host project.
+func (s *Service) fleetMembershipParent() string {
+	return fmt.Sprintf("projects/%s/locations/global", s.fleetProject())
+}
+
+// fleetMembershipID returns the name of the fleet membership of the cluster, which defaults to the cluster name.
+func (s *Service) fleetMembershipID() string {
+	if fleet := s.scope.GCPManagedControlPlane.Spec.Fleet; fleet != nil && fleet.MembershipName != "" {
+		return fleet.MembershipName
+	}
+	return s.scope.ClusterName()
+}
+
+// fleetMembershipName returns the full name of the fleet membership of the cluster.
+func (s *Service) fleetMembershipName() string {
+	return fmt.Sprintf("%s/memberships/%s", s.fleetMembershipParent(), s.fleetMembershipID())
+}
+
+// clusterResourceLink returns the self-link of the cluster that fleet memberships refer to.
+func (s *Service) clusterResourceLink() string {
+	return "//container.googleapis.com/" + s.scope.ClusterFullName()
+}
+
+// desiredFleetMembership returns the fleet membership of the cluster. Fleet workload identity is enabled when the
+// cluster has workload identity enabled.
+func (s *Service) desiredFleetMembership(cluster *containerpb.Cluster) *gkehub.Membership {
+	membership := &gkehub.Membership{
+		Endpoint: &gkehub.MembershipEndpoint{
+			GkeCluster: &gkehub.GkeCluster{
+				ResourceLink: s.clusterResourceLink(),
+			},
+		},
+	}
+	if cluster.GetWorkloadIdentityConfig().GetWorkloadPool() != "" {
+		membership.Authority = &gkehub.Authority{
+			Issuer: "https://container.googleapis.com/v1/" + s.scope.ClusterFullName(),
+		}
+	}
+
+	return membership
+}
+
+func membershipResourceLink(membership *gkehub.Membership) string {
+	if membership.Endpoint == nil || membership.Endpoint.GkeCluster == nil {
+		return ""
+	}
+	return membership.Endpoint.GkeCluster.ResourceLink
+}
+
+// reconcileConnectGatewayKubeconfig creates the kubeconfig secret that reaches the cluster through the Connect
+// Gateway once the cluster is registered in its fleet, and deletes it when it is no longer requested.
+func (s *Service) reconcileConnectGatewayKubeconfig(ctx context.Context, membership *gkehub.Membership, log *logr.Logger) error {
+	clusterRef := types.NamespacedName{
+		Name:      s.scope.Cluster.Name + "-connect-gateway",
+		Namespace: s.scope.Cluster.Namespace,
+	}
+
+	if fleet := s.scope.GCPManagedControlPlane.Spec.Fleet; fleet == nil || !fleet.ConnectGatewayKubeconfig {
+		configSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secret.Name(clusterRef.Name, secret.Kubeconfig),
+				Namespace: clusterRef.Namespace,
+			},
+		}
+		if err := s.scope.Client().Delete(ctx, configSecret); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting connect gateway kubeconfig secret: %w", err)
+		}
+		return nil
+	}
+	if membership == nil {
+		return nil
+	}
+
+	log.Info("Reconciling connect gateway kubeconfig")
+	out, tokenExpiry, err := s.connectGatewayKubeconfig(ctx, membership)
+	if err != nil {
+		return fmt.Errorf("generating connect gateway kubeconfig: %w", err)
+	}
+
+	return s.applyKubeconfigSecret(ctx, clusterRef, out, tokenExpiry, log)
+}
+
+// connectGatewayKubeconfig generates the kubeconfig that reaches the cluster through the Connect Gateway of its fleet
+// membership, with the same credentials as the user kubeconfig.
+func (s *Service) connectGatewayKubeconfig(ctx context.Context, membership *gkehub.Membership) ([]byte, time.Time, error) {
+	contextName := fmt.Sprintf("connectgateway_%s_global_%s", s.fleetProject(), s.fleetMembershipID())
+	authInfo, tokenExpiry, err := s.userAuthInfo(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	cfg := &api.Config{
+		APIVersion: api.SchemeGroupVersion.Version,
+		Clusters: map[string]*api.Cluster{
+			contextName: {
+				Server: connectGatewayServer(membership.Name),
+			},
+		},
+		Contexts: map[string]*api.Context{
+			contextName: {
+				Cluster:  contextName,
+				AuthInfo: contextName,
+			},
+		},
+		AuthInfos: map[string]*api.AuthInfo{
+			contextName: authInfo,
+		},
+		CurrentContext: contextName,
+	}
+
+	out, err := clientcmd.Write(*cfg)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("serialize kubeconfig to yaml: %w", err)
+	}
+
+	return out, tokenExpiry, nil
+}
+
+// connectGatewayServer returns the Connect Gateway server of the fleet membership.
+func connectGatewayServer(membershipName string) string {
+	return connectGatewayEndpoint + strings.Replace(membershipName, "/memberships/", "/gkeMemberships/", 1)
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/gkehub/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func newFleetTestService(fleet *infrav1exp.Fleet) *Service {
+	return newTestService(&infrav1exp.GCPManagedControlPlane{
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				Project:  "test-project",
+				Location: "us-central1",
+			},
+			ClusterName: "test-cluster",
+			Fleet:       fleet,
+		},
+	})
+}
+
+func TestFleetMembershipName(t *testing.T) {
+	tests := []struct {
+		name     string
+		fleet    *infrav1exp.Fleet
+		expected string
+	}{
+		{
+			name:     "defaults to the cluster project and name",
+			fleet:    &infrav1exp.Fleet{},
+			expected: "projects/test-project/locations/global/memberships/test-cluster",
+		},
+		{
+			name:     "fleet host project and membership name",
+			fleet:    &infrav1exp.Fleet{Project: "fleet-project", MembershipName: "member"},
+			expected: "projects/fleet-project/locations/global/memberships/member",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newFleetTestService(tt.fleet).fleetMembershipName(); got != tt.expected {
+				t.Errorf("fleetMembershipName() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDesiredFleetMembership(t *testing.T) {
+	tests := []struct {
+		name     string
+		cluster  *containerpb.Cluster
+		expected *gkehub.Membership
+	}{
+		{
+			name:    "without workload identity",
+			cluster: &containerpb.Cluster{},
+			expected: &gkehub.Membership{
+				Endpoint: &gkehub.MembershipEndpoint{
+					GkeCluster: &gkehub.GkeCluster{
+						ResourceLink: "//container.googleapis.com/projects/test-project/locations/us-central1/clusters/test-cluster",
+					},
+				},
+			},
+		},
+		{
+			name: "with workload identity",
+			cluster: &containerpb.Cluster{
+				WorkloadIdentityConfig: &containerpb.WorkloadIdentityConfig{WorkloadPool: "test-project.svc.id.goog"},
+			},
+			expected: &gkehub.Membership{
+				Endpoint: &gkehub.MembershipEndpoint{
+					GkeCluster: &gkehub.GkeCluster{
+						ResourceLink: "//container.googleapis.com/projects/test-project/locations/us-central1/clusters/test-cluster",
+					},
+				},
+				Authority: &gkehub.Authority{
+					Issuer: "https://container.googleapis.com/v1/projects/test-project/locations/us-central1/clusters/test-cluster",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newFleetTestService(&infrav1exp.Fleet{}).desiredFleetMembership(tt.cluster)
+			if !cmp.Equal(got, tt.expected) {
+				t.Errorf("desiredFleetMembership() mismatch (-got +want):\n%s", cmp.Diff(got, tt.expected))
+			}
+		})
+	}
+}
+
+func TestConnectGatewayKubeconfig(t *testing.T) {
+	s := newFleetTestService(&infrav1exp.Fleet{ConnectGatewayKubeconfig: true})
+	membership := &gkehub.Membership{Name: "projects/123456/locations/global/memberships/test-cluster"}
+
+	out, expiry, err := s.connectGatewayKubeconfig(context.TODO(), membership)
+	if err != nil {
+		t.Fatalf("connectGatewayKubeconfig() error = %v", err)
+	}
+	if !expiry.IsZero() {
+		t.Errorf("connectGatewayKubeconfig() expiry = %v, want zero", expiry)
+	}
+
+	cfg, err := clientcmd.Load(out)
+	if err != nil {
+		t.Fatalf("loading kubeconfig: %v", err)
+	}
+	contextName := "connectgateway_test-project_global_test-cluster"
+	if cfg.CurrentContext != contextName {
+		t.Errorf("current context = %q, want %q", cfg.CurrentContext, contextName)
+	}
+	server := "https://connectgateway.googleapis.com/v1/projects/123456/locations/global/gkeMemberships/test-cluster"
+	if got := cfg.Clusters[contextName].Server; got != server {
+		t.Errorf("server = %q, want %q", got, server)
+	}
+	if authInfo := cfg.AuthInfos[contextName]; authInfo == nil || authInfo.Exec == nil || authInfo.Exec.Command != "gke-gcloud-auth-plugin" {
+		t.Errorf("connect gateway kubeconfig should use gke-gcloud-auth-plugin, got %+v", authInfo)
+	}
+}
+
+// fakeGKEHub serves the fleet memberships of the GKE Hub API and records the requests.
+type fakeGKEHub struct {
+	memberships map[string]*gkehub.Membership
+	requests    []string
+}
+
+func (f *fakeGKEHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/v1/")
+	f.requests = append(f.requests, r.Method+" "+name)
+	w.Header().Set("Content-Type", "application/json")
+	switch r.Method {
+	case http.MethodGet, http.MethodDelete:
+		membership, ok := f.memberships[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"message":"not found"}}`))
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(f.memberships, name)
+			_, _ = w.Write([]byte(`{"name":"operation"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(membership)
+	case http.MethodPost:
+		membership := &gkehub.Membership{}
+		_ = json.NewDecoder(r.Body).Decode(membership)
+		f.memberships[name+"/"+r.URL.Query().Get("membershipId")] = membership
+		_, _ = w.Write([]byte(`{"name":"operation"}`))
+	}
+}
+
+// newFleetReconcileTestService returns a service whose GKE Hub client is served by the fake.
+func newFleetReconcileTestService(t *testing.T, controlPlane *infrav1exp.GCPManagedControlPlane, hub *fakeGKEHub) *Service {
+	t.Helper()
+	var gkeHubService *gkehub.Service
+	if hub != nil {
+		server := httptest.NewServer(hub)
+		t.Cleanup(server.Close)
+		var err error
+		gkeHubService, err = gkehub.NewService(context.Background(), option.WithEndpoint(server.URL+"/"), option.WithoutAuthentication())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return newScopedTestService(t, controlPlane, nil, gkeHubService)
+}
+
+func newFleetTestControlPlane(fleet *infrav1exp.Fleet, fleetMembership string) *infrav1exp.GCPManagedControlPlane {
+	return &infrav1exp.GCPManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-control-plane",
+			Namespace: "default",
+		},
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				Project:  "test-project",
+				Location: "us-central1",
+			},
+			ClusterName: "test-cluster",
+			Fleet:       fleet,
+		},
+		Status: infrav1exp.GCPManagedControlPlaneStatus{
+			FleetMembership: fleetMembership,
+		},
+	}
+}
+
+func TestReconcileFleetMembership(t *testing.T) {
+	const (
+		membershipName = "projects/test-project/locations/global/memberships/test-cluster"
+		resourceLink   = "//container.googleapis.com/projects/test-project/locations/us-central1/clusters/test-cluster"
+	)
+	membership := func(resourceLink, state string) *gkehub.Membership {
+		return &gkehub.Membership{
+			Endpoint: &gkehub.MembershipEndpoint{GkeCluster: &gkehub.GkeCluster{ResourceLink: resourceLink}},
+			State:    &gkehub.MembershipState{Code: state},
+		}
+	}
+
+	tests := []struct {
+		name                    string
+		fleet                   *infrav1exp.Fleet
+		fleetMembership         string
+		memberships             map[string]*gkehub.Membership
+		wantMembership          bool
+		wantStatus              string
+		wantConditionStatus     corev1.ConditionStatus
+		wantConditionReason     string
+		wantRequests            []string
+		wantRemainingMembership bool
+	}{
+		{
+			name:                "registers the cluster",
+			fleet:               &infrav1exp.Fleet{},
+			memberships:         map[string]*gkehub.Membership{},
+			wantStatus:          membershipName,
+			wantConditionStatus: corev1.ConditionFalse,
+			wantConditionReason: infrav1exp.GKEControlPlaneFleetRegisteringReason,
+			wantRequests: []string{
+				"GET " + membershipName,
+				"POST projects/test-project/locations/global/memberships",
+			},
+			wantRemainingMembership: true,
+		},
+		{
+			name:                    "waits for the registration",
+			fleet:                   &infrav1exp.Fleet{},
+			fleetMembership:         membershipName,
+			memberships:             map[string]*gkehub.Membership{membershipName: membership(resourceLink, "CREATING")},
+			wantStatus:              membershipName,
+			wantConditionStatus:     corev1.ConditionFalse,
+			wantConditionReason:     infrav1exp.GKEControlPlaneFleetRegisteringReason,
+			wantRequests:            []string{"GET " + membershipName},
+			wantRemainingMembership: true,
+		},
+		{
+			name:                    "returns the ready membership",
+			fleet:                   &infrav1exp.Fleet{},
+			fleetMembership:         membershipName,
+			memberships:             map[string]*gkehub.Membership{membershipName: membership(resourceLink, membershipStateReady)},
+			wantMembership:          true,
+			wantStatus:              membershipName,
+			wantConditionStatus:     corev1.ConditionTrue,
+			wantRequests:            []string{"GET " + membershipName},
+			wantRemainingMembership: true,
+		},
+		{
+			name:                    "doesn't adopt the membership of another cluster",
+			fleet:                   &infrav1exp.Fleet{},
+			memberships:             map[string]*gkehub.Membership{membershipName: membership("//container.googleapis.com/projects/test-project/locations/us-central1/clusters/other", membershipStateReady)},
+			wantConditionStatus:     corev1.ConditionFalse,
+			wantConditionReason:     infrav1exp.GKEControlPlaneFleetRegistrationFailedReason,
+			wantRequests:            []string{"GET " + membershipName},
+			wantRemainingMembership: true,
+		},
+		{
+			name:            "unregisters the cluster when the fleet is removed",
+			fleetMembership: membershipName,
+			memberships:     map[string]*gkehub.Membership{membershipName: membership(resourceLink, membershipStateReady)},
+			wantRequests:    []string{"DELETE " + membershipName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := &fakeGKEHub{memberships: tt.memberships}
+			s := newFleetReconcileTestService(t, newFleetTestControlPlane(tt.fleet, tt.fleetMembership), hub)
+			log := ctrl.Log.WithName("test")
+
+			got, err := s.reconcileFleetMembership(context.Background(), &containerpb.Cluster{Name: "test-cluster"}, &log)
+			if err != nil {
+				t.Fatalf("reconcileFleetMembership() error = %v", err)
+			}
+			if (got != nil) != tt.wantMembership {
+				t.Errorf("reconcileFleetMembership() = %v, want membership %v", got, tt.wantMembership)
+			}
+			if status := s.scope.GCPManagedControlPlane.Status.FleetMembership; status != tt.wantStatus {
+				t.Errorf("status.fleetMembership = %q, want %q", status, tt.wantStatus)
+			}
+			condition := v1beta1conditions.Get(s.scope.GCPManagedControlPlane, infrav1exp.GKEControlPlaneFleetRegisteredCondition)
+			switch {
+			case tt.wantConditionStatus == "" && condition != nil:
+				t.Errorf("fleet registered condition = %v, want none", condition)
+			case tt.wantConditionStatus != "" && (condition == nil || condition.Status != tt.wantConditionStatus || condition.Reason != tt.wantConditionReason):
+				t.Errorf("fleet registered condition = %v, want status %s and reason %q", condition, tt.wantConditionStatus, tt.wantConditionReason)
+			}
+			if !cmp.Equal(hub.requests, tt.wantRequests) {
+				t.Errorf("GKE Hub requests mismatch (-got +want):\n%s", cmp.Diff(hub.requests, tt.wantRequests))
+			}
+			if _, ok := hub.memberships[membershipName]; ok != tt.wantRemainingMembership {
+				t.Errorf("membership exists = %v, want %v", ok, tt.wantRemainingMembership)
+			}
+		})
+	}
+}
+
+func TestReconcileFleetMembershipWithoutFleet(t *testing.T) {
+	// Without a GKE Hub client, a request would use the default credentials.
+	s := newFleetReconcileTestService(t, newFleetTestControlPlane(nil, ""), nil)
+	log := ctrl.Log.WithName("test")
+
+	got, err := s.reconcileFleetMembership(context.Background(), &containerpb.Cluster{Name: "test-cluster"}, &log)
+	if err != nil || got != nil {
+		t.Errorf("reconcileFleetMembership() = %v, %v, want no membership and no error", got, err)
+	}
+}
+
+func TestUnregisterFleetMembership(t *testing.T) {
+	const membershipName = "projects/test-project/locations/global/memberships/test-cluster"
+
+	tests := []struct {
+		name            string
+		fleetMembership string
+		memberships     map[string]*gkehub.Membership
+		wantRequests    []string
+	}{
+		{
+			name: "nothing to unregister",
+		},
+		{
+			name:            "deletes the membership",
+			fleetMembership: membershipName,
+			memberships:     map[string]*gkehub.Membership{membershipName: {}},
+			wantRequests:    []string{"DELETE " + membershipName},
+		},
+		{
+			name:            "ignores a membership that is already deleted",
+			fleetMembership: membershipName,
+			memberships:     map[string]*gkehub.Membership{},
+			wantRequests:    []string{"DELETE " + membershipName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := &fakeGKEHub{memberships: tt.memberships}
+			s := newFleetReconcileTestService(t, newFleetTestControlPlane(&infrav1exp.Fleet{}, tt.fleetMembership), hub)
+			log := ctrl.Log.WithName("test")
+
+			if err := s.unregisterFleetMembership(context.Background(), &log); err != nil {
+				t.Fatalf("unregisterFleetMembership() error = %v", err)
+			}
+			if status := s.scope.GCPManagedControlPlane.Status.FleetMembership; status != "" {
+				t.Errorf("status.fleetMembership = %q, want it cleared", status)
+			}
+			if !cmp.Equal(hub.requests, tt.wantRequests) {
+				t.Errorf("GKE Hub requests mismatch (-got +want):\n%s", cmp.Diff(hub.requests, tt.wantRequests))
+			}
+			if len(hub.memberships) != 0 {
+				t.Errorf("memberships = %v, want none", hub.memberships)
+			}
+		})
+	}
+}
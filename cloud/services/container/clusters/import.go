@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"strings"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/cluster-api/util/record"
+)
+
+// reconcileImport imports the existing cluster and reports the settings that differ from the spec and will be changed
+// by the following reconciliations.
+func (s *Service) reconcileImport(cluster *containerpb.Cluster, log *logr.Logger) {
+	log.Info("Importing existing cluster", "name", s.scope.ClusterName())
+	s.importCluster(cluster)
+	v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneImportedCondition)
+
+	if changedFields := s.checkImmutableFields(cluster); len(changedFields) > 0 {
+		log.Info("Imported cluster differs in fields that can't be updated", "fields", changedFields)
+		record.Warnf(s.scope.GCPManagedControlPlane, "ImportedClusterImmutableFieldsChanged", "Fields can't be updated on imported cluster %s: %s", s.scope.ClusterName(), strings.Join(changedFields, ", "))
+	}
+	if settings := s.pendingClusterUpdates(cluster, log); len(settings) > 0 {
+		log.Info("Imported cluster differs from the spec and will be updated", "settings", settings)
+		record.Eventf(s.scope.GCPManagedControlPlane, "ImportedClusterUpdatePending", "Imported cluster %s will be updated: %s", s.scope.ClusterName(), strings.Join(settings, ", "))
+	}
+}
+
+// importCluster reads the settings of the existing cluster that are not specified into the spec of the
+// GCPManagedControlPlane, so that adopting the cluster doesn't change them. The settings that are specified are left
+// untouched and are applied to the cluster by the following reconciliations.
+func (s *Service) importCluster(cluster *containerpb.Cluster) {
+	spec := &s.scope.GCPManagedControlPlane.Spec
+
+	if spec.Description == "" {
+		spec.Description = cluster.GetDescription()
+	}
+	if cluster.GetAutopilot().GetEnabled() {
+		spec.EnableAutopilot = true
+	}
+	if spec.EnableIdentityService == nil {
+		spec.EnableIdentityService = ptr.To(cluster.GetIdentityServiceConfig().GetEnabled())
+	}
+	if spec.ReleaseChannel == nil {
+		spec.ReleaseChannel = convertFromSdkReleaseChannel(cluster.GetReleaseChannel().GetChannel())
+	}
+	if spec.MasterAuthorizedNetworksConfig == nil {
+		spec.MasterAuthorizedNetworksConfig = convertFromSdkMasterAuthorizedNetworksConfig(cluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetAuthorizedNetworksConfig())
+	}
+	if spec.Notifications == nil {
+		spec.Notifications = convertFromSdkNotificationConfig(cluster.GetNotificationConfig())
+	}
+	if spec.BinaryAuthorization == nil && cluster.GetBinaryAuthorization().GetEvaluationMode() == containerpb.BinaryAuthorization_PROJECT_SINGLETON_POLICY_ENFORCE {
+		spec.BinaryAuthorization = ptr.To(infrav1exp.EvaluationModeProjectSingletonPolicyEnforce)
+	}
+	if !spec.EnableAutopilot {
+		if spec.LoggingService == nil && cluster.GetLoggingService() != "" {
+			spec.LoggingService = ptr.To(infrav1exp.LoggingService(cluster.GetLoggingService()))
+		}
+		if spec.MonitoringService == nil && cluster.GetMonitoringService() != "" {
+			spec.MonitoringService = ptr.To(infrav1exp.MonitoringService(cluster.GetMonitoringService()))
+		}
+	}
+
+	if spec.ClusterNetwork == nil {
+		spec.ClusterNetwork = &infrav1exp.ClusterNetwork{}
+	}
+	importClusterNetwork(spec.ClusterNetwork, cluster)
+
+	if spec.ClusterSecurity == nil {
+		spec.ClusterSecurity = &infrav1exp.ClusterSecurity{}
+	}
+	importClusterSecurity(spec.ClusterSecurity, cluster)
+}
+
+// importClusterNetwork reads the network settings of the existing cluster that are not specified into the spec.
+func importClusterNetwork(cn *infrav1exp.ClusterNetwork, cluster *containerpb.Cluster) {
+	ipAllocationPolicy := cluster.GetIpAllocationPolicy()
+	if ipAllocationPolicy.GetUseIpAliases() {
+		cn.UseIPAliases = true
+		if cn.Pod == nil {
+			cn.Pod = &infrav1exp.ClusterNetworkPod{CidrBlock: ipAllocationPolicy.GetClusterIpv4CidrBlock()}
+		}
+		if cn.Service == nil {
+			cn.Service = &infrav1exp.ClusterNetworkService{CidrBlock: ipAllocationPolicy.GetServicesIpv4CidrBlock()}
+		}
+	}
+
+	ipEndpointsConfig := cluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig()
+	privateNodes := cluster.GetPrivateClusterConfig().GetEnablePrivateNodes()
+	if networkConfig := cluster.GetNetworkConfig(); networkConfig != nil && networkConfig.DefaultEnablePrivateNodes != nil {
+		privateNodes = networkConfig.GetDefaultEnablePrivateNodes()
+	}
+	privateEndpoint := ipEndpointsConfig != nil && ipEndpointsConfig.EnablePublicEndpoint != nil && !ipEndpointsConfig.GetEnablePublicEndpoint()
+	if cn.PrivateCluster == nil && (privateNodes || privateEndpoint) {
+		cn.PrivateCluster = &infrav1exp.PrivateCluster{
+			EnablePrivateEndpoint:    privateEndpoint,
+			EnablePrivateNodes:       privateNodes,
+			ControlPlaneCidrBlock:    cluster.GetPrivateClusterConfig().GetMasterIpv4CidrBlock(),
+			ControlPlaneGlobalAccess: ipEndpointsConfig.GetGlobalAccess(),
+			DisableDefaultSNAT:       cluster.GetNetworkConfig().GetDefaultSnatStatus().GetDisabled(),
+		}
+	}
+
+	if cn.DatapathProvider == nil {
+		switch cluster.GetNetworkConfig().GetDatapathProvider() {
+		case containerpb.DatapathProvider_ADVANCED_DATAPATH:
+			cn.DatapathProvider = ptr.To(infrav1exp.AdvancedDatapath)
+		case containerpb.DatapathProvider_LEGACY_DATAPATH:
+			cn.DatapathProvider = ptr.To(infrav1exp.LegacyDatapath)
+		}
+	}
+}
+
+// importClusterSecurity reads the security settings of the existing cluster that are not specified into the spec.
+func importClusterSecurity(cs *infrav1exp.ClusterSecurity, cluster *containerpb.Cluster) {
+	if cs.WorkloadIdentityConfig == nil && cluster.GetWorkloadIdentityConfig().GetWorkloadPool() != "" {
+		cs.WorkloadIdentityConfig = &infrav1exp.WorkloadIdentityConfig{
+			WorkloadPool: cluster.GetWorkloadIdentityConfig().GetWorkloadPool(),
+		}
+	}
+	if cs.AuthenticatorGroupConfig == nil && cluster.GetAuthenticatorGroupsConfig().GetEnabled() {
+		cs.AuthenticatorGroupConfig = &infrav1exp.AuthenticatorGroupConfig{
+			SecurityGroups: cluster.GetAuthenticatorGroupsConfig().GetSecurityGroup(),
+		}
+	}
+	if cs.DatabaseEncryption == nil && cluster.GetDatabaseEncryption().GetState() == containerpb.DatabaseEncryption_ENCRYPTED {
+		cs.DatabaseEncryption = &infrav1exp.DatabaseEncryption{
+			State:   infrav1exp.DatabaseEncryptionStateEncrypted,
+			KeyName: cluster.GetDatabaseEncryption().GetKeyName(),
+		}
+	}
+}
+
+// pendingClusterUpdates returns the settings of the cluster that differ from the spec and will be updated.
+func (s *Service) pendingClusterUpdates(cluster *containerpb.Cluster, log *logr.Logger) []string {
+	var settings []string
+	for _, updateFunc := range s.clusterUpdateFuncs() {
+		clusterUpdate := updateFunc(cluster, log)
+		if clusterUpdate == nil {
+			continue
+		}
+		clusterUpdate.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			settings = append(settings, strings.TrimPrefix(string(fd.Name()), "desired_"))
+			return true
+		})
+	}
+
+	return settings
+}
+
+// convertFromSdkReleaseChannel converts the SDK release channel to the value defined in CRs.
+func convertFromSdkReleaseChannel(channel containerpb.ReleaseChannel_Channel) *infrav1exp.ReleaseChannel {
+	switch channel {
+	case containerpb.ReleaseChannel_RAPID:
+		return ptr.To(infrav1exp.Rapid)
+	case containerpb.ReleaseChannel_REGULAR:
+		return ptr.To(infrav1exp.Regular)
+	case containerpb.ReleaseChannel_STABLE:
+		return ptr.To(infrav1exp.Stable)
+	case containerpb.ReleaseChannel_EXTENDED:
+		return ptr.To(infrav1exp.Extended)
+	default:
+		return nil
+	}
+}
+
+// convertFromSdkMasterAuthorizedNetworksConfig converts the SDK MasterAuthorizedNetworksConfig to the one defined in
+// CRs. A disabled config is converted to nil.
+func convertFromSdkMasterAuthorizedNetworksConfig(config *containerpb.MasterAuthorizedNetworksConfig) *infrav1exp.MasterAuthorizedNetworksConfig {
+	if !config.GetEnabled() {
+		return nil
+	}
+
+	cidrBlocks := make([]*infrav1exp.MasterAuthorizedNetworksConfigCidrBlock, len(config.GetCidrBlocks()))
+	for i, cidrBlock := range config.GetCidrBlocks() {
+		cidrBlocks[i] = &infrav1exp.MasterAuthorizedNetworksConfigCidrBlock{
+			CidrBlock:   cidrBlock.GetCidrBlock(),
+			DisplayName: cidrBlock.GetDisplayName(),
+		}
+	}
+
+	return &infrav1exp.MasterAuthorizedNetworksConfig{
+		CidrBlocks:                  cidrBlocks,
+		GcpPublicCidrsAccessEnabled: config.GcpPublicCidrsAccessEnabled,
+	}
+}
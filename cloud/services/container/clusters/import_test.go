@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func existingClusterToImport() *containerpb.Cluster {
+	return &containerpb.Cluster{
+		Description:       "existing cluster",
+		LoggingService:    "logging.googleapis.com/kubernetes",
+		MonitoringService: "monitoring.googleapis.com/kubernetes",
+		ReleaseChannel:    &containerpb.ReleaseChannel{Channel: containerpb.ReleaseChannel_REGULAR},
+		ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+				EnablePublicEndpoint: ptr.To(false),
+				GlobalAccess:         ptr.To(true),
+				AuthorizedNetworksConfig: &containerpb.MasterAuthorizedNetworksConfig{
+					Enabled: true,
+					CidrBlocks: []*containerpb.MasterAuthorizedNetworksConfig_CidrBlock{
+						{DisplayName: "office", CidrBlock: "192.168.0.0/24"},
+					},
+					GcpPublicCidrsAccessEnabled: ptr.To(false),
+				},
+			},
+		},
+		IpAllocationPolicy: &containerpb.IPAllocationPolicy{
+			UseIpAliases:          true,
+			ClusterIpv4CidrBlock:  "10.0.0.0/14",
+			ServicesIpv4CidrBlock: "10.4.0.0/20",
+		},
+		PrivateClusterConfig: &containerpb.PrivateClusterConfig{
+			EnablePrivateNodes:  true,
+			MasterIpv4CidrBlock: "172.16.0.0/28",
+		},
+		NetworkConfig: &containerpb.NetworkConfig{
+			DatapathProvider: containerpb.DatapathProvider_ADVANCED_DATAPATH,
+		},
+		WorkloadIdentityConfig: &containerpb.WorkloadIdentityConfig{WorkloadPool: "test-project.svc.id.goog"},
+		DatabaseEncryption: &containerpb.DatabaseEncryption{
+			State:   containerpb.DatabaseEncryption_ENCRYPTED,
+			KeyName: "projects/test-project/locations/us-central1/keyRings/ring/cryptoKeys/key",
+		},
+	}
+}
+
+func TestImportCluster(t *testing.T) {
+	tests := []struct {
+		name                string
+		description         string
+		spec                infrav1exp.GCPManagedControlPlaneClassSpec
+		expectedDescription string
+		expected            infrav1exp.GCPManagedControlPlaneClassSpec
+	}{
+		{
+			name:                "unspecified settings are read from the cluster",
+			expectedDescription: "existing cluster",
+			expected: infrav1exp.GCPManagedControlPlaneClassSpec{
+				EnableIdentityService: ptr.To(false),
+				ReleaseChannel:        ptr.To(infrav1exp.Regular),
+				LoggingService:        ptr.To(infrav1exp.LoggingService("logging.googleapis.com/kubernetes")),
+				MonitoringService:     ptr.To(infrav1exp.MonitoringService("monitoring.googleapis.com/kubernetes")),
+				MasterAuthorizedNetworksConfig: &infrav1exp.MasterAuthorizedNetworksConfig{
+					CidrBlocks: []*infrav1exp.MasterAuthorizedNetworksConfigCidrBlock{
+						{DisplayName: "office", CidrBlock: "192.168.0.0/24"},
+					},
+					GcpPublicCidrsAccessEnabled: ptr.To(false),
+				},
+				ClusterNetwork: &infrav1exp.ClusterNetwork{
+					UseIPAliases: true,
+					Pod:          &infrav1exp.ClusterNetworkPod{CidrBlock: "10.0.0.0/14"},
+					Service:      &infrav1exp.ClusterNetworkService{CidrBlock: "10.4.0.0/20"},
+					PrivateCluster: &infrav1exp.PrivateCluster{
+						EnablePrivateEndpoint:    true,
+						EnablePrivateNodes:       true,
+						ControlPlaneCidrBlock:    "172.16.0.0/28",
+						ControlPlaneGlobalAccess: true,
+					},
+					DatapathProvider: ptr.To(infrav1exp.AdvancedDatapath),
+				},
+				ClusterSecurity: &infrav1exp.ClusterSecurity{
+					WorkloadIdentityConfig: &infrav1exp.WorkloadIdentityConfig{WorkloadPool: "test-project.svc.id.goog"},
+					DatabaseEncryption: &infrav1exp.DatabaseEncryption{
+						State:   infrav1exp.DatabaseEncryptionStateEncrypted,
+						KeyName: "projects/test-project/locations/us-central1/keyRings/ring/cryptoKeys/key",
+					},
+				},
+			},
+		},
+		{
+			name:        "specified settings are kept",
+			description: "managed cluster",
+			spec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				EnableIdentityService: ptr.To(true),
+				ReleaseChannel:        ptr.To(infrav1exp.Stable),
+				ClusterNetwork: &infrav1exp.ClusterNetwork{
+					PrivateCluster: &infrav1exp.PrivateCluster{EnablePrivateNodes: true},
+				},
+			},
+			expectedDescription: "managed cluster",
+			expected: infrav1exp.GCPManagedControlPlaneClassSpec{
+				EnableIdentityService: ptr.To(true),
+				ReleaseChannel:        ptr.To(infrav1exp.Stable),
+				LoggingService:        ptr.To(infrav1exp.LoggingService("logging.googleapis.com/kubernetes")),
+				MonitoringService:     ptr.To(infrav1exp.MonitoringService("monitoring.googleapis.com/kubernetes")),
+				MasterAuthorizedNetworksConfig: &infrav1exp.MasterAuthorizedNetworksConfig{
+					CidrBlocks: []*infrav1exp.MasterAuthorizedNetworksConfigCidrBlock{
+						{DisplayName: "office", CidrBlock: "192.168.0.0/24"},
+					},
+					GcpPublicCidrsAccessEnabled: ptr.To(false),
+				},
+				ClusterNetwork: &infrav1exp.ClusterNetwork{
+					UseIPAliases:     true,
+					Pod:              &infrav1exp.ClusterNetworkPod{CidrBlock: "10.0.0.0/14"},
+					Service:          &infrav1exp.ClusterNetworkService{CidrBlock: "10.4.0.0/20"},
+					PrivateCluster:   &infrav1exp.PrivateCluster{EnablePrivateNodes: true},
+					DatapathProvider: ptr.To(infrav1exp.AdvancedDatapath),
+				},
+				ClusterSecurity: &infrav1exp.ClusterSecurity{
+					WorkloadIdentityConfig: &infrav1exp.WorkloadIdentityConfig{WorkloadPool: "test-project.svc.id.goog"},
+					DatabaseEncryption: &infrav1exp.DatabaseEncryption{
+						State:   infrav1exp.DatabaseEncryptionStateEncrypted,
+						KeyName: "projects/test-project/locations/us-central1/keyRings/ring/cryptoKeys/key",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(&infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: tt.spec,
+					Description:                     tt.description,
+					Import:                          true,
+				},
+			})
+			svc.importCluster(existingClusterToImport())
+			if got := svc.scope.GCPManagedControlPlane.Spec.Description; got != tt.expectedDescription {
+				t.Errorf("description = %q, want %q", got, tt.expectedDescription)
+			}
+			got := svc.scope.GCPManagedControlPlane.Spec.GCPManagedControlPlaneClassSpec
+			if !cmp.Equal(got, tt.expected) {
+				t.Errorf("importCluster() mismatch (-got +want):\n%s", cmp.Diff(got, tt.expected))
+			}
+		})
+	}
+}
+
+func TestImportClusterKeepsResourceLabels(t *testing.T) {
+	svc := newTestService(&infrav1exp.GCPManagedControlPlane{
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				Project:  "test-project",
+				Location: "us-central1",
+			},
+			ClusterName: "test-cluster",
+			Import:      true,
+		},
+	})
+	svc.scope.GCPManagedCluster = &infrav1exp.GCPManagedCluster{
+		Spec: infrav1exp.GCPManagedClusterSpec{
+			AdditionalLabels: infrav1.Labels{"env": "prod"},
+		},
+	}
+	cluster := existingClusterToImport()
+	cluster.ResourceLabels = map[string]string{"team": "a"}
+	cluster.LabelFingerprint = "fingerprint"
+	svc.importCluster(cluster)
+
+	log := ctrl.Log.WithName("test")
+	needUpdate, req := svc.checkDiffAndPrepareResourceLabels(cluster, &log)
+	if !needUpdate {
+		t.Fatalf("checkDiffAndPrepareResourceLabels() needUpdate = false, want true")
+	}
+	wantLabels := map[string]string{
+		"team":                      "a",
+		"env":                       "prod",
+		"capg-cluster-test-cluster": "owned",
+	}
+	if !cmp.Equal(req.GetResourceLabels(), wantLabels) {
+		t.Errorf("resource labels mismatch (-got +want):\n%s", cmp.Diff(req.GetResourceLabels(), wantLabels))
+	}
+}
+
+func TestPendingClusterUpdates(t *testing.T) {
+	svc := newTestService(&infrav1exp.GCPManagedControlPlane{
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			Import: true,
+		},
+	})
+	existingCluster := existingClusterToImport()
+	svc.importCluster(existingCluster)
+	log := ctrl.Log.WithName("test")
+	if got := svc.pendingClusterUpdates(existingCluster, &log); len(got) != 0 {
+		t.Errorf("pendingClusterUpdates() = %v, want no updates after import", got)
+	}
+	if got := svc.checkImmutableFields(existingCluster); len(got) != 0 {
+		t.Errorf("checkImmutableFields() = %v, want no changes after import", got)
+	}
+
+	svc.scope.GCPManagedControlPlane.Spec.MonitoringService = ptr.To(infrav1exp.MonitoringService("none"))
+	if got, want := svc.pendingClusterUpdates(existingCluster, &log), []string{"monitoring_service"}; !cmp.Equal(got, want) {
+		t.Errorf("pendingClusterUpdates() = %v, want %v", got, want)
+	}
+}
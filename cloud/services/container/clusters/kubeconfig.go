@@ -17,21 +17,25 @@ limitations under the License.
 package clusters
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/container/apiv1/containerpb"
-	"cloud.google.com/go/iam/credentials/apiv1/credentialspb"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/reconciler"
 	"sigs.k8s.io/cluster-api/util/kubeconfig"
 	"sigs.k8s.io/cluster-api/util/secret"
 )
@@ -39,9 +43,19 @@ import (
 const (
 	// GkeScope is the scope to request when generating access token.
 	GkeScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	// KubeconfigTokenExpiryAnnotation records when the access token embedded in a kubeconfig secret expires, in
+	// RFC 3339 format.
+	KubeconfigTokenExpiryAnnotation = "infrastructure.cluster.x-k8s.io/kubeconfig-token-expiry"
+
+	// kubeconfigTokenRefreshBefore is how long before its expiry the access token of a kubeconfig is refreshed.
+	kubeconfigTokenRefreshBefore = 10 * time.Minute
 )
 
-func (s *Service) reconcileKubeconfig(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) error {
+// reconcileKubeconfig creates the CAPI kubeconfig secret and refreshes its access token before it expires, as well as
+// its endpoint and CA once they change, for example during a credential rotation. It returns how long the token can
+// be used before it needs to be refreshed again, but at least reconciler.DefaultRetryTime.
+func (s *Service) reconcileKubeconfig(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) (time.Duration, error) {
 	log.Info("Reconciling kubeconfig")
 	clusterRef := types.NamespacedName{
 		Name:      s.scope.Cluster.Name,
@@ -52,25 +66,31 @@ func (s *Service) reconcileKubeconfig(ctx context.Context, cluster *containerpb.
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
 			log.Error(err, "getting kubeconfig secret", "name", clusterRef)
-			return fmt.Errorf("getting kubeconfig secret %s: %w", clusterRef, err)
+			return 0, fmt.Errorf("getting kubeconfig secret %s: %w", clusterRef, err)
 		}
 		log.Info("kubeconfig secret not found, creating")
 
-		if createErr := s.createCAPIKubeconfigSecret(
+		configSecret, err = s.createCAPIKubeconfigSecret(
 			ctx,
 			cluster,
 			&clusterRef,
 			log,
-		); createErr != nil {
-			return fmt.Errorf("creating kubeconfig secret: %w", createErr)
+		)
+		if err != nil {
+			return 0, fmt.Errorf("creating kubeconfig secret: %w", err)
+		}
+	} else if kubeconfigTokenRefreshAfter(configSecret, time.Now()) == 0 || kubeconfigClusterChanged(configSecret, s.getKubeConfigContextName(false), cluster) {
+		log.V(2).Info("Refreshing kubeconfig")
+		if err := s.updateCAPIKubeconfigSecret(ctx, configSecret, cluster); err != nil {
+			return 0, fmt.Errorf("updating kubeconfig secret: %w", err)
 		}
-	} else if updateErr := s.updateCAPIKubeconfigSecret(ctx, configSecret); updateErr != nil {
-		return fmt.Errorf("updating kubeconfig secret: %w", err)
 	}
 
-	return nil
+	return kubeconfigRequeueAfter(configSecret, time.Now()), nil
 }
 
+// reconcileAdditionalKubeconfigs creates the user kubeconfig secret and keeps it in line with the user kubeconfig
+// mode of the control plane.
 func (s *Service) reconcileAdditionalKubeconfigs(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) error {
 	log.Info("Reconciling additional kubeconfig")
 	clusterRef := types.NamespacedName{
@@ -78,62 +98,107 @@ func (s *Service) reconcileAdditionalKubeconfigs(ctx context.Context, cluster *c
 		Namespace: s.scope.Cluster.Namespace,
 	}
 
-	// Create the additional kubeconfig for users. This doesn't need updating on every sync
-	_, err := secret.GetFromNamespacedName(ctx, s.scope.Client(), clusterRef, secret.Kubeconfig)
+	out, tokenExpiry, err := s.userKubeconfig(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("generating additional kubeconfig: %w", err)
+	}
+
+	return s.applyKubeconfigSecret(ctx, clusterRef, out, tokenExpiry, log)
+}
+
+// applyKubeconfigSecret creates the kubeconfig secret or updates it when its kubeconfig changed.
+func (s *Service) applyKubeconfigSecret(ctx context.Context, clusterRef types.NamespacedName, out []byte, tokenExpiry time.Time, log *logr.Logger) error {
+	configSecret, err := secret.GetFromNamespacedName(ctx, s.scope.Client(), clusterRef, secret.Kubeconfig)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
-			return fmt.Errorf("getting kubeconfig (user) secret %s: %w", clusterRef, err)
+			return fmt.Errorf("getting kubeconfig secret %s: %w", clusterRef, err)
 		}
 
-		createErr := s.createUserKubeconfigSecret(
-			ctx,
-			cluster,
-			&clusterRef,
-		)
-		if createErr != nil {
-			return fmt.Errorf("creating additional kubeconfig secret: %w", err)
+		controllerOwnerRef := *metav1.NewControllerRef(s.scope.GCPManagedControlPlane, infrav1exp.GroupVersion.WithKind("GCPManagedControlPlane"))
+		kubeconfigSecret := kubeconfig.GenerateSecretWithOwner(clusterRef, out, controllerOwnerRef)
+		setKubeconfigTokenExpiry(kubeconfigSecret, tokenExpiry)
+		if err := s.scope.Client().Create(ctx, kubeconfigSecret); err != nil {
+			return fmt.Errorf("creating kubeconfig secret %s: %w", clusterRef, err)
 		}
+		return nil
+	}
+
+	if string(configSecret.Data[secret.KubeconfigDataName]) == string(out) {
+		return nil
+	}
+	log.V(2).Info("Updating kubeconfig", "name", clusterRef)
+	configSecret.Data[secret.KubeconfigDataName] = out
+	setKubeconfigTokenExpiry(configSecret, tokenExpiry)
+	if err := s.scope.Client().Update(ctx, configSecret); err != nil {
+		return fmt.Errorf("updating kubeconfig secret %s: %w", clusterRef, err)
 	}
 
 	return nil
 }
 
-func (s *Service) createUserKubeconfigSecret(ctx context.Context, cluster *containerpb.Cluster, clusterRef *types.NamespacedName) error {
-	controllerOwnerRef := *metav1.NewControllerRef(s.scope.GCPManagedControlPlane, infrav1exp.GroupVersion.WithKind("GCPManagedControlPlane"))
-
+// userKubeconfig generates the user kubeconfig. In the token mode, the access token is taken from the CAPI
+// kubeconfig, so that both are refreshed together, and its expiry is returned.
+func (s *Service) userKubeconfig(ctx context.Context, cluster *containerpb.Cluster) ([]byte, time.Time, error) {
 	contextName := s.getKubeConfigContextName(false)
 
 	cfg, err := s.createBaseKubeConfig(contextName, cluster)
 	if err != nil {
-		return fmt.Errorf("creating base kubeconfig: %w", err)
+		return nil, time.Time{}, fmt.Errorf("creating base kubeconfig: %w", err)
 	}
 
-	execConfig := &api.ExecConfig{
-		APIVersion:         "client.authentication.k8s.io/v1beta1",
-		Command:            "gke-gcloud-auth-plugin",
-		InstallHint:        "Install gke-gcloud-auth-plugin for use with kubectl by following\n		https://cloud.google.com/blog/products/containers-kubernetes/kubectl-auth-changes-in-gke",
-		ProvideClusterInfo: true,
+	authInfo, tokenExpiry, err := s.userAuthInfo(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
 	}
 	cfg.AuthInfos = map[string]*api.AuthInfo{
-		contextName: {
-			Exec: execConfig,
-		},
+		contextName: authInfo,
 	}
 
 	out, err := clientcmd.Write(*cfg)
 	if err != nil {
-		return fmt.Errorf("serialize kubeconfig to yaml: %w", err)
+		return nil, time.Time{}, fmt.Errorf("serialize kubeconfig to yaml: %w", err)
 	}
 
-	kubeconfigSecret := kubeconfig.GenerateSecretWithOwner(*clusterRef, out, controllerOwnerRef)
-	if err := s.scope.Client().Create(ctx, kubeconfigSecret); err != nil {
-		return fmt.Errorf("creating secret: %w", err)
+	return out, tokenExpiry, nil
+}
+
+// userAuthInfo returns the credentials of the user kubeconfigs for the user kubeconfig mode of the control plane. In
+// the token mode, the access token of the CAPI kubeconfig is used, and its expiry is returned.
+func (s *Service) userAuthInfo(ctx context.Context) (*api.AuthInfo, time.Time, error) {
+	if ptr.Deref(s.scope.GCPManagedControlPlane.Spec.UserKubeconfigMode, infrav1exp.UserKubeconfigModeExec) != infrav1exp.UserKubeconfigModeToken {
+		return &api.AuthInfo{
+			Exec: &api.ExecConfig{
+				APIVersion:         "client.authentication.k8s.io/v1beta1",
+				Command:            "gke-gcloud-auth-plugin",
+				InstallHint:        "Install gke-gcloud-auth-plugin for use with kubectl by following\n		https://cloud.google.com/blog/products/containers-kubernetes/kubectl-auth-changes-in-gke",
+				ProvideClusterInfo: true,
+			},
+		}, time.Time{}, nil
 	}
 
-	return nil
+	clusterRef := types.NamespacedName{
+		Name:      s.scope.Cluster.Name,
+		Namespace: s.scope.Cluster.Namespace,
+	}
+	configSecret, err := secret.GetFromNamespacedName(ctx, s.scope.Client(), clusterRef, secret.Kubeconfig)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("getting kubeconfig secret %s: %w", clusterRef, err)
+	}
+	capiConfig, err := clientcmd.Load(configSecret.Data[secret.KubeconfigDataName])
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "failed to convert kubeconfig Secret into a clientcmdapi.Config")
+	}
+	contextName := s.getKubeConfigContextName(false)
+	capiAuthInfo, ok := capiConfig.AuthInfos[contextName]
+	if !ok {
+		return nil, time.Time{}, errors.Errorf("missing user %q in kubeconfig secret %s", contextName, clusterRef)
+	}
+	tokenExpiry, _ := time.Parse(time.RFC3339, configSecret.GetAnnotations()[KubeconfigTokenExpiryAnnotation])
+
+	return &api.AuthInfo{Token: capiAuthInfo.Token}, tokenExpiry, nil
 }
 
-func (s *Service) createCAPIKubeconfigSecret(ctx context.Context, cluster *containerpb.Cluster, clusterRef *types.NamespacedName, log *logr.Logger) error {
+func (s *Service) createCAPIKubeconfigSecret(ctx context.Context, cluster *containerpb.Cluster, clusterRef *types.NamespacedName, log *logr.Logger) (*corev1.Secret, error) {
 	controllerOwnerRef := *metav1.NewControllerRef(s.scope.GCPManagedControlPlane, infrav1exp.GroupVersion.WithKind("GCPManagedControlPlane"))
 
 	contextName := s.getKubeConfigContextName(false)
@@ -141,36 +206,37 @@ func (s *Service) createCAPIKubeconfigSecret(ctx context.Context, cluster *conta
 	cfg, err := s.createBaseKubeConfig(contextName, cluster)
 	if err != nil {
 		log.Error(err, "failed creating base config")
-		return fmt.Errorf("creating base kubeconfig: %w", err)
+		return nil, fmt.Errorf("creating base kubeconfig: %w", err)
 	}
 
-	token, err := s.generateToken(ctx)
+	token, err := s.generateToken()
 	if err != nil {
 		log.Error(err, "failed generating token")
-		return err
+		return nil, err
 	}
 	cfg.AuthInfos = map[string]*api.AuthInfo{
 		contextName: {
-			Token: token,
+			Token: token.AccessToken,
 		},
 	}
 
 	out, err := clientcmd.Write(*cfg)
 	if err != nil {
 		log.Error(err, "failed serializing kubeconfig to yaml")
-		return fmt.Errorf("serialize kubeconfig to yaml: %w", err)
+		return nil, fmt.Errorf("serialize kubeconfig to yaml: %w", err)
 	}
 
 	kubeconfigSecret := kubeconfig.GenerateSecretWithOwner(*clusterRef, out, controllerOwnerRef)
+	setKubeconfigTokenExpiry(kubeconfigSecret, token.Expiry)
 	if err := s.scope.Client().Create(ctx, kubeconfigSecret); err != nil {
 		log.Error(err, "failed creating secret")
-		return fmt.Errorf("creating secret: %w", err)
+		return nil, fmt.Errorf("creating secret: %w", err)
 	}
 
-	return nil
+	return kubeconfigSecret, nil
 }
 
-func (s *Service) updateCAPIKubeconfigSecret(ctx context.Context, configSecret *corev1.Secret) error {
+func (s *Service) updateCAPIKubeconfigSecret(ctx context.Context, configSecret *corev1.Secret, cluster *containerpb.Cluster) error {
 	data, ok := configSecret.Data[secret.KubeconfigDataName]
 	if !ok {
 		return errors.Errorf("missing key %q in secret data", secret.KubeconfigDataName)
@@ -181,13 +247,18 @@ func (s *Service) updateCAPIKubeconfigSecret(ctx context.Context, configSecret *
 		return errors.Wrap(err, "failed to convert kubeconfig Secret into a clientcmdapi.Config")
 	}
 
-	token, err := s.generateToken(ctx)
+	token, err := s.generateToken()
 	if err != nil {
 		return err
 	}
 
 	contextName := s.getKubeConfigContextName(false)
-	config.AuthInfos[contextName].Token = token
+	baseConfig, err := s.createBaseKubeConfig(contextName, cluster)
+	if err != nil {
+		return fmt.Errorf("creating base kubeconfig: %w", err)
+	}
+	config.Clusters[contextName] = baseConfig.Clusters[contextName]
+	config.AuthInfos[contextName].Token = token.AccessToken
 
 	out, err := clientcmd.Write(*config)
 	if err != nil {
@@ -195,6 +266,7 @@ func (s *Service) updateCAPIKubeconfigSecret(ctx context.Context, configSecret *
 	}
 
 	configSecret.Data[secret.KubeconfigDataName] = out
+	setKubeconfigTokenExpiry(configSecret, token.Expiry)
 
 	err = s.scope.Client().Update(ctx, configSecret)
 	if err != nil {
@@ -237,17 +309,58 @@ func (s *Service) createBaseKubeConfig(contextName string, cluster *containerpb.
 	return cfg, nil
 }
 
-func (s *Service) generateToken(ctx context.Context) (string, error) {
-	req := &credentialspb.GenerateAccessTokenRequest{
-		Name: "projects/-/serviceAccounts/" + s.scope.GetCredential().ClientEmail,
-		Scope: []string{
-			GkeScope,
-		},
+// kubeconfigClusterChanged returns whether the endpoint or the CA of the cluster differ from the ones of the
+// kubeconfig secret.
+func kubeconfigClusterChanged(configSecret *corev1.Secret, contextName string, cluster *containerpb.Cluster) bool {
+	config, err := clientcmd.Load(configSecret.Data[secret.KubeconfigDataName])
+	if err != nil || config.Clusters[contextName] == nil {
+		return true
 	}
-	resp, err := s.scope.CredentialsClient().GenerateAccessToken(ctx, req)
+	certData, err := base64.StdEncoding.DecodeString(cluster.GetMasterAuth().GetClusterCaCertificate())
 	if err != nil {
-		return "", errors.Errorf("error generating access token: %v", err)
+		return false
 	}
 
-	return resp.GetAccessToken(), nil
+	return config.Clusters[contextName].Server != "https://"+cluster.GetEndpoint() ||
+		!bytes.Equal(config.Clusters[contextName].CertificateAuthorityData, certData)
+}
+
+// generateToken returns an access token for the credentials of the GCP clients.
+func (s *Service) generateToken() (*oauth2.Token, error) {
+	token, err := s.scope.TokenSource().Token()
+	if err != nil {
+		return nil, errors.Errorf("error generating access token: %v", err)
+	}
+
+	return token, nil
+}
+
+// setKubeconfigTokenExpiry records the expiry of the access token embedded in the kubeconfig secret. Tokens without
+// an expiry are refreshed on every reconciliation.
+func setKubeconfigTokenExpiry(configSecret *corev1.Secret, expiry time.Time) {
+	if expiry.IsZero() {
+		delete(configSecret.Annotations, KubeconfigTokenExpiryAnnotation)
+		return
+	}
+	if configSecret.Annotations == nil {
+		configSecret.Annotations = map[string]string{}
+	}
+	configSecret.Annotations[KubeconfigTokenExpiryAnnotation] = expiry.UTC().Format(time.RFC3339)
+}
+
+// kubeconfigTokenRefreshAfter returns how long the access token of the kubeconfig secret can be used before it has to
+// be refreshed. Secrets without a valid expiry annotation have to be refreshed right away.
+func kubeconfigTokenRefreshAfter(configSecret *corev1.Secret, now time.Time) time.Duration {
+	expiry, err := time.Parse(time.RFC3339, configSecret.GetAnnotations()[KubeconfigTokenExpiryAnnotation])
+	if err != nil {
+		return 0
+	}
+
+	return max(expiry.Sub(now)-kubeconfigTokenRefreshBefore, 0)
+}
+
+// kubeconfigRequeueAfter returns when the kubeconfig secret has to be reconciled again. A token which is about to
+// expire right after a refresh is retried after reconciler.DefaultRetryTime, instead of not requeueing at all.
+func kubeconfigRequeueAfter(configSecret *corev1.Secret, now time.Time) time.Duration {
+	return max(kubeconfigTokenRefreshAfter(configSecret, now), reconciler.DefaultRetryTime)
 }
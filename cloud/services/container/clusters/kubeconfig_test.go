@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestKubeconfigTokenRefreshAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    time.Duration
+	}{
+		{
+			name:     "refresh right away without annotation",
+			expected: 0,
+		},
+		{
+			name:        "refresh right away with an invalid annotation",
+			annotations: map[string]string{KubeconfigTokenExpiryAnnotation: "tomorrow"},
+			expected:    0,
+		},
+		{
+			name:        "refresh before the token expires",
+			annotations: map[string]string{KubeconfigTokenExpiryAnnotation: "2026-01-01T13:00:00Z"},
+			expected:    50 * time.Minute,
+		},
+		{
+			name:        "refresh right away when the token is about to expire",
+			annotations: map[string]string{KubeconfigTokenExpiryAnnotation: "2026-01-01T12:05:00Z"},
+			expected:    0,
+		},
+		{
+			name:        "refresh right away when the token is expired",
+			annotations: map[string]string{KubeconfigTokenExpiryAnnotation: "2026-01-01T11:00:00Z"},
+			expected:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			if got := kubeconfigTokenRefreshAfter(configSecret, now); got != tt.expected {
+				t.Errorf("kubeconfigTokenRefreshAfter() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetKubeconfigTokenExpiry(t *testing.T) {
+	configSecret := &corev1.Secret{}
+
+	expiry := time.Date(2026, 1, 1, 13, 0, 0, 0, time.FixedZone("CET", 3600))
+	setKubeconfigTokenExpiry(configSecret, expiry)
+	if got := configSecret.Annotations[KubeconfigTokenExpiryAnnotation]; got != "2026-01-01T12:00:00Z" {
+		t.Errorf("expiry annotation = %q, want %q", got, "2026-01-01T12:00:00Z")
+	}
+
+	setKubeconfigTokenExpiry(configSecret, time.Time{})
+	if _, ok := configSecret.Annotations[KubeconfigTokenExpiryAnnotation]; ok {
+		t.Errorf("expiry annotation should be removed for tokens without expiry")
+	}
+}
+
+func TestUserKubeconfigExecMode(t *testing.T) {
+	s := newTestService(&infrav1exp.GCPManagedControlPlane{
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				Project:  "test-project",
+				Location: "us-central1",
+			},
+			ClusterName: "test-cluster",
+		},
+	})
+	cluster := &containerpb.Cluster{Endpoint: "10.0.0.1"}
+
+	out, expiry, err := s.userKubeconfig(context.TODO(), cluster)
+	if err != nil {
+		t.Fatalf("userKubeconfig() error = %v", err)
+	}
+	if !expiry.IsZero() {
+		t.Errorf("userKubeconfig() expiry = %v, want zero", expiry)
+	}
+
+	cfg, err := clientcmd.Load(out)
+	if err != nil {
+		t.Fatalf("loading kubeconfig: %v", err)
+	}
+	contextName := "gke_test-project_us-central1_test-cluster"
+	authInfo, ok := cfg.AuthInfos[contextName]
+	if !ok {
+		t.Fatalf("missing user %q in kubeconfig", contextName)
+	}
+	if authInfo.Token != "" {
+		t.Errorf("exec kubeconfig should not embed a token")
+	}
+	if authInfo.Exec == nil || authInfo.Exec.Command != "gke-gcloud-auth-plugin" {
+		t.Errorf("exec kubeconfig should use gke-gcloud-auth-plugin, got %+v", authInfo.Exec)
+	}
+	if got := cfg.Clusters[contextName].Server; got != "https://10.0.0.1" {
+		t.Errorf("server = %q, want %q", got, "https://10.0.0.1")
+	}
+}
+
+func TestKubeconfigClusterChanged(t *testing.T) {
+	contextName := "gke_test-project_us-central1_test-cluster"
+	cluster := &containerpb.Cluster{
+		Endpoint:   "10.0.0.1",
+		MasterAuth: &containerpb.MasterAuth{ClusterCaCertificate: base64.StdEncoding.EncodeToString([]byte("ca"))},
+	}
+	out, err := clientcmd.Write(api.Config{
+		Clusters: map[string]*api.Cluster{
+			contextName: {Server: "https://10.0.0.1", CertificateAuthorityData: []byte("ca")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("serializing kubeconfig: %v", err)
+	}
+	configSecret := &corev1.Secret{Data: map[string][]byte{secret.KubeconfigDataName: out}}
+
+	if kubeconfigClusterChanged(configSecret, contextName, cluster) {
+		t.Errorf("kubeconfigClusterChanged() = true, want false for the same endpoint and CA")
+	}
+	rotated := &containerpb.Cluster{
+		Endpoint:   "10.0.0.2",
+		MasterAuth: &containerpb.MasterAuth{ClusterCaCertificate: base64.StdEncoding.EncodeToString([]byte("new-ca"))},
+	}
+	if !kubeconfigClusterChanged(configSecret, contextName, rotated) {
+		t.Errorf("kubeconfigClusterChanged() = false, want true after a credential rotation")
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"slices"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+var notificationEventTypes = map[infrav1exp.NotificationType]containerpb.NotificationConfig_EventType{
+	infrav1exp.UpgradeAvailableNotification: containerpb.NotificationConfig_UPGRADE_AVAILABLE_EVENT,
+	infrav1exp.UpgradeNotification:          containerpb.NotificationConfig_UPGRADE_EVENT,
+	infrav1exp.SecurityBulletinNotification: containerpb.NotificationConfig_SECURITY_BULLETIN_EVENT,
+	infrav1exp.UpgradeInfoNotification:      containerpb.NotificationConfig_UPGRADE_INFO_EVENT,
+}
+
+func convertToSdkNotificationConfig(notifications *infrav1exp.Notifications) *containerpb.NotificationConfig {
+	if notifications == nil {
+		return nil
+	}
+	pubsub := &containerpb.NotificationConfig_PubSub{
+		Enabled: notifications.Topic != "",
+		Topic:   notifications.Topic,
+	}
+	if len(notifications.Filter) > 0 {
+		pubsub.Filter = &containerpb.NotificationConfig_Filter{}
+		for _, notificationType := range notifications.Filter {
+			pubsub.Filter.EventType = append(pubsub.Filter.EventType, notificationEventTypes[notificationType])
+		}
+	}
+
+	return &containerpb.NotificationConfig{Pubsub: pubsub}
+}
+
+func convertFromSdkNotificationConfig(config *containerpb.NotificationConfig) *infrav1exp.Notifications {
+	if !config.GetPubsub().GetEnabled() {
+		return nil
+	}
+	notifications := &infrav1exp.Notifications{
+		Topic: config.GetPubsub().GetTopic(),
+	}
+	for notificationType, eventType := range notificationEventTypes {
+		if slices.Contains(config.GetPubsub().GetFilter().GetEventType(), eventType) {
+			notifications.Filter = append(notifications.Filter, notificationType)
+		}
+	}
+	slices.Sort(notifications.Filter)
+
+	return notifications
+}
+
+func (s *Service) notificationConfigUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	desiredNotificationConfig := convertToSdkNotificationConfig(s.scope.GCPManagedControlPlane.Spec.Notifications)
+	if desiredNotificationConfig == nil || compareNotificationConfig(desiredNotificationConfig, existingCluster.GetNotificationConfig()) {
+		return nil
+	}
+	log.V(2).Info("Notification config update required", "current", existingCluster.GetNotificationConfig(), "desired", desiredNotificationConfig)
+
+	return &containerpb.ClusterUpdate{
+		DesiredNotificationConfig: desiredNotificationConfig,
+	}
+}
+
+// compareNotificationConfig returns whether the existing notification config matches the desired one. The topic and
+// the filter of disabled notifications don't matter.
+func compareNotificationConfig(desired, existing *containerpb.NotificationConfig) bool {
+	if desired.GetPubsub().GetEnabled() != existing.GetPubsub().GetEnabled() {
+		return false
+	}
+	if !desired.GetPubsub().GetEnabled() {
+		return true
+	}
+	desiredEventTypes := slices.Sorted(slices.Values(desired.GetPubsub().GetFilter().GetEventType()))
+	existingEventTypes := slices.Sorted(slices.Values(existing.GetPubsub().GetFilter().GetEventType()))
+
+	return desired.GetPubsub().GetTopic() == existing.GetPubsub().GetTopic() && slices.Equal(desiredEventTypes, existingEventTypes)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/google/go-cmp/cmp"
+
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+const testNotificationTopic = "projects/test-project/topics/gke-notifications"
+
+func TestNotificationConfigConversion(t *testing.T) {
+	notifications := &infrav1exp.Notifications{
+		Topic:  testNotificationTopic,
+		Filter: []infrav1exp.NotificationType{infrav1exp.SecurityBulletinNotification, infrav1exp.UpgradeAvailableNotification},
+	}
+
+	config := convertToSdkNotificationConfig(notifications)
+	if !config.GetPubsub().GetEnabled() || config.GetPubsub().GetTopic() != testNotificationTopic {
+		t.Fatalf("convertToSdkNotificationConfig() = %v, want enabled notifications to %s", config, testNotificationTopic)
+	}
+	expectedEventTypes := []containerpb.NotificationConfig_EventType{
+		containerpb.NotificationConfig_SECURITY_BULLETIN_EVENT,
+		containerpb.NotificationConfig_UPGRADE_AVAILABLE_EVENT,
+	}
+	if !cmp.Equal(config.GetPubsub().GetFilter().GetEventType(), expectedEventTypes) {
+		t.Errorf("convertToSdkNotificationConfig() filter mismatch (-got +want):\n%s", cmp.Diff(config.GetPubsub().GetFilter().GetEventType(), expectedEventTypes))
+	}
+
+	// The imported filter is sorted.
+	expected := &infrav1exp.Notifications{
+		Topic:  testNotificationTopic,
+		Filter: []infrav1exp.NotificationType{infrav1exp.SecurityBulletinNotification, infrav1exp.UpgradeAvailableNotification},
+	}
+	if got := convertFromSdkNotificationConfig(config); !cmp.Equal(got, expected) {
+		t.Errorf("convertFromSdkNotificationConfig() mismatch (-got +want):\n%s", cmp.Diff(got, expected))
+	}
+
+	if got := convertFromSdkNotificationConfig(&containerpb.NotificationConfig{Pubsub: &containerpb.NotificationConfig_PubSub{}}); got != nil {
+		t.Errorf("convertFromSdkNotificationConfig() = %v, want nil for disabled notifications", got)
+	}
+}
+
+func TestCompareNotificationConfig(t *testing.T) {
+	enabled := func(topic string, eventTypes ...containerpb.NotificationConfig_EventType) *containerpb.NotificationConfig {
+		config := &containerpb.NotificationConfig{Pubsub: &containerpb.NotificationConfig_PubSub{Enabled: true, Topic: topic}}
+		if len(eventTypes) > 0 {
+			config.Pubsub.Filter = &containerpb.NotificationConfig_Filter{EventType: eventTypes}
+		}
+		return config
+	}
+
+	tests := []struct {
+		name     string
+		desired  *containerpb.NotificationConfig
+		existing *containerpb.NotificationConfig
+		expected bool
+	}{
+		{
+			name:     "enabling notifications",
+			desired:  enabled(testNotificationTopic),
+			existing: nil,
+			expected: false,
+		},
+		{
+			name:     "disabling notifications",
+			desired:  &containerpb.NotificationConfig{Pubsub: &containerpb.NotificationConfig_PubSub{}},
+			existing: enabled(testNotificationTopic),
+			expected: false,
+		},
+		{
+			name:     "disabled notifications",
+			desired:  &containerpb.NotificationConfig{Pubsub: &containerpb.NotificationConfig_PubSub{}},
+			existing: &containerpb.NotificationConfig{Pubsub: &containerpb.NotificationConfig_PubSub{Topic: testNotificationTopic}},
+			expected: true,
+		},
+		{
+			name:     "changing the topic",
+			desired:  enabled("projects/test-project/topics/other"),
+			existing: enabled(testNotificationTopic),
+			expected: false,
+		},
+		{
+			name:     "filter in a different order",
+			desired:  enabled(testNotificationTopic, containerpb.NotificationConfig_UPGRADE_EVENT, containerpb.NotificationConfig_SECURITY_BULLETIN_EVENT),
+			existing: enabled(testNotificationTopic, containerpb.NotificationConfig_SECURITY_BULLETIN_EVENT, containerpb.NotificationConfig_UPGRADE_EVENT),
+			expected: true,
+		},
+		{
+			name:     "changing the filter",
+			desired:  enabled(testNotificationTopic, containerpb.NotificationConfig_UPGRADE_EVENT),
+			existing: enabled(testNotificationTopic),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareNotificationConfig(tt.desired, tt.existing); got != tt.expected {
+				t.Errorf("compareNotificationConfig() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
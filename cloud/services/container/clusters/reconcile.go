@@ -31,6 +31,8 @@ import (
 	"github.com/googleapis/gax-go/v2/apierror"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/utils/ptr"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/util/reconciler"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -51,6 +53,23 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition, infrav1exp.GKEControlPlaneReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "describing cluster: %v", err)
 		return ctrl.Result{}, err
 	}
+	// Without the server config, versions are left to GKE to resolve.
+	s.serverConfig, err = s.scope.ServerConfig(ctx)
+	if err != nil {
+		log.Error(err, "Failed to get the GKE server config, versions are resolved by GKE")
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneServerConfigAvailableCondition, infrav1exp.GKEControlPlaneServerConfigUnavailableReason, clusterv1beta1.ConditionSeverityWarning, "getting server config: %v", err)
+	} else {
+		v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneServerConfigAvailableCondition)
+	}
+	if cluster == nil && s.scope.GCPManagedControlPlane.Spec.Import {
+		log.Info("Cluster to import not found", "name", s.scope.ClusterName())
+		s.scope.GCPManagedControlPlane.Status.Initialized = false
+		s.scope.GCPManagedControlPlane.Status.Ready = false
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition, infrav1exp.GKEControlPlaneImportFailedReason, clusterv1beta1.ConditionSeverityWarning, "cluster %s not found", s.scope.ClusterName())
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition, infrav1exp.GKEControlPlaneImportFailedReason, clusterv1beta1.ConditionSeverityWarning, "cluster %s not found", s.scope.ClusterName())
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneImportedCondition, infrav1exp.GKEControlPlaneImportFailedReason, clusterv1beta1.ConditionSeverityWarning, "cluster %s not found", s.scope.ClusterName())
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
 	if cluster == nil {
 		log.Info("Cluster not found, creating")
 		s.scope.GCPManagedControlPlane.Status.Initialized = false
@@ -81,6 +100,14 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 			}
 		}
 
+		if _, err := s.desiredMasterVersion(nil); err != nil {
+			log.Info("Invalid control plane version", "reason", err.Error())
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition, infrav1exp.GKEControlPlaneInvalidVersionReason, clusterv1beta1.ConditionSeverityError, "%v", err)
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition, infrav1exp.GKEControlPlaneInvalidVersionReason, clusterv1beta1.ConditionSeverityError, "%v", err)
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCreatingCondition, infrav1exp.GKEControlPlaneInvalidVersionReason, clusterv1beta1.ConditionSeverityError, "%v", err)
+			return ctrl.Result{}, nil
+		}
+
 		if err = s.createCluster(ctx, &log); err != nil {
 			log.Error(err, "failed creating cluster")
 			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition, infrav1exp.GKEControlPlaneReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "creating cluster: %v", err)
@@ -89,6 +116,7 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 			return ctrl.Result{}, err
 		}
 		log.Info("Cluster created provisioning in progress")
+		s.scope.GCPManagedControlPlane.Status.ManagedResourceLabels = s.managedResourceLabels()
 		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition, infrav1exp.GKEControlPlaneCreatingReason, clusterv1beta1.ConditionSeverityInfo, "")
 		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition, infrav1exp.GKEControlPlaneCreatingReason, clusterv1beta1.ConditionSeverityInfo, "")
 		v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCreatingCondition)
@@ -99,6 +127,8 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 	controlPlaneVersion := convertToSdkMasterVersion(cluster.GetCurrentMasterVersion())
 	s.scope.GCPManagedControlPlane.Status.CurrentVersion = controlPlaneVersion
 	s.scope.GCPManagedControlPlane.Status.Version = &controlPlaneVersion
+	s.scope.GCPManagedControlPlane.Status.UpgradeTargets = s.upgradeTargets(cluster)
+	s.scope.GCPManagedControlPlane.Status.DatabaseEncryption = convertFromSdkDatabaseEncryption(cluster.GetDatabaseEncryption())
 
 	switch cluster.GetStatus() {
 	case containerpb.Cluster_PROVISIONING:
@@ -141,23 +171,53 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		return ctrl.Result{}, statusErr
 	}
 
-	needUpdate, updateClusterRequest := s.checkDiffAndPrepareUpdate(cluster, &log)
-	if needUpdate {
-		log.Info("Update required")
-		err = s.updateCluster(ctx, updateClusterRequest, &log)
-		if err != nil {
-			return ctrl.Result{}, err
-		}
+	if s.scope.GCPManagedControlPlane.Spec.Import && !v1beta1conditions.IsTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneImportedCondition) {
+		s.reconcileImport(cluster, &log)
+		s.scope.GCPManagedControlPlane.Status.Initialized = true
+		s.scope.GCPManagedControlPlane.Status.Ready = true
+		// Requeue so that the imported settings are persisted before the cluster is updated.
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
+
+	if changedFields := s.checkImmutableFields(cluster); len(changedFields) > 0 {
+		log.Info("Fields differ from the existing cluster but can't be updated", "fields", changedFields)
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneImmutableFieldsInSyncCondition, infrav1exp.GKEControlPlaneImmutableFieldsChangedReason, clusterv1beta1.ConditionSeverityWarning, "fields can't be updated: %s", strings.Join(changedFields, ", "))
+	} else {
+		v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneImmutableFieldsInSyncCondition)
+	}
+
+	_, versionErr := s.desiredMasterVersion(cluster)
+	if versionErr != nil {
+		log.Info("Invalid control plane version, keeping the current version", "reason", versionErr.Error())
+	}
+
+	updating, err := s.reconcileClusterUpdates(ctx, cluster, &log)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if updating {
 		log.Info("Cluster updating in progress")
 		v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneUpdatingCondition)
 		s.scope.GCPManagedControlPlane.Status.Initialized = true
 		s.scope.GCPManagedControlPlane.Status.Ready = true
-		return ctrl.Result{}, nil
+		// Requeue so that the remaining fields are updated once GKE accepts the next update.
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
+	s.scope.GCPManagedControlPlane.Status.ManagedResourceLabels = s.managedResourceLabels()
+	if versionErr != nil {
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneUpdatingCondition, infrav1exp.GKEControlPlaneInvalidVersionReason, clusterv1beta1.ConditionSeverityError, "%v", versionErr)
+	} else {
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneUpdatingCondition, infrav1exp.GKEControlPlaneUpdatedReason, clusterv1beta1.ConditionSeverityInfo, "")
+	}
+
+	membership, err := s.reconcileFleetMembership(ctx, cluster, &log)
+	if err != nil {
+		log.Error(err, "Failed to reconcile fleet membership")
+		return ctrl.Result{}, err
 	}
-	v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneUpdatingCondition, infrav1exp.GKEControlPlaneUpdatedReason, clusterv1beta1.ConditionSeverityInfo, "")
 
 	// Reconcile kubeconfig
-	err = s.reconcileKubeconfig(ctx, cluster, &log)
+	kubeconfigRefreshAfter, err := s.reconcileKubeconfig(ctx, cluster, &log)
 	if err != nil {
 		log.Error(err, "Failed to reconcile CAPI kubeconfig")
 		return ctrl.Result{}, err
@@ -167,8 +227,23 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		log.Error(err, "Failed to reconcile additional kubeconfig")
 		return ctrl.Result{}, err
 	}
+	err = s.reconcileConnectGatewayKubeconfig(ctx, membership, &log)
+	if err != nil {
+		log.Error(err, "Failed to reconcile connect gateway kubeconfig")
+		return ctrl.Result{}, err
+	}
+
+	rotating, err := s.reconcileCredentialRotation(ctx, &log)
+	if err != nil {
+		log.Error(err, "Failed to rotate credentials")
+		return ctrl.Result{}, err
+	}
 
 	s.scope.SetEndpoint(cluster.GetEndpoint())
+	if err := s.scope.PatchClusterControlPlaneEndpoint(ctx); err != nil {
+		log.Error(err, "Failed to update the control plane endpoint of the cluster")
+		return ctrl.Result{}, err
+	}
 	v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition)
 	v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition)
 	v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCreatingCondition, infrav1exp.GKEControlPlaneCreatedReason, clusterv1beta1.ConditionSeverityInfo, "")
@@ -177,7 +252,10 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 
 	log.Info("Cluster reconciled")
 
-	return ctrl.Result{}, nil
+	if rotating || (s.scope.GCPManagedControlPlane.Spec.Fleet != nil && membership == nil) {
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
+	return ctrl.Result{RequeueAfter: kubeconfigRefreshAfter}, nil
 }
 
 // Delete delete GKE cluster.
@@ -191,6 +269,9 @@ func (s *Service) Delete(ctx context.Context) (ctrl.Result, error) {
 	}
 	if cluster == nil {
 		log.Info("Cluster already deleted")
+		if err := s.unregisterFleetMembership(ctx, &log); err != nil {
+			return ctrl.Result{}, err
+		}
 		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneDeletingCondition, infrav1exp.GKEControlPlaneDeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
 		return ctrl.Result{}, nil
 	}
@@ -211,6 +292,16 @@ func (s *Service) Delete(ctx context.Context) (ctrl.Result, error) {
 		break
 	}
 
+	if s.scope.DeletionPolicy() == infrav1exp.DeletionPolicyOrphan {
+		log.Info("Orphaning cluster, leaving it in place", "name", s.scope.ClusterName())
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneDeletingCondition, infrav1exp.GKEControlPlaneOrphanedReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{}, nil
+	}
+
+	if err := s.unregisterFleetMembership(ctx, &log); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneDeletingCondition, infrav1exp.GKEControlPlaneReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "unregistering cluster from fleet: %v", err)
+		return ctrl.Result{}, err
+	}
 	if err = s.deleteCluster(ctx, &log); err != nil {
 		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneDeletingCondition, infrav1exp.GKEControlPlaneReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "deleting cluster: %v", err)
 		return ctrl.Result{}, err
@@ -254,15 +345,16 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 
 	isRegional := shared.IsRegional(s.scope.Region())
 	cluster := &containerpb.Cluster{
-		Name:        s.scope.ClusterName(),
-		Description: s.scope.GCPManagedControlPlane.Spec.Description,
-		Network:     *s.scope.GCPManagedCluster.Spec.Network.Name,
-		Subnetwork:  s.getSubnetNameInClusterRegion(),
+		Name:           s.scope.ClusterName(),
+		Description:    s.scope.GCPManagedControlPlane.Spec.Description,
+		Network:        *s.scope.GCPManagedCluster.Spec.Network.Name,
+		Subnetwork:     s.getSubnetNameInClusterRegion(),
+		ResourceLabels: s.scope.ClusterResourceLabels(),
 		Autopilot: &containerpb.Autopilot{
 			Enabled: s.scope.GCPManagedControlPlane.Spec.EnableAutopilot,
 		},
 		IdentityServiceConfig: &containerpb.IdentityServiceConfig{
-			Enabled: s.scope.GCPManagedControlPlane.Spec.EnableIdentityService,
+			Enabled: ptr.Deref(s.scope.GCPManagedControlPlane.Spec.EnableIdentityService, false),
 		},
 		ReleaseChannel: &containerpb.ReleaseChannel{
 			Channel: convertToSdkReleaseChannel(s.scope.GCPManagedControlPlane.Spec.ReleaseChannel),
@@ -270,6 +362,9 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 		BinaryAuthorization: &containerpb.BinaryAuthorization{
 			EvaluationMode: convertToSdkBinaryAuthorizationEvaluationMode(s.scope.GCPManagedControlPlane.Spec.BinaryAuthorization),
 		},
+		MaintenancePolicy:  convertToSdkMaintenancePolicy(s.scope.GCPManagedControlPlane.Spec.MaintenancePolicy),
+		AddonsConfig:       convertToSdkAddonsConfig(s.scope.GCPManagedControlPlane.Spec.Addons),
+		NotificationConfig: convertToSdkNotificationConfig(s.scope.GCPManagedControlPlane.Spec.Notifications),
 		ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
 			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
 				AuthorizedNetworksConfig: convertToSdkMasterAuthorizedNetworksConfig(s.scope.GCPManagedControlPlane.Spec.MasterAuthorizedNetworksConfig),
@@ -277,9 +372,11 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 		},
 	}
 
-	if initialClusterVersionFromSpec := s.scope.GetControlPlaneVersion(); initialClusterVersionFromSpec != nil {
-		cluster.InitialClusterVersion = convertToSdkMasterVersion(*initialClusterVersionFromSpec)
+	initialClusterVersion, err := s.desiredMasterVersion(nil)
+	if err != nil {
+		return err
 	}
+	cluster.InitialClusterVersion = initialClusterVersion
 
 	if s.scope.GCPManagedControlPlane.Spec.ClusterNetwork != nil {
 		cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
@@ -304,10 +401,11 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 			}
 
 			// Initialize NetworkConfig before accessing DefaultEnablePrivateNodes
-			cluster.NetworkConfig = &containerpb.NetworkConfig{
-				DefaultSnatStatus: &containerpb.DefaultSnatStatus{
-					Disabled: cn.PrivateCluster.DisableDefaultSNAT,
-				},
+			if cluster.NetworkConfig == nil {
+				cluster.NetworkConfig = &containerpb.NetworkConfig{}
+			}
+			cluster.NetworkConfig.DefaultSnatStatus = &containerpb.DefaultSnatStatus{
+				Disabled: cn.PrivateCluster.DisableDefaultSNAT,
 			}
 			cluster.NetworkConfig.DefaultEnablePrivateNodes = &cn.PrivateCluster.EnablePrivateNodes
 
@@ -319,10 +417,46 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 			}
 			cluster.ControlPlaneEndpointsConfig.IpEndpointsConfig.GlobalAccess = &cn.PrivateCluster.ControlPlaneGlobalAccess
 		}
+
+		if cn.DatapathProvider != nil || cn.EnableIntraNodeVisibility != nil || cn.DNS != nil || cn.GatewayAPIChannel != nil || cn.EnableMultiNetworking != nil {
+			if cluster.NetworkConfig == nil {
+				cluster.NetworkConfig = &containerpb.NetworkConfig{}
+			}
+			if cn.DatapathProvider != nil {
+				cluster.NetworkConfig.DatapathProvider = convertToSdkDatapathProvider(*cn.DatapathProvider)
+			}
+			if cn.EnableIntraNodeVisibility != nil {
+				cluster.NetworkConfig.EnableIntraNodeVisibility = *cn.EnableIntraNodeVisibility
+			}
+			cluster.NetworkConfig.DnsConfig = convertToSdkDNSConfig(cn.DNS)
+			if cn.GatewayAPIChannel != nil {
+				cluster.NetworkConfig.GatewayApiConfig = &containerpb.GatewayAPIConfig{
+					Channel: convertToSdkGatewayAPIChannel(*cn.GatewayAPIChannel),
+				}
+			}
+			if cn.EnableMultiNetworking != nil {
+				cluster.NetworkConfig.EnableMultiNetworking = *cn.EnableMultiNetworking
+			}
+		}
+
+		if cn.EnableNetworkPolicy != nil {
+			cluster.NetworkPolicy = &containerpb.NetworkPolicy{
+				Provider: containerpb.NetworkPolicy_CALICO,
+				Enabled:  *cn.EnableNetworkPolicy,
+			}
+			// Network policy enforcement requires the network policy add-on.
+			if *cn.EnableNetworkPolicy && cluster.GetAddonsConfig().GetNetworkPolicyConfig() == nil {
+				if cluster.AddonsConfig == nil {
+					cluster.AddonsConfig = &containerpb.AddonsConfig{}
+				}
+				cluster.AddonsConfig.NetworkPolicyConfig = &containerpb.NetworkPolicyConfig{Disabled: false}
+			}
+		}
 	}
 
 	if !s.scope.IsAutopilotCluster() {
 		cluster.NodePools = scope.ConvertToSdkNodePools(nodePools, machinePools, isRegional, cluster.GetName())
+		cluster.Autoscaling = convertToSdkClusterAutoscaling(s.scope.GCPManagedControlPlane.Spec.ClusterAutoscaling)
 
 		if s.scope.GCPManagedControlPlane.Spec.LoggingService != nil {
 			cluster.LoggingService = s.scope.GCPManagedControlPlane.Spec.LoggingService.String()
@@ -347,6 +481,8 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 				SecurityGroup: cs.AuthenticatorGroupConfig.SecurityGroups,
 			}
 		}
+
+		cluster.DatabaseEncryption = convertToSdkDatabaseEncryption(cs.DatabaseEncryption)
 	}
 
 	createClusterRequest := &containerpb.CreateClusterRequest{
@@ -355,7 +491,7 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 	}
 
 	log.V(2).Info("Creating GKE cluster")
-	_, err := s.scope.ManagedControlPlaneClient().CreateCluster(ctx, createClusterRequest)
+	_, err = s.scope.ManagedControlPlaneClient().CreateCluster(ctx, createClusterRequest)
 	if err != nil {
 		log.Error(err, "Error creating GKE cluster", "name", s.scope.ClusterName())
 		return err
@@ -385,6 +521,53 @@ func (s *Service) getSubnetNameInClusterRegion() string {
 	return ""
 }
 
+// reconcileClusterUpdates updates the settings of the cluster that differ from the spec. GKE only allows one update
+// at a time, so it issues at most one update call and returns true if it did.
+func (s *Service) reconcileClusterUpdates(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) (bool, error) {
+	// Network policy enforcement must be disabled before the network policy add-on, and the add-on enabled before
+	// the enforcement. While enforcement is being disabled, the cluster update is deferred to a later reconcile.
+	needUpdateNetworkPolicy, setNetworkPolicyRequest := s.checkDiffAndPrepareNetworkPolicy(cluster, log)
+	disableNetworkPolicy := needUpdateNetworkPolicy && !setNetworkPolicyRequest.GetNetworkPolicy().GetEnabled()
+
+	needUpdate, updateClusterRequest := s.checkDiffAndPrepareUpdate(cluster, log)
+	if needUpdate && !disableNetworkPolicy {
+		log.Info("Update required")
+		if err := s.updateCluster(ctx, updateClusterRequest, log); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	needUpdateMaintenancePolicy, setMaintenancePolicyRequest := s.checkDiffAndPrepareMaintenancePolicy(cluster, log)
+	if needUpdateMaintenancePolicy {
+		log.Info("Maintenance policy update required")
+		if err := s.setMaintenancePolicy(ctx, setMaintenancePolicyRequest, log); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	if needUpdateNetworkPolicy {
+		log.Info("Network policy update required")
+		if err := s.setNetworkPolicy(ctx, setNetworkPolicyRequest, log); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	needUpdateResourceLabels, setLabelsRequest := s.checkDiffAndPrepareResourceLabels(cluster, log)
+	if needUpdateResourceLabels {
+		log.Info("Resource labels update required")
+		if err := s.setResourceLabels(ctx, setLabelsRequest, log); err != nil {
+			return false, err
+		}
+		s.scope.GCPManagedControlPlane.Status.ManagedResourceLabels = s.managedResourceLabels()
+		return true, nil
+	}
+
+	return false, nil
+}
+
 func (s *Service) updateCluster(ctx context.Context, updateClusterRequest *containerpb.UpdateClusterRequest, log *logr.Logger) error {
 	_, err := s.scope.ManagedControlPlaneClient().UpdateCluster(ctx, updateClusterRequest)
 	if err != nil {
@@ -395,6 +578,36 @@ func (s *Service) updateCluster(ctx context.Context, updateClusterRequest *conta
 	return nil
 }
 
+func (s *Service) setMaintenancePolicy(ctx context.Context, setMaintenancePolicyRequest *containerpb.SetMaintenancePolicyRequest, log *logr.Logger) error {
+	_, err := s.scope.ManagedControlPlaneClient().SetMaintenancePolicy(ctx, setMaintenancePolicyRequest)
+	if err != nil {
+		log.Error(err, "Error setting GKE cluster maintenance policy", "name", s.scope.ClusterName())
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) setNetworkPolicy(ctx context.Context, setNetworkPolicyRequest *containerpb.SetNetworkPolicyRequest, log *logr.Logger) error {
+	_, err := s.scope.ManagedControlPlaneClient().SetNetworkPolicy(ctx, setNetworkPolicyRequest)
+	if err != nil {
+		log.Error(err, "Error setting GKE cluster network policy", "name", s.scope.ClusterName())
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) setResourceLabels(ctx context.Context, setLabelsRequest *containerpb.SetLabelsRequest, log *logr.Logger) error {
+	_, err := s.scope.ManagedControlPlaneClient().SetLabels(ctx, setLabelsRequest)
+	if err != nil {
+		log.Error(err, "Error setting resource labels of GKE cluster", "name", s.scope.ClusterName())
+		return err
+	}
+
+	return nil
+}
+
 func (s *Service) deleteCluster(ctx context.Context, log *logr.Logger) error {
 	deleteClusterRequest := &containerpb.DeleteClusterRequest{
 		Name: s.scope.ClusterFullName(),
@@ -474,80 +687,275 @@ func convertToSdkBinaryAuthorizationEvaluationMode(mode *infrav1exp.BinaryAuthor
 	}
 }
 
-func (s *Service) checkDiffAndPrepareUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) (bool, *containerpb.UpdateClusterRequest) {
-	log.V(4).Info("Checking diff and preparing update.")
+// convertToSdkDatapathProvider converts the DatapathProvider string to the SDK int32 value.
+func convertToSdkDatapathProvider(provider infrav1exp.DatapathProvider) containerpb.DatapathProvider {
+	switch provider {
+	case infrav1exp.LegacyDatapath:
+		return containerpb.DatapathProvider_LEGACY_DATAPATH
+	case infrav1exp.AdvancedDatapath:
+		return containerpb.DatapathProvider_ADVANCED_DATAPATH
+	default:
+		return containerpb.DatapathProvider_DATAPATH_PROVIDER_UNSPECIFIED
+	}
+}
 
-	needUpdate := false
-	clusterUpdate := containerpb.ClusterUpdate{}
-	// Release channel
-	desiredReleaseChannel := convertToSdkReleaseChannel(s.scope.GCPManagedControlPlane.Spec.ReleaseChannel)
-	if desiredReleaseChannel != existingCluster.GetReleaseChannel().GetChannel() {
-		log.V(2).Info("Release channel update required", "current", existingCluster.GetReleaseChannel().GetChannel(), "desired", desiredReleaseChannel)
-		needUpdate = true
-		clusterUpdate.DesiredReleaseChannel = &containerpb.ReleaseChannel{
-			Channel: desiredReleaseChannel,
-		}
+// convertToSdkDNSConfig converts the ClusterDNS defined in CRs to the SDK version.
+func convertToSdkDNSConfig(dns *infrav1exp.ClusterDNS) *containerpb.DNSConfig {
+	if dns == nil {
+		return nil
 	}
-	// Master version
-	if desiredMasterVersionFromSpec := s.scope.GetControlPlaneVersion(); desiredMasterVersionFromSpec != nil {
-		desiredMasterVersion := convertToSdkMasterVersion(*desiredMasterVersionFromSpec)
-		existingClusterMasterVersion := convertToSdkMasterVersion(existingCluster.GetCurrentMasterVersion())
-		if desiredMasterVersion != existingClusterMasterVersion {
-			needUpdate = true
-			clusterUpdate.DesiredMasterVersion = desiredMasterVersion
-			log.V(2).Info("Master version update required", "current", existingClusterMasterVersion, "desired", desiredMasterVersion)
-		}
+
+	config := &containerpb.DNSConfig{
+		ClusterDnsDomain: dns.Domain,
+	}
+	switch dns.Provider {
+	case infrav1exp.ClusterDNSProviderPlatformDefault:
+		config.ClusterDns = containerpb.DNSConfig_PLATFORM_DEFAULT
+	case infrav1exp.ClusterDNSProviderCloudDNS:
+		config.ClusterDns = containerpb.DNSConfig_CLOUD_DNS
+	case infrav1exp.ClusterDNSProviderKubeDNS:
+		config.ClusterDns = containerpb.DNSConfig_KUBE_DNS
+	}
+	switch dns.Scope {
+	case infrav1exp.ClusterDNSScopeCluster:
+		config.ClusterDnsScope = containerpb.DNSConfig_CLUSTER_SCOPE
+	case infrav1exp.ClusterDNSScopeVPC:
+		config.ClusterDnsScope = containerpb.DNSConfig_VPC_SCOPE
 	}
 
-	// LoggingService
-	if specLoggingService := s.scope.GCPManagedControlPlane.Spec.LoggingService; specLoggingService != nil {
-		if existingCluster.GetLoggingService() != specLoggingService.String() {
-			needUpdate = true
-			clusterUpdate.DesiredLoggingService = specLoggingService.String()
-			log.V(2).Info("LoggingService config update required", "current", existingCluster.GetLoggingService(), "desired", specLoggingService.String())
-		}
+	return config
+}
+
+// convertToSdkGatewayAPIChannel converts the GatewayAPIChannel string to the SDK int32 value.
+func convertToSdkGatewayAPIChannel(channel infrav1exp.GatewayAPIChannel) containerpb.GatewayAPIConfig_Channel {
+	switch channel {
+	case infrav1exp.GatewayAPIChannelDisabled:
+		return containerpb.GatewayAPIConfig_CHANNEL_DISABLED
+	case infrav1exp.GatewayAPIChannelStandard:
+		return containerpb.GatewayAPIConfig_CHANNEL_STANDARD
+	default:
+		return containerpb.GatewayAPIConfig_CHANNEL_UNSPECIFIED
+	}
+}
+
+// checkDiffAndPrepareNetworkPolicy compares the network policy enforcement in the spec with the one of the existing cluster.
+// The network policy is not part of UpdateCluster and has to be set with a dedicated request.
+func (s *Service) checkDiffAndPrepareNetworkPolicy(existingCluster *containerpb.Cluster, log *logr.Logger) (bool, *containerpb.SetNetworkPolicyRequest) {
+	cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+	if cn == nil || cn.EnableNetworkPolicy == nil {
+		return false, nil
+	}
+	if *cn.EnableNetworkPolicy == existingCluster.GetNetworkPolicy().GetEnabled() {
+		return false, nil
+	}
+	log.V(2).Info("Network policy update required", "current", existingCluster.GetNetworkPolicy().GetEnabled(), "desired", *cn.EnableNetworkPolicy)
+
+	return true, &containerpb.SetNetworkPolicyRequest{
+		Name: s.scope.ClusterFullName(),
+		NetworkPolicy: &containerpb.NetworkPolicy{
+			Provider: containerpb.NetworkPolicy_CALICO,
+			Enabled:  *cn.EnableNetworkPolicy,
+		},
+	}
+}
+
+// convertToSdkAddonsConfig converts the ClusterAddons defined in CRs to the SDK version.
+// Only the add-ons that are specified are set, the others are left at the GKE defaults.
+func convertToSdkAddonsConfig(addons *infrav1exp.ClusterAddons) *containerpb.AddonsConfig {
+	if addons == nil {
+		return nil
+	}
+
+	config := &containerpb.AddonsConfig{}
+	if addons.HTTPLoadBalancing != nil {
+		config.HttpLoadBalancing = &containerpb.HttpLoadBalancing{Disabled: !*addons.HTTPLoadBalancing}
+	}
+	if addons.NetworkPolicy != nil {
+		config.NetworkPolicyConfig = &containerpb.NetworkPolicyConfig{Disabled: !*addons.NetworkPolicy}
+	}
+	if addons.GCEPersistentDiskCSIDriver != nil {
+		config.GcePersistentDiskCsiDriverConfig = &containerpb.GcePersistentDiskCsiDriverConfig{Enabled: *addons.GCEPersistentDiskCSIDriver}
+	}
+	if addons.GCPFilestoreCSIDriver != nil {
+		config.GcpFilestoreCsiDriverConfig = &containerpb.GcpFilestoreCsiDriverConfig{Enabled: *addons.GCPFilestoreCSIDriver}
+	}
+	if addons.GCSFuseCSIDriver != nil {
+		config.GcsFuseCsiDriverConfig = &containerpb.GcsFuseCsiDriverConfig{Enabled: *addons.GCSFuseCSIDriver}
+	}
+	if addons.ConfigConnector != nil {
+		config.ConfigConnectorConfig = &containerpb.ConfigConnectorConfig{Enabled: *addons.ConfigConnector}
+	}
+	if addons.GKEBackupAgent != nil {
+		config.GkeBackupAgentConfig = &containerpb.GkeBackupAgentConfig{Enabled: *addons.GKEBackupAgent}
+	}
+	if addons.DNSCache != nil {
+		config.DnsCacheConfig = &containerpb.DnsCacheConfig{Enabled: *addons.DNSCache}
+	}
+	if addons.RayOperator != nil {
+		config.RayOperatorConfig = &containerpb.RayOperatorConfig{Enabled: *addons.RayOperator}
+	}
+
+	return config
+}
+
+// convertToSdkClusterAutoscaling converts the ClusterAutoscaling defined in CRs to the SDK version.
+func convertToSdkClusterAutoscaling(autoscaling *infrav1exp.ClusterAutoscaling) *containerpb.ClusterAutoscaling {
+	if autoscaling == nil {
+		return nil
 	}
 
-	// MonitoringService
-	if specMonitoringService := s.scope.GCPManagedControlPlane.Spec.MonitoringService; specMonitoringService != nil {
-		if existingCluster.GetMonitoringService() != specMonitoringService.String() {
-			needUpdate = true
-			clusterUpdate.DesiredLoggingService = specMonitoringService.String()
-			log.V(2).Info("MonitoringService config update required", "current", existingCluster.GetMonitoringService(), "desired", specMonitoringService.String())
+	sdkAutoscaling := &containerpb.ClusterAutoscaling{
+		EnableNodeAutoprovisioning: autoscaling.EnableNodeAutoprovisioning,
+		AutoprovisioningLocations:  autoscaling.AutoprovisioningLocations,
+	}
+	for _, limit := range autoscaling.ResourceLimits {
+		sdkAutoscaling.ResourceLimits = append(sdkAutoscaling.ResourceLimits, &containerpb.ResourceLimit{
+			ResourceType: limit.ResourceType,
+			Minimum:      limit.Minimum,
+			Maximum:      limit.Maximum,
+		})
+	}
+	if autoscaling.AutoscalingProfile != nil {
+		sdkAutoscaling.AutoscalingProfile = convertToSdkAutoscalingProfile(*autoscaling.AutoscalingProfile)
+	}
+	if defaults := autoscaling.AutoprovisioningNodePoolDefaults; defaults != nil {
+		sdkAutoscaling.AutoprovisioningNodePoolDefaults = &containerpb.AutoprovisioningNodePoolDefaults{
+			ServiceAccount: defaults.ServiceAccount,
+			OauthScopes:    defaults.OAuthScopes,
+			ImageType:      defaults.ImageType,
+			BootDiskKmsKey: defaults.BootDiskKMSKey,
 		}
 	}
 
-	// DesiredMasterAuthorizedNetworksConfig
-	// When desiredMasterAuthorizedNetworksConfig is nil, it means that the user wants to disable the feature.
-	desiredMasterAuthorizedNetworksConfig := convertToSdkMasterAuthorizedNetworksConfig(s.scope.GCPManagedControlPlane.Spec.MasterAuthorizedNetworksConfig)
-	var existingAuthorizedNetworksConfig *containerpb.MasterAuthorizedNetworksConfig
-	if cpEndpointsConfig := existingCluster.GetControlPlaneEndpointsConfig(); cpEndpointsConfig != nil {
-		if ipEndpointsConfig := cpEndpointsConfig.GetIpEndpointsConfig(); ipEndpointsConfig != nil {
-			existingAuthorizedNetworksConfig = ipEndpointsConfig.GetAuthorizedNetworksConfig()
+	return sdkAutoscaling
+}
+
+// convertToSdkAutoscalingProfile converts the AutoscalingProfile string to the SDK int32 value.
+func convertToSdkAutoscalingProfile(profile infrav1exp.AutoscalingProfile) containerpb.ClusterAutoscaling_AutoscalingProfile {
+	switch profile {
+	case infrav1exp.AutoscalingProfileBalanced:
+		return containerpb.ClusterAutoscaling_BALANCED
+	case infrav1exp.AutoscalingProfileOptimizeUtilization:
+		return containerpb.ClusterAutoscaling_OPTIMIZE_UTILIZATION
+	default:
+		return containerpb.ClusterAutoscaling_PROFILE_UNSPECIFIED
+	}
+}
+
+// convertToSdkDatabaseEncryption converts the DatabaseEncryption defined in CRs to the SDK version.
+func convertToSdkDatabaseEncryption(encryption *infrav1exp.DatabaseEncryption) *containerpb.DatabaseEncryption {
+	if encryption == nil {
+		return nil
+	}
+
+	if encryption.State == infrav1exp.DatabaseEncryptionStateDecrypted {
+		return &containerpb.DatabaseEncryption{
+			State: containerpb.DatabaseEncryption_DECRYPTED,
 		}
 	}
-	if !compareMasterAuthorizedNetworksConfig(desiredMasterAuthorizedNetworksConfig, existingAuthorizedNetworksConfig) {
-		needUpdate = true
-		if clusterUpdate.GetDesiredControlPlaneEndpointsConfig() == nil {
-			clusterUpdate.DesiredControlPlaneEndpointsConfig = &containerpb.ControlPlaneEndpointsConfig{}
+	return &containerpb.DatabaseEncryption{
+		State:   containerpb.DatabaseEncryption_ENCRYPTED,
+		KeyName: encryption.KeyName,
+	}
+}
+
+// convertFromSdkDatabaseEncryption converts the database encryption reported by GKE to the GCPManagedControlPlane status.
+func convertFromSdkDatabaseEncryption(encryption *containerpb.DatabaseEncryption) *infrav1exp.DatabaseEncryptionStatus {
+	if encryption == nil {
+		return nil
+	}
+
+	status := &infrav1exp.DatabaseEncryptionStatus{
+		KeyName: encryption.GetKeyName(),
+	}
+	// The current state isn't reported by all clusters, the desired state is used when it is missing.
+	if encryption.CurrentState != nil && encryption.GetCurrentState() != containerpb.DatabaseEncryption_CURRENT_STATE_UNSPECIFIED {
+		status.State = strings.ToLower(strings.TrimPrefix(encryption.GetCurrentState().String(), "CURRENT_STATE_"))
+	} else if encryption.GetState() != containerpb.DatabaseEncryption_UNKNOWN {
+		status.State = strings.ToLower(encryption.GetState().String())
+	}
+	if errs := encryption.GetLastOperationErrors(); len(errs) > 0 {
+		status.LastOperationError = errs[len(errs)-1].GetErrorMessage()
+	}
+
+	return status
+}
+
+// convertToSdkMaintenancePolicy converts the MaintenancePolicy defined in CRs to the SDK version.
+func convertToSdkMaintenancePolicy(policy *infrav1exp.MaintenancePolicy) *containerpb.MaintenancePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	window := &containerpb.MaintenanceWindow{}
+	switch {
+	case policy.DailyMaintenanceWindow != nil:
+		window.Policy = &containerpb.MaintenanceWindow_DailyMaintenanceWindow{
+			DailyMaintenanceWindow: &containerpb.DailyMaintenanceWindow{
+				StartTime: policy.DailyMaintenanceWindow.StartTime,
+			},
 		}
-		if clusterUpdate.GetDesiredControlPlaneEndpointsConfig().GetIpEndpointsConfig() == nil {
-			clusterUpdate.DesiredControlPlaneEndpointsConfig.IpEndpointsConfig = &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{}
+	case policy.RecurringWindow != nil:
+		window.Policy = &containerpb.MaintenanceWindow_RecurringWindow{
+			RecurringWindow: &containerpb.RecurringTimeWindow{
+				Window: &containerpb.TimeWindow{
+					StartTime: timestamppb.New(policy.RecurringWindow.StartTime.Time),
+					EndTime:   timestamppb.New(policy.RecurringWindow.EndTime.Time),
+				},
+				Recurrence: policy.RecurringWindow.Recurrence,
+			},
 		}
-		clusterUpdate.DesiredControlPlaneEndpointsConfig.IpEndpointsConfig.AuthorizedNetworksConfig = desiredMasterAuthorizedNetworksConfig
-		log.V(2).Info("Master authorized networks config update required", "current", existingAuthorizedNetworksConfig, "desired", desiredMasterAuthorizedNetworksConfig)
 	}
-	log.V(4).Info("Master authorized networks config update check", "current", existingAuthorizedNetworksConfig)
-	if desiredMasterAuthorizedNetworksConfig != nil {
-		log.V(4).Info("Master authorized networks config update check", "desired", desiredMasterAuthorizedNetworksConfig)
+
+	if len(policy.MaintenanceExclusions) > 0 {
+		window.MaintenanceExclusions = make(map[string]*containerpb.TimeWindow, len(policy.MaintenanceExclusions))
+		for _, exclusion := range policy.MaintenanceExclusions {
+			window.MaintenanceExclusions[exclusion.Name] = &containerpb.TimeWindow{
+				StartTime: timestamppb.New(exclusion.StartTime.Time),
+				EndTime:   timestamppb.New(exclusion.EndTime.Time),
+				Options: &containerpb.TimeWindow_MaintenanceExclusionOptions{
+					MaintenanceExclusionOptions: &containerpb.MaintenanceExclusionOptions{
+						Scope: convertToSdkMaintenanceExclusionScope(exclusion.Scope),
+					},
+				},
+			}
+		}
 	}
 
-	updateClusterRequest := containerpb.UpdateClusterRequest{
-		Name:   s.scope.ClusterFullName(),
-		Update: &clusterUpdate,
+	return &containerpb.MaintenancePolicy{
+		Window: window,
+	}
+}
+
+// convertToSdkMaintenanceExclusionScope converts the MaintenanceExclusionScope string to the SDK int32 value.
+func convertToSdkMaintenanceExclusionScope(scope infrav1exp.MaintenanceExclusionScope) containerpb.MaintenanceExclusionOptions_Scope {
+	switch scope {
+	case infrav1exp.MaintenanceExclusionScopeNoMinorUpgrades:
+		return containerpb.MaintenanceExclusionOptions_NO_MINOR_UPGRADES
+	default:
+		return containerpb.MaintenanceExclusionOptions_NO_UPGRADES
+	}
+}
+
+// checkDiffAndPrepareMaintenancePolicy compares the maintenance policy in the spec with the one of the existing cluster.
+// The maintenance policy is not part of UpdateCluster and has to be set with a dedicated request.
+func (s *Service) checkDiffAndPrepareMaintenancePolicy(existingCluster *containerpb.Cluster, log *logr.Logger) (bool, *containerpb.SetMaintenancePolicyRequest) {
+	// When the maintenance policy is not specified, the policy of the cluster is left untouched.
+	desiredMaintenancePolicy := convertToSdkMaintenancePolicy(s.scope.GCPManagedControlPlane.Spec.MaintenancePolicy)
+	if desiredMaintenancePolicy == nil {
+		return false, nil
+	}
+	if compareMaintenancePolicy(desiredMaintenancePolicy, existingCluster.GetMaintenancePolicy()) {
+		return false, nil
+	}
+	log.V(2).Info("Maintenance policy update required", "current", existingCluster.GetMaintenancePolicy(), "desired", desiredMaintenancePolicy)
+
+	// The resource version of the existing policy guards against overwriting concurrent changes.
+	desiredMaintenancePolicy.ResourceVersion = existingCluster.GetMaintenancePolicy().GetResourceVersion()
+	return true, &containerpb.SetMaintenancePolicyRequest{
+		Name:              s.scope.ClusterFullName(),
+		MaintenancePolicy: desiredMaintenancePolicy,
 	}
-	log.V(4).Info("Update cluster request. ", "needUpdate", needUpdate, "updateClusterRequest", &updateClusterRequest)
-	return needUpdate, &updateClusterRequest
 }
 
 // compare if two MasterAuthorizedNetworksConfig are equal.
@@ -577,3 +985,121 @@ func compareMasterAuthorizedNetworksConfig(a, b *containerpb.MasterAuthorizedNet
 	}
 	return true
 }
+
+// compare if the desired DatabaseEncryption matches the existing one. The key is only compared when the secrets are
+// encrypted, as GKE keeps the key name of a decrypted cluster.
+func compareDatabaseEncryption(desired, existing *containerpb.DatabaseEncryption) bool {
+	if desired.GetState() != existing.GetState() {
+		return false
+	}
+	if desired.GetState() == containerpb.DatabaseEncryption_ENCRYPTED && desired.GetKeyName() != existing.GetKeyName() {
+		return false
+	}
+	return true
+}
+
+// compare if the add-ons set in the desired AddonsConfig match the existing ones.
+func compareAddonsConfig(desired, existing *containerpb.AddonsConfig) bool {
+	if desired.GetHttpLoadBalancing() != nil && desired.GetHttpLoadBalancing().GetDisabled() != existing.GetHttpLoadBalancing().GetDisabled() {
+		return false
+	}
+	if desired.GetNetworkPolicyConfig() != nil && desired.GetNetworkPolicyConfig().GetDisabled() != existing.GetNetworkPolicyConfig().GetDisabled() {
+		return false
+	}
+	if desired.GetGcePersistentDiskCsiDriverConfig() != nil && desired.GetGcePersistentDiskCsiDriverConfig().GetEnabled() != existing.GetGcePersistentDiskCsiDriverConfig().GetEnabled() {
+		return false
+	}
+	if desired.GetGcpFilestoreCsiDriverConfig() != nil && desired.GetGcpFilestoreCsiDriverConfig().GetEnabled() != existing.GetGcpFilestoreCsiDriverConfig().GetEnabled() {
+		return false
+	}
+	if desired.GetGcsFuseCsiDriverConfig() != nil && desired.GetGcsFuseCsiDriverConfig().GetEnabled() != existing.GetGcsFuseCsiDriverConfig().GetEnabled() {
+		return false
+	}
+	if desired.GetConfigConnectorConfig() != nil && desired.GetConfigConnectorConfig().GetEnabled() != existing.GetConfigConnectorConfig().GetEnabled() {
+		return false
+	}
+	if desired.GetGkeBackupAgentConfig() != nil && desired.GetGkeBackupAgentConfig().GetEnabled() != existing.GetGkeBackupAgentConfig().GetEnabled() {
+		return false
+	}
+	if desired.GetDnsCacheConfig() != nil && desired.GetDnsCacheConfig().GetEnabled() != existing.GetDnsCacheConfig().GetEnabled() {
+		return false
+	}
+	if desired.GetRayOperatorConfig() != nil && desired.GetRayOperatorConfig().GetEnabled() != existing.GetRayOperatorConfig().GetEnabled() {
+		return false
+	}
+	return true
+}
+
+// compare if the desired ClusterAutoscaling matches the existing one. The autoscaling profile, the node pool defaults
+// and the locations are only compared when they are specified, as GKE fills in defaults for them.
+func compareClusterAutoscaling(desired, existing *containerpb.ClusterAutoscaling) bool {
+	if desired.GetEnableNodeAutoprovisioning() != existing.GetEnableNodeAutoprovisioning() {
+		return false
+	}
+	if desired.GetAutoscalingProfile() != containerpb.ClusterAutoscaling_PROFILE_UNSPECIFIED && desired.GetAutoscalingProfile() != existing.GetAutoscalingProfile() {
+		return false
+	}
+	sortResourceLimits := cmpopts.SortSlices(func(a, b *containerpb.ResourceLimit) bool { return a.GetResourceType() < b.GetResourceType() })
+	if !cmp.Equal(desired.GetResourceLimits(), existing.GetResourceLimits(), sortResourceLimits, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(containerpb.ResourceLimit{})) {
+		return false
+	}
+	if len(desired.GetAutoprovisioningLocations()) > 0 && !cmp.Equal(desired.GetAutoprovisioningLocations(), existing.GetAutoprovisioningLocations(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+		return false
+	}
+	desiredDefaults, existingDefaults := desired.GetAutoprovisioningNodePoolDefaults(), existing.GetAutoprovisioningNodePoolDefaults()
+	if desiredDefaults.GetServiceAccount() != "" && desiredDefaults.GetServiceAccount() != existingDefaults.GetServiceAccount() {
+		return false
+	}
+	if len(desiredDefaults.GetOauthScopes()) > 0 && !cmp.Equal(desiredDefaults.GetOauthScopes(), existingDefaults.GetOauthScopes(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+		return false
+	}
+	if desiredDefaults.GetImageType() != "" && desiredDefaults.GetImageType() != existingDefaults.GetImageType() {
+		return false
+	}
+	if desiredDefaults.GetBootDiskKmsKey() != "" && desiredDefaults.GetBootDiskKmsKey() != existingDefaults.GetBootDiskKmsKey() {
+		return false
+	}
+	return true
+}
+
+// compare if two DNSConfig are equal. A DNS scope or domain that is not specified is not compared.
+func compareDNSConfig(desired, existing *containerpb.DNSConfig) bool {
+	if desired.GetClusterDns() != existing.GetClusterDns() {
+		return false
+	}
+	if desired.GetClusterDnsScope() != containerpb.DNSConfig_DNS_SCOPE_UNSPECIFIED && desired.GetClusterDnsScope() != existing.GetClusterDnsScope() {
+		return false
+	}
+	if desired.GetClusterDnsDomain() != "" && desired.GetClusterDnsDomain() != existing.GetClusterDnsDomain() {
+		return false
+	}
+	return true
+}
+
+// compare if two MaintenancePolicy are equal, ignoring the output only fields set by GKE.
+func compareMaintenancePolicy(a, b *containerpb.MaintenancePolicy) bool {
+	aWindow, bWindow := a.GetWindow(), b.GetWindow()
+	if aWindow.GetDailyMaintenanceWindow().GetStartTime() != bWindow.GetDailyMaintenanceWindow().GetStartTime() {
+		return false
+	}
+	if !compareTimeWindow(aWindow.GetRecurringWindow().GetWindow(), bWindow.GetRecurringWindow().GetWindow()) ||
+		aWindow.GetRecurringWindow().GetRecurrence() != bWindow.GetRecurringWindow().GetRecurrence() {
+		return false
+	}
+	if len(aWindow.GetMaintenanceExclusions()) != len(bWindow.GetMaintenanceExclusions()) {
+		return false
+	}
+	for name, aExclusion := range aWindow.GetMaintenanceExclusions() {
+		bExclusion, ok := bWindow.GetMaintenanceExclusions()[name]
+		if !ok || !compareTimeWindow(aExclusion, bExclusion) ||
+			aExclusion.GetMaintenanceExclusionOptions().GetScope() != bExclusion.GetMaintenanceExclusionOptions().GetScope() {
+			return false
+		}
+	}
+	return true
+}
+
+// compare if the start and end time of two TimeWindow are equal.
+func compareTimeWindow(a, b *containerpb.TimeWindow) bool {
+	return a.GetStartTime().AsTime().Equal(b.GetStartTime().AsTime()) && a.GetEndTime().AsTime().Equal(b.GetEndTime().AsTime())
+}
@@ -17,11 +17,24 @@ limitations under the License.
 package clusters
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	container "cloud.google.com/go/container/apiv1"
 	"cloud.google.com/go/container/apiv1/containerpb"
+	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/gkehub/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
@@ -33,6 +46,60 @@ func newTestService(controlPlane *infrav1exp.GCPManagedControlPlane) *Service {
 	return &Service{scope: s}
 }
 
+// newScopedTestService returns a service whose scope is backed by a fake client and uses the given GKE and GKE Hub
+// clients. The GKE client defaults to one that can't reach any server.
+func newScopedTestService(t *testing.T, controlPlane *infrav1exp.GCPManagedControlPlane, managedClusterClient *container.ClusterManagerClient, gkeHubService *gkehub.Service) *Service {
+	t.Helper()
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := infrav1exp.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	var err error
+	if managedClusterClient == nil {
+		managedClusterClient, err = container.NewClusterManagerClient(ctx, option.WithEndpoint("localhost:0"), option.WithoutAuthentication())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	tagBindingsClient, err := resourcemanager.NewTagBindingsClient(ctx, option.WithEndpoint("localhost:0"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := scope.NewManagedControlPlaneScope(ctx, scope.ManagedControlPlaneScopeParams{
+		Client:                 fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).Build(),
+		Cluster:                &clusterv1.Cluster{},
+		GCPManagedCluster:      &infrav1exp.GCPManagedCluster{},
+		GCPManagedControlPlane: controlPlane,
+		ManagedClusterClient:   managedClusterClient,
+		TagBindingsClient:      tagBindingsClient,
+		GKEHubService:          gkeHubService,
+		TokenSource:            oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = s.Close() })
+
+	return New(s)
+}
+
+// disabledAuthorizedNetworksEndpointsConfig returns the control plane endpoints of a cluster without master
+// authorized networks, which matches a spec that doesn't configure them.
+func disabledAuthorizedNetworksEndpointsConfig() *containerpb.ControlPlaneEndpointsConfig {
+	return &containerpb.ControlPlaneEndpointsConfig{
+		IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+			AuthorizedNetworksConfig: &containerpb.MasterAuthorizedNetworksConfig{
+				Enabled:                     false,
+				CidrBlocks:                  []*containerpb.MasterAuthorizedNetworksConfig_CidrBlock{},
+				GcpPublicCidrsAccessEnabled: ptr.To(false),
+			},
+		},
+	}
+}
+
 func TestCheckDiffAndPrepareUpdate(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -243,6 +310,376 @@ func TestCheckDiffAndPrepareUpdate(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "no diff when specified addons match",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+						Addons: &infrav1exp.ClusterAddons{
+							HTTPLoadBalancing: ptr.To(true),
+							GCSFuseCSIDriver:  ptr.To(true),
+						},
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				AddonsConfig: &containerpb.AddonsConfig{
+					HttpLoadBalancing:      &containerpb.HttpLoadBalancing{Disabled: false},
+					GcsFuseCsiDriverConfig: &containerpb.GcsFuseCsiDriverConfig{Enabled: true},
+					DnsCacheConfig:         &containerpb.DnsCacheConfig{Enabled: true},
+				},
+				ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+					IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+						AuthorizedNetworksConfig: &containerpb.MasterAuthorizedNetworksConfig{
+							Enabled:                     false,
+							CidrBlocks:                  []*containerpb.MasterAuthorizedNetworksConfig_CidrBlock{},
+							GcpPublicCidrsAccessEnabled: ptr.To(false),
+						},
+					},
+				},
+			},
+			wantNeedUpdate: false,
+		},
+		{
+			name: "update needed when addons differ",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+						Addons: &infrav1exp.ClusterAddons{
+							HTTPLoadBalancing: ptr.To(false),
+							ConfigConnector:   ptr.To(true),
+						},
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				AddonsConfig: &containerpb.AddonsConfig{
+					HttpLoadBalancing: &containerpb.HttpLoadBalancing{Disabled: false},
+				},
+				ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+					IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+						AuthorizedNetworksConfig: &containerpb.MasterAuthorizedNetworksConfig{
+							Enabled:                     false,
+							CidrBlocks:                  []*containerpb.MasterAuthorizedNetworksConfig_CidrBlock{},
+							GcpPublicCidrsAccessEnabled: ptr.To(false),
+						},
+					},
+				},
+			},
+			wantNeedUpdate: true,
+			validateUpdateFunc: func(t *testing.T, req *containerpb.UpdateClusterRequest) {
+				t.Helper()
+				addonsConfig := req.GetUpdate().GetDesiredAddonsConfig()
+				if !addonsConfig.GetHttpLoadBalancing().GetDisabled() {
+					t.Error("expected HTTP load balancing to be disabled")
+				}
+				if !addonsConfig.GetConfigConnectorConfig().GetEnabled() {
+					t.Error("expected Config Connector to be enabled")
+				}
+				if addonsConfig.GetDnsCacheConfig() != nil {
+					t.Errorf("expected DNS cache to be left unset, got %v", addonsConfig.GetDnsCacheConfig())
+				}
+			},
+		},
+		{
+			name: "cluster network settings are updated one at a time",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+						ClusterNetwork: &infrav1exp.ClusterNetwork{
+							EnableIntraNodeVisibility: ptr.To(true),
+							DNS: &infrav1exp.ClusterDNS{
+								Provider: infrav1exp.ClusterDNSProviderCloudDNS,
+								Scope:    infrav1exp.ClusterDNSScopeCluster,
+							},
+							GatewayAPIChannel: ptr.To(infrav1exp.GatewayAPIChannelStandard),
+						},
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				NetworkConfig: &containerpb.NetworkConfig{
+					DnsConfig: &containerpb.DNSConfig{
+						ClusterDns: containerpb.DNSConfig_KUBE_DNS,
+					},
+				},
+				ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+					IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+						AuthorizedNetworksConfig: &containerpb.MasterAuthorizedNetworksConfig{
+							Enabled:                     false,
+							CidrBlocks:                  []*containerpb.MasterAuthorizedNetworksConfig_CidrBlock{},
+							GcpPublicCidrsAccessEnabled: ptr.To(false),
+						},
+					},
+				},
+			},
+			wantNeedUpdate: true,
+			validateUpdateFunc: func(t *testing.T, req *containerpb.UpdateClusterRequest) {
+				t.Helper()
+				update := req.GetUpdate()
+				if !update.GetDesiredIntraNodeVisibilityConfig().GetEnabled() {
+					t.Error("expected intranode visibility to be enabled")
+				}
+				if update.GetDesiredDnsConfig() != nil || update.GetDesiredGatewayApiConfig() != nil {
+					t.Errorf("expected DNS and Gateway API configs to be updated in later reconciliations, got %v", update)
+				}
+			},
+		},
+		{
+			name: "no diff when gateway API is disabled and not configured on the cluster",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+						ClusterNetwork: &infrav1exp.ClusterNetwork{
+							GatewayAPIChannel:         ptr.To(infrav1exp.GatewayAPIChannelDisabled),
+							EnableIntraNodeVisibility: ptr.To(false),
+						},
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+					IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+						AuthorizedNetworksConfig: &containerpb.MasterAuthorizedNetworksConfig{
+							Enabled:                     false,
+							CidrBlocks:                  []*containerpb.MasterAuthorizedNetworksConfig_CidrBlock{},
+							GcpPublicCidrsAccessEnabled: ptr.To(false),
+						},
+					},
+				},
+			},
+			wantNeedUpdate: false,
+		},
+		{
+			name: "update needed when monitoring service differs",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:           "test-project",
+						Location:          "us-central1",
+						MonitoringService: ptr.To(infrav1exp.MonitoringService("none")),
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				MonitoringService:           "monitoring.googleapis.com/kubernetes",
+				ControlPlaneEndpointsConfig: disabledAuthorizedNetworksEndpointsConfig(),
+			},
+			wantNeedUpdate: true,
+			validateUpdateFunc: func(t *testing.T, req *containerpb.UpdateClusterRequest) {
+				t.Helper()
+				if req.GetUpdate().GetDesiredMonitoringService() != "none" {
+					t.Errorf("expected monitoring service none, got %q", req.GetUpdate().GetDesiredMonitoringService())
+				}
+				if req.GetUpdate().GetDesiredLoggingService() != "" {
+					t.Errorf("expected logging service to be left unset, got %q", req.GetUpdate().GetDesiredLoggingService())
+				}
+			},
+		},
+		{
+			name: "update needed when binary authorization differs",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:             "test-project",
+						Location:            "us-central1",
+						BinaryAuthorization: ptr.To(infrav1exp.EvaluationModeProjectSingletonPolicyEnforce),
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				ControlPlaneEndpointsConfig: disabledAuthorizedNetworksEndpointsConfig(),
+			},
+			wantNeedUpdate: true,
+			validateUpdateFunc: func(t *testing.T, req *containerpb.UpdateClusterRequest) {
+				t.Helper()
+				if req.GetUpdate().GetDesiredBinaryAuthorization().GetEvaluationMode() != containerpb.BinaryAuthorization_PROJECT_SINGLETON_POLICY_ENFORCE {
+					t.Errorf("expected enforced binary authorization, got %v", req.GetUpdate().GetDesiredBinaryAuthorization())
+				}
+			},
+		},
+		{
+			name: "no diff when binary authorization is disabled and not configured on the cluster",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:             "test-project",
+						Location:            "us-central1",
+						BinaryAuthorization: ptr.To(infrav1exp.EvaluationModeDisabled),
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				ControlPlaneEndpointsConfig: disabledAuthorizedNetworksEndpointsConfig(),
+			},
+			wantNeedUpdate: false,
+		},
+		{
+			name: "update needed when workload identity differs",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+						ClusterSecurity: &infrav1exp.ClusterSecurity{
+							WorkloadIdentityConfig: &infrav1exp.WorkloadIdentityConfig{
+								WorkloadPool: "test-project.svc.id.goog",
+							},
+						},
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				ControlPlaneEndpointsConfig: disabledAuthorizedNetworksEndpointsConfig(),
+			},
+			wantNeedUpdate: true,
+			validateUpdateFunc: func(t *testing.T, req *containerpb.UpdateClusterRequest) {
+				t.Helper()
+				if req.GetUpdate().GetDesiredWorkloadIdentityConfig().GetWorkloadPool() != "test-project.svc.id.goog" {
+					t.Errorf("expected workload pool test-project.svc.id.goog, got %v", req.GetUpdate().GetDesiredWorkloadIdentityConfig())
+				}
+			},
+		},
+		{
+			name: "update needed when identity service differs",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:               "test-project",
+						Location:              "us-central1",
+						EnableIdentityService: ptr.To(true),
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				ControlPlaneEndpointsConfig: disabledAuthorizedNetworksEndpointsConfig(),
+			},
+			wantNeedUpdate: true,
+			validateUpdateFunc: func(t *testing.T, req *containerpb.UpdateClusterRequest) {
+				t.Helper()
+				if !req.GetUpdate().GetDesiredIdentityServiceConfig().GetEnabled() {
+					t.Error("expected identity service to be enabled")
+				}
+			},
+		},
+		{
+			name: "update needed when private endpoint differs",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+						ClusterNetwork: &infrav1exp.ClusterNetwork{
+							PrivateCluster: &infrav1exp.PrivateCluster{
+								EnablePrivateEndpoint: true,
+								EnablePrivateNodes:    true,
+							},
+						},
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				NetworkConfig: &containerpb.NetworkConfig{
+					DefaultEnablePrivateNodes: ptr.To(true),
+				},
+				ControlPlaneEndpointsConfig: disabledAuthorizedNetworksEndpointsConfig(),
+			},
+			wantNeedUpdate: true,
+			validateUpdateFunc: func(t *testing.T, req *containerpb.UpdateClusterRequest) {
+				t.Helper()
+				ipEndpointsConfig := req.GetUpdate().GetDesiredControlPlaneEndpointsConfig().GetIpEndpointsConfig()
+				if ipEndpointsConfig.EnablePublicEndpoint == nil || ipEndpointsConfig.GetEnablePublicEndpoint() {
+					t.Errorf("expected public endpoint to be disabled, got %v", ipEndpointsConfig)
+				}
+				if ipEndpointsConfig.GetAuthorizedNetworksConfig() != nil || ipEndpointsConfig.GlobalAccess != nil {
+					t.Errorf("expected only the public endpoint to be updated, got %v", ipEndpointsConfig)
+				}
+			},
+		},
+		{
+			name: "update needed when control plane global access differs",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+						ClusterNetwork: &infrav1exp.ClusterNetwork{
+							PrivateCluster: &infrav1exp.PrivateCluster{
+								ControlPlaneGlobalAccess: true,
+							},
+						},
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				ControlPlaneEndpointsConfig: disabledAuthorizedNetworksEndpointsConfig(),
+			},
+			wantNeedUpdate: true,
+			validateUpdateFunc: func(t *testing.T, req *containerpb.UpdateClusterRequest) {
+				t.Helper()
+				if !req.GetUpdate().GetDesiredControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetGlobalAccess() {
+					t.Error("expected control plane global access to be enabled")
+				}
+			},
+		},
+		{
+			name: "no diff when private cluster settings match",
+			controlPlane: &infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+						ClusterNetwork: &infrav1exp.ClusterNetwork{
+							PrivateCluster: &infrav1exp.PrivateCluster{
+								EnablePrivateNodes:       true,
+								ControlPlaneGlobalAccess: true,
+								DisableDefaultSNAT:       true,
+							},
+						},
+					},
+					ClusterName: "test-cluster",
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				PrivateClusterConfig: &containerpb.PrivateClusterConfig{
+					EnablePrivateNodes: true,
+				},
+				NetworkConfig: &containerpb.NetworkConfig{
+					DefaultSnatStatus: &containerpb.DefaultSnatStatus{Disabled: true},
+				},
+				ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+					IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+						EnablePublicEndpoint: ptr.To(true),
+						GlobalAccess:         ptr.To(true),
+						AuthorizedNetworksConfig: &containerpb.MasterAuthorizedNetworksConfig{
+							Enabled:                     false,
+							CidrBlocks:                  []*containerpb.MasterAuthorizedNetworksConfig_CidrBlock{},
+							GcpPublicCidrsAccessEnabled: ptr.To(false),
+						},
+					},
+				},
+			},
+			wantNeedUpdate: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -675,3 +1112,448 @@ func TestClusterNetworkNilPointerGuards(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckDiffAndPrepareMaintenancePolicy(t *testing.T) {
+	startTime := time.Date(2025, time.January, 4, 2, 0, 0, 0, time.UTC)
+	policy := &infrav1exp.MaintenancePolicy{
+		RecurringWindow: &infrav1exp.RecurringMaintenanceWindow{
+			StartTime:  metav1.NewTime(startTime),
+			EndTime:    metav1.NewTime(startTime.Add(8 * time.Hour)),
+			Recurrence: "FREQ=WEEKLY;BYDAY=SA,SU",
+		},
+		MaintenanceExclusions: []infrav1exp.MaintenanceExclusion{
+			{
+				Name:      "freeze",
+				StartTime: metav1.NewTime(startTime),
+				EndTime:   metav1.NewTime(startTime.Add(30 * 24 * time.Hour)),
+				Scope:     infrav1exp.MaintenanceExclusionScopeNoMinorUpgrades,
+			},
+		},
+	}
+	existingPolicy := &containerpb.MaintenancePolicy{
+		Window: &containerpb.MaintenanceWindow{
+			Policy: &containerpb.MaintenanceWindow_RecurringWindow{
+				RecurringWindow: &containerpb.RecurringTimeWindow{
+					Window: &containerpb.TimeWindow{
+						StartTime: timestamppb.New(startTime),
+						EndTime:   timestamppb.New(startTime.Add(8 * time.Hour)),
+					},
+					Recurrence: "FREQ=WEEKLY;BYDAY=SA,SU",
+				},
+			},
+			MaintenanceExclusions: map[string]*containerpb.TimeWindow{
+				"freeze": {
+					StartTime: timestamppb.New(startTime),
+					EndTime:   timestamppb.New(startTime.Add(30 * 24 * time.Hour)),
+					Options: &containerpb.TimeWindow_MaintenanceExclusionOptions{
+						MaintenanceExclusionOptions: &containerpb.MaintenanceExclusionOptions{
+							Scope: containerpb.MaintenanceExclusionOptions_NO_MINOR_UPGRADES,
+						},
+					},
+				},
+			},
+		},
+		ResourceVersion: "abc123",
+	}
+
+	tests := []struct {
+		name           string
+		policy         *infrav1exp.MaintenancePolicy
+		existingPolicy *containerpb.MaintenancePolicy
+		wantNeedUpdate bool
+	}{
+		{
+			name:           "no update when maintenance policy is not specified",
+			policy:         nil,
+			existingPolicy: existingPolicy,
+			wantNeedUpdate: false,
+		},
+		{
+			name:           "no update when maintenance policy matches",
+			policy:         policy,
+			existingPolicy: existingPolicy,
+			wantNeedUpdate: false,
+		},
+		{
+			name:           "update needed when cluster has no maintenance policy",
+			policy:         policy,
+			existingPolicy: nil,
+			wantNeedUpdate: true,
+		},
+		{
+			name: "update needed when daily maintenance window differs",
+			policy: &infrav1exp.MaintenancePolicy{
+				DailyMaintenanceWindow: &infrav1exp.DailyMaintenanceWindow{
+					StartTime: "03:00",
+				},
+			},
+			existingPolicy: &containerpb.MaintenancePolicy{
+				Window: &containerpb.MaintenanceWindow{
+					Policy: &containerpb.MaintenanceWindow_DailyMaintenanceWindow{
+						DailyMaintenanceWindow: &containerpb.DailyMaintenanceWindow{
+							StartTime: "04:00",
+							Duration:  "PT4H0M0S",
+						},
+					},
+				},
+			},
+			wantNeedUpdate: true,
+		},
+		{
+			name: "update needed when maintenance exclusion is removed",
+			policy: &infrav1exp.MaintenancePolicy{
+				RecurringWindow: policy.RecurringWindow,
+			},
+			existingPolicy: existingPolicy,
+			wantNeedUpdate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(&infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:           "test-project",
+						Location:          "us-central1",
+						MaintenancePolicy: tt.policy,
+					},
+					ClusterName: "test-cluster",
+				},
+			})
+			log := ctrl.Log.WithName("test")
+			needUpdate, req := svc.checkDiffAndPrepareMaintenancePolicy(&containerpb.Cluster{MaintenancePolicy: tt.existingPolicy}, &log)
+			if needUpdate != tt.wantNeedUpdate {
+				t.Fatalf("checkDiffAndPrepareMaintenancePolicy() needUpdate = %v, want %v", needUpdate, tt.wantNeedUpdate)
+			}
+			if !needUpdate {
+				return
+			}
+			if req.GetName() != "projects/test-project/locations/us-central1/clusters/test-cluster" {
+				t.Errorf("unexpected cluster name %q", req.GetName())
+			}
+			if req.GetMaintenancePolicy().GetResourceVersion() != tt.existingPolicy.GetResourceVersion() {
+				t.Errorf("expected resource version %q, got %q", tt.existingPolicy.GetResourceVersion(), req.GetMaintenancePolicy().GetResourceVersion())
+			}
+		})
+	}
+}
+
+func TestCheckDiffAndPrepareNetworkPolicy(t *testing.T) {
+	tests := []struct {
+		name                string
+		enableNetworkPolicy *bool
+		existingPolicy      *containerpb.NetworkPolicy
+		wantNeedUpdate      bool
+	}{
+		{
+			name:                "no update when network policy is not specified",
+			enableNetworkPolicy: nil,
+			existingPolicy:      &containerpb.NetworkPolicy{Enabled: true},
+			wantNeedUpdate:      false,
+		},
+		{
+			name:                "no update when network policy matches",
+			enableNetworkPolicy: ptr.To(true),
+			existingPolicy:      &containerpb.NetworkPolicy{Provider: containerpb.NetworkPolicy_CALICO, Enabled: true},
+			wantNeedUpdate:      false,
+		},
+		{
+			name:                "no update when network policy is disabled and cluster has none",
+			enableNetworkPolicy: ptr.To(false),
+			existingPolicy:      nil,
+			wantNeedUpdate:      false,
+		},
+		{
+			name:                "update needed when network policy is enabled",
+			enableNetworkPolicy: ptr.To(true),
+			existingPolicy:      nil,
+			wantNeedUpdate:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(&infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+						ClusterNetwork: &infrav1exp.ClusterNetwork{
+							EnableNetworkPolicy: tt.enableNetworkPolicy,
+						},
+					},
+					ClusterName: "test-cluster",
+				},
+			})
+			log := ctrl.Log.WithName("test")
+			needUpdate, req := svc.checkDiffAndPrepareNetworkPolicy(&containerpb.Cluster{NetworkPolicy: tt.existingPolicy}, &log)
+			if needUpdate != tt.wantNeedUpdate {
+				t.Fatalf("checkDiffAndPrepareNetworkPolicy() needUpdate = %v, want %v", needUpdate, tt.wantNeedUpdate)
+			}
+			if !needUpdate {
+				return
+			}
+			if req.GetNetworkPolicy().GetProvider() != containerpb.NetworkPolicy_CALICO {
+				t.Errorf("expected Calico provider, got %v", req.GetNetworkPolicy().GetProvider())
+			}
+			if req.GetNetworkPolicy().GetEnabled() != *tt.enableNetworkPolicy {
+				t.Errorf("expected network policy enabled = %v", *tt.enableNetworkPolicy)
+			}
+		})
+	}
+}
+
+func TestReconcileClusterUpdatesDisablesNetworkPolicyFirst(t *testing.T) {
+	const clusterName = "projects/test-project/locations/us-central1/clusters/test-cluster"
+
+	controlPlane := &infrav1exp.GCPManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-control-plane", Namespace: "default"},
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				Project:        "test-project",
+				Location:       "us-central1",
+				ClusterNetwork: &infrav1exp.ClusterNetwork{EnableNetworkPolicy: ptr.To(false)},
+				Addons:         &infrav1exp.ClusterAddons{NetworkPolicy: ptr.To(false)},
+			},
+			ClusterName: "test-cluster",
+		},
+	}
+	clusterManager := &fakeClusterManager{}
+	s := newRotationTestService(t, controlPlane, clusterManager)
+	log := ctrl.Log.WithName("test")
+
+	// GKE rejects disabling the add-on while enforcement is enabled, so enforcement is disabled first.
+	cluster := &containerpb.Cluster{
+		NetworkPolicy: &containerpb.NetworkPolicy{Provider: containerpb.NetworkPolicy_CALICO, Enabled: true},
+		AddonsConfig:  &containerpb.AddonsConfig{NetworkPolicyConfig: &containerpb.NetworkPolicyConfig{Disabled: false}},
+	}
+	updating, err := s.reconcileClusterUpdates(context.Background(), cluster, &log)
+	if err != nil {
+		t.Fatalf("reconcileClusterUpdates() error = %v", err)
+	}
+	if !updating {
+		t.Fatalf("reconcileClusterUpdates() = false, want an update")
+	}
+	if diff := cmp.Diff([]string{"SetNetworkPolicy " + clusterName}, clusterManager.requests); diff != "" {
+		t.Fatalf("requests mismatch (-want +got):\n%s", diff)
+	}
+
+	// Once enforcement is disabled, the add-on is disabled with a cluster update.
+	clusterManager.requests = nil
+	cluster.NetworkPolicy.Enabled = false
+	if _, err := s.reconcileClusterUpdates(context.Background(), cluster, &log); err != nil {
+		t.Fatalf("reconcileClusterUpdates() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"UpdateCluster " + clusterName}, clusterManager.requests); diff != "" {
+		t.Errorf("requests mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCompareClusterAutoscaling(t *testing.T) {
+	existing := &containerpb.ClusterAutoscaling{
+		EnableNodeAutoprovisioning: true,
+		AutoscalingProfile:         containerpb.ClusterAutoscaling_BALANCED,
+		ResourceLimits: []*containerpb.ResourceLimit{
+			{ResourceType: "memory", Minimum: 4, Maximum: 256},
+			{ResourceType: "cpu", Minimum: 1, Maximum: 64},
+		},
+		AutoprovisioningNodePoolDefaults: &containerpb.AutoprovisioningNodePoolDefaults{
+			ServiceAccount: "default",
+			OauthScopes:    []string{"https://www.googleapis.com/auth/cloud-platform"},
+			ImageType:      "COS_CONTAINERD",
+		},
+		AutoprovisioningLocations: []string{"us-central1-a", "us-central1-b"},
+	}
+
+	tests := []struct {
+		name     string
+		desired  *infrav1exp.ClusterAutoscaling
+		expected bool
+	}{
+		{
+			name: "equal when only the resource limits are specified",
+			desired: &infrav1exp.ClusterAutoscaling{
+				EnableNodeAutoprovisioning: true,
+				ResourceLimits: []infrav1exp.ResourceLimit{
+					{ResourceType: "cpu", Minimum: 1, Maximum: 64},
+					{ResourceType: "memory", Minimum: 4, Maximum: 256},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "not equal when a resource limit differs",
+			desired: &infrav1exp.ClusterAutoscaling{
+				EnableNodeAutoprovisioning: true,
+				ResourceLimits: []infrav1exp.ResourceLimit{
+					{ResourceType: "cpu", Minimum: 1, Maximum: 128},
+					{ResourceType: "memory", Minimum: 4, Maximum: 256},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "not equal when a GPU resource limit is added",
+			desired: &infrav1exp.ClusterAutoscaling{
+				EnableNodeAutoprovisioning: true,
+				ResourceLimits: []infrav1exp.ResourceLimit{
+					{ResourceType: "cpu", Minimum: 1, Maximum: 64},
+					{ResourceType: "memory", Minimum: 4, Maximum: 256},
+					{ResourceType: "nvidia-tesla-t4", Maximum: 4},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "not equal when the autoscaling profile differs",
+			desired: &infrav1exp.ClusterAutoscaling{
+				EnableNodeAutoprovisioning: true,
+				ResourceLimits: []infrav1exp.ResourceLimit{
+					{ResourceType: "cpu", Minimum: 1, Maximum: 64},
+					{ResourceType: "memory", Minimum: 4, Maximum: 256},
+				},
+				AutoscalingProfile: ptr.To(infrav1exp.AutoscalingProfileOptimizeUtilization),
+			},
+			expected: false,
+		},
+		{
+			name: "not equal when the node pool defaults differ",
+			desired: &infrav1exp.ClusterAutoscaling{
+				EnableNodeAutoprovisioning: true,
+				ResourceLimits: []infrav1exp.ResourceLimit{
+					{ResourceType: "cpu", Minimum: 1, Maximum: 64},
+					{ResourceType: "memory", Minimum: 4, Maximum: 256},
+				},
+				AutoprovisioningNodePoolDefaults: &infrav1exp.AutoprovisioningNodePoolDefaults{
+					ServiceAccount: "nodes@test-project.iam.gserviceaccount.com",
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "not equal when node auto-provisioning is disabled",
+			desired: &infrav1exp.ClusterAutoscaling{
+				AutoscalingProfile: ptr.To(infrav1exp.AutoscalingProfileBalanced),
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareClusterAutoscaling(convertToSdkClusterAutoscaling(tt.desired), existing); got != tt.expected {
+				t.Errorf("compareClusterAutoscaling() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompareDatabaseEncryption(t *testing.T) {
+	keyName := "projects/test-project/locations/us-central1/keyRings/gke/cryptoKeys/secrets"
+	tests := []struct {
+		name     string
+		desired  *infrav1exp.DatabaseEncryption
+		existing *containerpb.DatabaseEncryption
+		expected bool
+	}{
+		{
+			name:     "equal when encrypted with the same key",
+			desired:  &infrav1exp.DatabaseEncryption{State: infrav1exp.DatabaseEncryptionStateEncrypted, KeyName: keyName},
+			existing: &containerpb.DatabaseEncryption{State: containerpb.DatabaseEncryption_ENCRYPTED, KeyName: keyName},
+			expected: true,
+		},
+		{
+			name:     "not equal when the key differs",
+			desired:  &infrav1exp.DatabaseEncryption{State: infrav1exp.DatabaseEncryptionStateEncrypted, KeyName: keyName + "-v2"},
+			existing: &containerpb.DatabaseEncryption{State: containerpb.DatabaseEncryption_ENCRYPTED, KeyName: keyName},
+			expected: false,
+		},
+		{
+			name:     "not equal when encryption is enabled on a decrypted cluster",
+			desired:  &infrav1exp.DatabaseEncryption{KeyName: keyName},
+			existing: &containerpb.DatabaseEncryption{State: containerpb.DatabaseEncryption_DECRYPTED},
+			expected: false,
+		},
+		{
+			name:     "not equal when encryption is disabled",
+			desired:  &infrav1exp.DatabaseEncryption{State: infrav1exp.DatabaseEncryptionStateDecrypted},
+			existing: &containerpb.DatabaseEncryption{State: containerpb.DatabaseEncryption_ENCRYPTED, KeyName: keyName},
+			expected: false,
+		},
+		{
+			name:     "equal when decrypted and GKE keeps the previous key",
+			desired:  &infrav1exp.DatabaseEncryption{State: infrav1exp.DatabaseEncryptionStateDecrypted},
+			existing: &containerpb.DatabaseEncryption{State: containerpb.DatabaseEncryption_DECRYPTED, KeyName: keyName},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareDatabaseEncryption(convertToSdkDatabaseEncryption(tt.desired), tt.existing); got != tt.expected {
+				t.Errorf("compareDatabaseEncryption() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertFromSdkDatabaseEncryption(t *testing.T) {
+	keyName := "projects/test-project/locations/us-central1/keyRings/gke/cryptoKeys/secrets"
+	tests := []struct {
+		name     string
+		input    *containerpb.DatabaseEncryption
+		expected *infrav1exp.DatabaseEncryptionStatus
+	}{
+		{
+			name:     "nil encryption",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "current state is reported",
+			input: &containerpb.DatabaseEncryption{
+				State:        containerpb.DatabaseEncryption_ENCRYPTED,
+				KeyName:      keyName,
+				CurrentState: containerpb.DatabaseEncryption_CURRENT_STATE_ENCRYPTION_PENDING.Enum(),
+			},
+			expected: &infrav1exp.DatabaseEncryptionStatus{
+				State:   "encryption_pending",
+				KeyName: keyName,
+			},
+		},
+		{
+			name: "state is used without a current state",
+			input: &containerpb.DatabaseEncryption{
+				State: containerpb.DatabaseEncryption_DECRYPTED,
+			},
+			expected: &infrav1exp.DatabaseEncryptionStatus{
+				State: "decrypted",
+			},
+		},
+		{
+			name: "last operation error is reported",
+			input: &containerpb.DatabaseEncryption{
+				State:        containerpb.DatabaseEncryption_ENCRYPTED,
+				KeyName:      keyName,
+				CurrentState: containerpb.DatabaseEncryption_CURRENT_STATE_ENCRYPTION_ERROR.Enum(),
+				LastOperationErrors: []*containerpb.DatabaseEncryption_OperationError{
+					{KeyName: keyName, ErrorMessage: "permission denied on key"},
+				},
+			},
+			expected: &infrav1exp.DatabaseEncryptionStatus{
+				State:              "encryption_error",
+				KeyName:            keyName,
+				LastOperationError: "permission denied on key",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertFromSdkDatabaseEncryption(tt.input); !cmp.Equal(got, tt.expected) {
+				t.Errorf("convertFromSdkDatabaseEncryption() mismatch (-got +want):\n%s", cmp.Diff(got, tt.expected))
+			}
+		})
+	}
+}
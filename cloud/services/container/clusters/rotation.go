@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+// reconcileCredentialRotation rotates the CA and the control plane IP of the cluster when the rotate-credentials
+// annotation requests it. The rotation is started, then the nodes are recreated to use the new credentials, and the
+// old credentials are removed once the kubeconfig secrets use the new endpoint and CA. Each phase waits for the
+// cluster to be running again. It returns whether a rotation is in progress.
+func (s *Service) reconcileCredentialRotation(ctx context.Context, log *logr.Logger) (bool, error) {
+	rotation := s.scope.GCPManagedControlPlane.Status.CredentialRotation
+	if rotation == nil || rotation.Phase == infrav1exp.CredentialRotationCompleted {
+		rotationID := s.scope.GCPManagedControlPlane.GetAnnotations()[infrav1exp.RotateCredentialsAnnotation]
+		if rotationID == "" || (rotation != nil && rotation.ID == rotationID) {
+			return false, nil
+		}
+		return true, s.startCredentialRotation(ctx, rotationID, log)
+	}
+
+	switch rotation.Phase {
+	case infrav1exp.CredentialRotationStarting:
+		log.Info("Credential rotation started, recreating nodes", "id", rotation.ID)
+		rotation.Phase = infrav1exp.CredentialRotationRecreatingNodes
+		fallthrough
+	case infrav1exp.CredentialRotationRecreatingNodes:
+		recreating, err := s.recreateNodes(ctx, rotation, log)
+		if err != nil {
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCredentialsRotatedCondition, infrav1exp.GKEControlPlaneRotationFailedReason, clusterv1beta1.ConditionSeverityError, "recreating nodes: %v", err)
+			return true, err
+		}
+		if recreating {
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCredentialsRotatedCondition, infrav1exp.GKEControlPlaneRotationRecreatingNodesReason, clusterv1beta1.ConditionSeverityInfo, "")
+			return true, nil
+		}
+
+		// The kubeconfig secrets were refreshed with the new endpoint and CA earlier in the reconciliation.
+		log.Info("Completing credential rotation", "id", rotation.ID)
+		_, err = s.scope.ManagedControlPlaneClient().CompleteIPRotation(ctx, &containerpb.CompleteIPRotationRequest{
+			Name: s.scope.ClusterFullName(),
+		})
+		if err != nil {
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCredentialsRotatedCondition, infrav1exp.GKEControlPlaneRotationFailedReason, clusterv1beta1.ConditionSeverityError, "completing rotation: %v", err)
+			return true, fmt.Errorf("completing credential rotation: %w", err)
+		}
+		rotation.Phase = infrav1exp.CredentialRotationCompleting
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCredentialsRotatedCondition, infrav1exp.GKEControlPlaneRotationCompletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return true, nil
+	case infrav1exp.CredentialRotationCompleting:
+		log.Info("Credential rotation completed", "id", rotation.ID)
+		rotation.Phase = infrav1exp.CredentialRotationCompleted
+		rotation.CompletionTime = ptr.To(metav1.Now())
+		v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCredentialsRotatedCondition)
+	}
+
+	return false, nil
+}
+
+func (s *Service) startCredentialRotation(ctx context.Context, rotationID string, log *logr.Logger) error {
+	log.Info("Starting credential rotation", "id", rotationID)
+	_, err := s.scope.ManagedControlPlaneClient().StartIPRotation(ctx, &containerpb.StartIPRotationRequest{
+		Name:              s.scope.ClusterFullName(),
+		RotateCredentials: true,
+	})
+	if err != nil {
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCredentialsRotatedCondition, infrav1exp.GKEControlPlaneRotationFailedReason, clusterv1beta1.ConditionSeverityError, "starting rotation: %v", err)
+		return fmt.Errorf("starting credential rotation: %w", err)
+	}
+
+	s.scope.GCPManagedControlPlane.Status.CredentialRotation = &infrav1exp.CredentialRotationStatus{
+		ID:        rotationID,
+		Phase:     infrav1exp.CredentialRotationStarting,
+		StartTime: ptr.To(metav1.Now()),
+	}
+	v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCredentialsRotatedCondition, infrav1exp.GKEControlPlaneRotationStartingReason, clusterv1beta1.ConditionSeverityInfo, "")
+
+	return nil
+}
+
+// recreateNodes recreates the nodes of the node pools one node pool at a time, by updating them to their current
+// version. It returns whether node pools are still being recreated.
+func (s *Service) recreateNodes(ctx context.Context, rotation *infrav1exp.CredentialRotationStatus, log *logr.Logger) (bool, error) {
+	// GKE recreates the nodes of Autopilot clusters itself.
+	if s.scope.IsAutopilotCluster() {
+		return false, nil
+	}
+
+	nodePools, err := s.scope.ManagedControlPlaneClient().ListNodePools(ctx, &containerpb.ListNodePoolsRequest{
+		Parent: s.scope.ClusterFullName(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("listing node pools: %w", err)
+	}
+	nodePool, busy, err := nodePoolToRecreate(nodePools.GetNodePools(), rotation.RecreatedNodePools)
+	if err != nil {
+		return false, err
+	}
+	if busy {
+		log.V(2).Info("Waiting for node pools to be recreated")
+		return true, nil
+	}
+	if nodePool == nil {
+		return false, nil
+	}
+
+	log.Info("Recreating nodes of node pool", "nodePool", nodePool.GetName())
+	_, err = s.scope.ManagedControlPlaneClient().UpdateNodePool(ctx, &containerpb.UpdateNodePoolRequest{
+		Name:        s.scope.ClusterFullName() + "/nodePools/" + nodePool.GetName(),
+		NodeVersion: nodePool.GetVersion(),
+		ImageType:   nodePool.GetConfig().GetImageType(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("recreating nodes of node pool %s: %w", nodePool.GetName(), err)
+	}
+	rotation.RecreatedNodePools = append(rotation.RecreatedNodePools, nodePool.GetName())
+
+	return true, nil
+}
+
+// nodePoolToRecreate returns the next node pool whose nodes have to be recreated, and whether a node pool is still
+// being changed, in which case the recreation waits for it. It returns an error when a node pool is in error, as its
+// nodes can't be recreated and would keep using the old credentials, which the completion of the rotation removes.
+func nodePoolToRecreate(nodePools []*containerpb.NodePool, recreated []string) (*containerpb.NodePool, bool, error) {
+	var next *containerpb.NodePool
+	for _, nodePool := range nodePools {
+		switch nodePool.GetStatus() {
+		case containerpb.NodePool_PROVISIONING, containerpb.NodePool_RECONCILING, containerpb.NodePool_STOPPING:
+			return nil, true, nil
+		case containerpb.NodePool_ERROR:
+			return nil, false, fmt.Errorf("node pool %s is in error, its nodes can't be recreated", nodePool.GetName())
+		case containerpb.NodePool_RUNNING, containerpb.NodePool_RUNNING_WITH_ERROR:
+			if next == nil && !slices.Contains(recreated, nodePool.GetName()) {
+				next = nodePool
+			}
+		}
+	}
+
+	return next, false, nil
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	container "cloud.google.com/go/container/apiv1"
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+func TestNodePoolToRecreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		nodePools    []*containerpb.NodePool
+		recreated    []string
+		expected     string
+		expectedBusy bool
+		expectError  bool
+	}{
+		{
+			name: "first node pool not recreated yet",
+			nodePools: []*containerpb.NodePool{
+				{Name: "pool-1", Status: containerpb.NodePool_RUNNING},
+				{Name: "pool-2", Status: containerpb.NodePool_RUNNING},
+			},
+			recreated: []string{"pool-1"},
+			expected:  "pool-2",
+		},
+		{
+			name: "wait for a node pool being recreated",
+			nodePools: []*containerpb.NodePool{
+				{Name: "pool-1", Status: containerpb.NodePool_RECONCILING},
+				{Name: "pool-2", Status: containerpb.NodePool_RUNNING},
+			},
+			recreated:    []string{"pool-1"},
+			expectedBusy: true,
+		},
+		{
+			name: "all node pools recreated",
+			nodePools: []*containerpb.NodePool{
+				{Name: "pool-1", Status: containerpb.NodePool_RUNNING},
+				{Name: "pool-2", Status: containerpb.NodePool_RUNNING_WITH_ERROR},
+			},
+			recreated: []string{"pool-1", "pool-2"},
+		},
+		{
+			name: "fail on node pools in error",
+			nodePools: []*containerpb.NodePool{
+				{Name: "pool-1", Status: containerpb.NodePool_ERROR},
+				{Name: "pool-2", Status: containerpb.NodePool_RUNNING},
+			},
+			recreated:   []string{"pool-2"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodePool, busy, err := nodePoolToRecreate(tt.nodePools, tt.recreated)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("nodePoolToRecreate() error = %v, expectError %v", err, tt.expectError)
+			}
+			if nodePool.GetName() != tt.expected || busy != tt.expectedBusy {
+				t.Errorf("nodePoolToRecreate() = %q, %v, want %q, %v", nodePool.GetName(), busy, tt.expected, tt.expectedBusy)
+			}
+		})
+	}
+}
+
+func TestReconcileCredentialRotation(t *testing.T) {
+	tests := []struct {
+		name             string
+		annotation       string
+		rotation         *infrav1exp.CredentialRotationStatus
+		expectedRotating bool
+		expectedPhase    infrav1exp.CredentialRotationPhase
+	}{
+		{
+			name: "no rotation requested",
+		},
+		{
+			name:          "rotation already completed",
+			annotation:    "2026-01-01",
+			rotation:      &infrav1exp.CredentialRotationStatus{ID: "2026-01-01", Phase: infrav1exp.CredentialRotationCompleted},
+			expectedPhase: infrav1exp.CredentialRotationCompleted,
+		},
+		{
+			name:          "rotation completing",
+			annotation:    "2026-01-01",
+			rotation:      &infrav1exp.CredentialRotationStatus{ID: "2026-01-01", Phase: infrav1exp.CredentialRotationCompleting},
+			expectedPhase: infrav1exp.CredentialRotationCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controlPlane := &infrav1exp.GCPManagedControlPlane{
+				Status: infrav1exp.GCPManagedControlPlaneStatus{CredentialRotation: tt.rotation},
+			}
+			if tt.annotation != "" {
+				controlPlane.Annotations = map[string]string{infrav1exp.RotateCredentialsAnnotation: tt.annotation}
+			}
+			log := logr.Discard()
+
+			rotating, err := newTestService(controlPlane).reconcileCredentialRotation(context.TODO(), &log)
+			if err != nil {
+				t.Fatalf("reconcileCredentialRotation() error = %v", err)
+			}
+			if rotating != tt.expectedRotating {
+				t.Errorf("reconcileCredentialRotation() = %v, want %v", rotating, tt.expectedRotating)
+			}
+			if tt.expectedPhase == "" {
+				if controlPlane.Status.CredentialRotation != nil {
+					t.Errorf("credential rotation = %+v, want none", controlPlane.Status.CredentialRotation)
+				}
+				return
+			}
+			if got := controlPlane.Status.CredentialRotation.Phase; got != tt.expectedPhase {
+				t.Errorf("credential rotation phase = %q, want %q", got, tt.expectedPhase)
+			}
+		})
+	}
+
+	t.Run("rotation completed sets the condition", func(t *testing.T) {
+		controlPlane := &infrav1exp.GCPManagedControlPlane{
+			Status: infrav1exp.GCPManagedControlPlaneStatus{
+				CredentialRotation: &infrav1exp.CredentialRotationStatus{ID: "1", Phase: infrav1exp.CredentialRotationCompleting},
+			},
+		}
+		log := logr.Discard()
+		if _, err := newTestService(controlPlane).reconcileCredentialRotation(context.TODO(), &log); err != nil {
+			t.Fatalf("reconcileCredentialRotation() error = %v", err)
+		}
+		if !v1beta1conditions.IsTrue(controlPlane, infrav1exp.GKEControlPlaneCredentialsRotatedCondition) {
+			t.Errorf("condition %s should be true", infrav1exp.GKEControlPlaneCredentialsRotatedCondition)
+		}
+		if controlPlane.Status.CredentialRotation.CompletionTime == nil {
+			t.Errorf("completion time should be set")
+		}
+	})
+}
+
+// fakeClusterManager serves the GKE API calls of the credential rotation and of cluster updates, and records them.
+type fakeClusterManager struct {
+	containerpb.UnimplementedClusterManagerServer
+	nodePools []*containerpb.NodePool
+	requests  []string
+}
+
+func (f *fakeClusterManager) StartIPRotation(_ context.Context, req *containerpb.StartIPRotationRequest) (*containerpb.Operation, error) {
+	f.requests = append(f.requests, "StartIPRotation "+req.GetName())
+	return &containerpb.Operation{}, nil
+}
+
+func (f *fakeClusterManager) CompleteIPRotation(_ context.Context, req *containerpb.CompleteIPRotationRequest) (*containerpb.Operation, error) {
+	f.requests = append(f.requests, "CompleteIPRotation "+req.GetName())
+	return &containerpb.Operation{}, nil
+}
+
+func (f *fakeClusterManager) UpdateCluster(_ context.Context, req *containerpb.UpdateClusterRequest) (*containerpb.Operation, error) {
+	f.requests = append(f.requests, "UpdateCluster "+req.GetName())
+	return &containerpb.Operation{}, nil
+}
+
+func (f *fakeClusterManager) SetNetworkPolicy(_ context.Context, req *containerpb.SetNetworkPolicyRequest) (*containerpb.Operation, error) {
+	f.requests = append(f.requests, "SetNetworkPolicy "+req.GetName())
+	return &containerpb.Operation{}, nil
+}
+
+func (f *fakeClusterManager) ListNodePools(_ context.Context, _ *containerpb.ListNodePoolsRequest) (*containerpb.ListNodePoolsResponse, error) {
+	return &containerpb.ListNodePoolsResponse{NodePools: f.nodePools}, nil
+}
+
+func (f *fakeClusterManager) UpdateNodePool(_ context.Context, req *containerpb.UpdateNodePoolRequest) (*containerpb.Operation, error) {
+	f.requests = append(f.requests, "UpdateNodePool "+req.GetName()+" "+req.GetNodeVersion())
+	return &containerpb.Operation{}, nil
+}
+
+// newRotationTestService returns a service whose GKE client is served by the fake.
+func newRotationTestService(t *testing.T, controlPlane *infrav1exp.GCPManagedControlPlane, clusterManager *fakeClusterManager) *Service {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	containerpb.RegisterClusterManagerServer(server, clusterManager)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	managedClusterClient, err := container.NewClusterManagerClient(context.Background(),
+		option.WithEndpoint(listener.Addr().String()),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return newScopedTestService(t, controlPlane, managedClusterClient, nil)
+}
+
+func TestReconcileCredentialRotationPhases(t *testing.T) {
+	const clusterName = "projects/test-project/locations/us-central1/clusters/test-cluster"
+
+	tests := []struct {
+		name              string
+		autopilot         bool
+		rotation          *infrav1exp.CredentialRotationStatus
+		nodePools         []*containerpb.NodePool
+		expectError       bool
+		expectedRequests  []string
+		expectedPhase     infrav1exp.CredentialRotationPhase
+		expectedRecreated []string
+		expectedReason    string
+	}{
+		{
+			name:             "start the rotation",
+			expectedRequests: []string{"StartIPRotation " + clusterName},
+			expectedPhase:    infrav1exp.CredentialRotationStarting,
+			expectedReason:   infrav1exp.GKEControlPlaneRotationStartingReason,
+		},
+		{
+			name:     "recreate the nodes of the first node pool",
+			rotation: &infrav1exp.CredentialRotationStatus{ID: "2", Phase: infrav1exp.CredentialRotationStarting},
+			nodePools: []*containerpb.NodePool{
+				{Name: "pool-1", Version: "1.31.4-gke.1183000", Status: containerpb.NodePool_RUNNING},
+				{Name: "pool-2", Version: "1.31.4-gke.1183000", Status: containerpb.NodePool_RUNNING},
+			},
+			expectedRequests:  []string{"UpdateNodePool " + clusterName + "/nodePools/pool-1 1.31.4-gke.1183000"},
+			expectedPhase:     infrav1exp.CredentialRotationRecreatingNodes,
+			expectedRecreated: []string{"pool-1"},
+			expectedReason:    infrav1exp.GKEControlPlaneRotationRecreatingNodesReason,
+		},
+		{
+			name:     "wait for the node pool being recreated",
+			rotation: &infrav1exp.CredentialRotationStatus{ID: "2", Phase: infrav1exp.CredentialRotationRecreatingNodes, RecreatedNodePools: []string{"pool-1"}},
+			nodePools: []*containerpb.NodePool{
+				{Name: "pool-1", Status: containerpb.NodePool_RECONCILING},
+				{Name: "pool-2", Status: containerpb.NodePool_RUNNING},
+			},
+			expectedPhase:     infrav1exp.CredentialRotationRecreatingNodes,
+			expectedRecreated: []string{"pool-1"},
+			expectedReason:    infrav1exp.GKEControlPlaneRotationRecreatingNodesReason,
+		},
+		{
+			name:     "complete the rotation once all the nodes are recreated",
+			rotation: &infrav1exp.CredentialRotationStatus{ID: "2", Phase: infrav1exp.CredentialRotationRecreatingNodes, RecreatedNodePools: []string{"pool-1", "pool-2"}},
+			nodePools: []*containerpb.NodePool{
+				{Name: "pool-1", Status: containerpb.NodePool_RUNNING},
+				{Name: "pool-2", Status: containerpb.NodePool_RUNNING},
+			},
+			expectedRequests:  []string{"CompleteIPRotation " + clusterName},
+			expectedPhase:     infrav1exp.CredentialRotationCompleting,
+			expectedRecreated: []string{"pool-1", "pool-2"},
+			expectedReason:    infrav1exp.GKEControlPlaneRotationCompletingReason,
+		},
+		{
+			name:             "complete the rotation of an autopilot cluster",
+			autopilot:        true,
+			rotation:         &infrav1exp.CredentialRotationStatus{ID: "2", Phase: infrav1exp.CredentialRotationStarting},
+			expectedRequests: []string{"CompleteIPRotation " + clusterName},
+			expectedPhase:    infrav1exp.CredentialRotationCompleting,
+			expectedReason:   infrav1exp.GKEControlPlaneRotationCompletingReason,
+		},
+		{
+			name:     "fail the rotation on a node pool in error",
+			rotation: &infrav1exp.CredentialRotationStatus{ID: "2", Phase: infrav1exp.CredentialRotationRecreatingNodes, RecreatedNodePools: []string{"pool-1"}},
+			nodePools: []*containerpb.NodePool{
+				{Name: "pool-1", Status: containerpb.NodePool_RUNNING},
+				{Name: "pool-2", Status: containerpb.NodePool_ERROR},
+			},
+			expectError:       true,
+			expectedPhase:     infrav1exp.CredentialRotationRecreatingNodes,
+			expectedRecreated: []string{"pool-1"},
+			expectedReason:    infrav1exp.GKEControlPlaneRotationFailedReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controlPlane := &infrav1exp.GCPManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-control-plane",
+					Namespace:   "default",
+					Annotations: map[string]string{infrav1exp.RotateCredentialsAnnotation: "2"},
+				},
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:         "test-project",
+						Location:        "us-central1",
+						EnableAutopilot: tt.autopilot,
+					},
+					ClusterName: "test-cluster",
+				},
+				Status: infrav1exp.GCPManagedControlPlaneStatus{CredentialRotation: tt.rotation},
+			}
+			clusterManager := &fakeClusterManager{nodePools: tt.nodePools}
+			s := newRotationTestService(t, controlPlane, clusterManager)
+			log := logr.Discard()
+
+			rotating, err := s.reconcileCredentialRotation(context.Background(), &log)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("reconcileCredentialRotation() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !rotating {
+				t.Errorf("reconcileCredentialRotation() = false, want a rotation in progress")
+			}
+			if !cmp.Equal(clusterManager.requests, tt.expectedRequests) {
+				t.Errorf("GKE requests mismatch (-got +want):\n%s", cmp.Diff(clusterManager.requests, tt.expectedRequests))
+			}
+			rotation := controlPlane.Status.CredentialRotation
+			if rotation.Phase != tt.expectedPhase {
+				t.Errorf("credential rotation phase = %q, want %q", rotation.Phase, tt.expectedPhase)
+			}
+			if !cmp.Equal(rotation.RecreatedNodePools, tt.expectedRecreated) {
+				t.Errorf("recreated node pools = %v, want %v", rotation.RecreatedNodePools, tt.expectedRecreated)
+			}
+			if reason := v1beta1conditions.GetReason(controlPlane, infrav1exp.GKEControlPlaneCredentialsRotatedCondition); reason != tt.expectedReason {
+				t.Errorf("condition reason = %q, want %q", reason, tt.expectedReason)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package clusters
 
 import (
+	"cloud.google.com/go/container/apiv1/containerpb"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
 )
@@ -24,6 +25,8 @@ import (
 // Service implements clusters reconciler.
 type Service struct {
 	scope *scope.ManagedControlPlaneScope
+	// serverConfig lists the versions available in the location of the cluster, it is loaded by Reconcile.
+	serverConfig *containerpb.ServerConfig
 }
 
 var _ cloud.ReconcilerWithResult = &Service{}
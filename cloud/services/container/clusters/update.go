@@ -0,0 +1,506 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+)
+
+// clusterUpdateFunc returns the update of a single setting of the cluster, or nil when the setting is up to date.
+type clusterUpdateFunc func(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate
+
+// clusterUpdateFuncs returns the functions comparing the mutable settings of the spec with the existing cluster, in
+// the order in which the updates are applied.
+func (s *Service) clusterUpdateFuncs() []clusterUpdateFunc {
+	return []clusterUpdateFunc{
+		s.releaseChannelUpdate,
+		s.masterVersionUpdate,
+		s.loggingServiceUpdate,
+		s.monitoringServiceUpdate,
+		s.addonsConfigUpdate,
+		s.clusterAutoscalingUpdate,
+		s.intraNodeVisibilityUpdate,
+		s.dnsConfigUpdate,
+		s.gatewayAPIConfigUpdate,
+		s.multiNetworkingUpdate,
+		s.defaultSnatStatusUpdate,
+		s.defaultEnablePrivateNodesUpdate,
+		s.databaseEncryptionUpdate,
+		s.binaryAuthorizationUpdate,
+		s.workloadIdentityConfigUpdate,
+		s.identityServiceConfigUpdate,
+		s.authenticatorGroupsConfigUpdate,
+		s.masterAuthorizedNetworksConfigUpdate,
+		s.publicEndpointUpdate,
+		s.controlPlaneGlobalAccessUpdate,
+		s.notificationConfigUpdate,
+	}
+}
+
+// checkDiffAndPrepareUpdate compares the spec with the existing cluster and prepares the update of the first setting
+// that differs. GKE only runs one update of a cluster at a time, so the settings are updated one per reconciliation.
+func (s *Service) checkDiffAndPrepareUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) (bool, *containerpb.UpdateClusterRequest) {
+	log.V(4).Info("Checking diff and preparing update.")
+
+	for _, updateFunc := range s.clusterUpdateFuncs() {
+		clusterUpdate := updateFunc(existingCluster, log)
+		if clusterUpdate == nil {
+			continue
+		}
+		updateClusterRequest := &containerpb.UpdateClusterRequest{
+			Name:   s.scope.ClusterFullName(),
+			Update: clusterUpdate,
+		}
+		log.V(4).Info("Update cluster request. ", "updateClusterRequest", updateClusterRequest)
+		return true, updateClusterRequest
+	}
+
+	return false, nil
+}
+
+func (s *Service) releaseChannelUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	desiredReleaseChannel := convertToSdkReleaseChannel(s.scope.GCPManagedControlPlane.Spec.ReleaseChannel)
+	if desiredReleaseChannel == existingCluster.GetReleaseChannel().GetChannel() {
+		return nil
+	}
+	log.V(2).Info("Release channel update required", "current", existingCluster.GetReleaseChannel().GetChannel(), "desired", desiredReleaseChannel)
+
+	return &containerpb.ClusterUpdate{
+		DesiredReleaseChannel: &containerpb.ReleaseChannel{
+			Channel: desiredReleaseChannel,
+		},
+	}
+}
+
+func (s *Service) masterVersionUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	// Invalid versions are reported by Reconcile and leave the cluster at its current version.
+	desiredMasterVersion, err := s.desiredMasterVersion(existingCluster)
+	if err != nil || desiredMasterVersion == "" {
+		return nil
+	}
+	existingClusterMasterVersion := existingCluster.GetCurrentMasterVersion()
+	if desiredMasterVersion == existingClusterMasterVersion {
+		return nil
+	}
+	log.V(2).Info("Master version update required", "current", existingClusterMasterVersion, "desired", desiredMasterVersion)
+
+	return &containerpb.ClusterUpdate{
+		DesiredMasterVersion: desiredMasterVersion,
+	}
+}
+
+func (s *Service) loggingServiceUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	specLoggingService := s.scope.GCPManagedControlPlane.Spec.LoggingService
+	if specLoggingService == nil || existingCluster.GetLoggingService() == specLoggingService.String() {
+		return nil
+	}
+	log.V(2).Info("LoggingService config update required", "current", existingCluster.GetLoggingService(), "desired", specLoggingService.String())
+
+	return &containerpb.ClusterUpdate{
+		DesiredLoggingService: specLoggingService.String(),
+	}
+}
+
+func (s *Service) monitoringServiceUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	specMonitoringService := s.scope.GCPManagedControlPlane.Spec.MonitoringService
+	if specMonitoringService == nil || existingCluster.GetMonitoringService() == specMonitoringService.String() {
+		return nil
+	}
+	log.V(2).Info("MonitoringService config update required", "current", existingCluster.GetMonitoringService(), "desired", specMonitoringService.String())
+
+	return &containerpb.ClusterUpdate{
+		DesiredMonitoringService: specMonitoringService.String(),
+	}
+}
+
+func (s *Service) addonsConfigUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	desiredAddonsConfig := convertToSdkAddonsConfig(s.scope.GCPManagedControlPlane.Spec.Addons)
+	// Network policy enforcement requires the network policy add-on, which is enabled before the network policy is set.
+	if cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork; cn != nil && ptr.Deref(cn.EnableNetworkPolicy, false) && desiredAddonsConfig.GetNetworkPolicyConfig() == nil {
+		if desiredAddonsConfig == nil {
+			desiredAddonsConfig = &containerpb.AddonsConfig{}
+		}
+		desiredAddonsConfig.NetworkPolicyConfig = &containerpb.NetworkPolicyConfig{Disabled: false}
+	}
+	if desiredAddonsConfig == nil || compareAddonsConfig(desiredAddonsConfig, existingCluster.GetAddonsConfig()) {
+		return nil
+	}
+	log.V(2).Info("Addons config update required", "current", existingCluster.GetAddonsConfig(), "desired", desiredAddonsConfig)
+
+	return &containerpb.ClusterUpdate{
+		DesiredAddonsConfig: desiredAddonsConfig,
+	}
+}
+
+func (s *Service) clusterAutoscalingUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	if s.scope.IsAutopilotCluster() {
+		return nil
+	}
+	desiredAutoscaling := convertToSdkClusterAutoscaling(s.scope.GCPManagedControlPlane.Spec.ClusterAutoscaling)
+	if desiredAutoscaling == nil || compareClusterAutoscaling(desiredAutoscaling, existingCluster.GetAutoscaling()) {
+		return nil
+	}
+	log.V(2).Info("Cluster autoscaling update required", "current", existingCluster.GetAutoscaling(), "desired", desiredAutoscaling)
+
+	return &containerpb.ClusterUpdate{
+		DesiredClusterAutoscaling: desiredAutoscaling,
+	}
+}
+
+func (s *Service) intraNodeVisibilityUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+	if cn == nil || cn.EnableIntraNodeVisibility == nil || *cn.EnableIntraNodeVisibility == existingCluster.GetNetworkConfig().GetEnableIntraNodeVisibility() {
+		return nil
+	}
+	log.V(2).Info("Intranode visibility update required", "current", existingCluster.GetNetworkConfig().GetEnableIntraNodeVisibility(), "desired", *cn.EnableIntraNodeVisibility)
+
+	return &containerpb.ClusterUpdate{
+		DesiredIntraNodeVisibilityConfig: &containerpb.IntraNodeVisibilityConfig{
+			Enabled: *cn.EnableIntraNodeVisibility,
+		},
+	}
+}
+
+func (s *Service) dnsConfigUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+	if cn == nil {
+		return nil
+	}
+	desiredDNSConfig := convertToSdkDNSConfig(cn.DNS)
+	if desiredDNSConfig == nil || compareDNSConfig(desiredDNSConfig, existingCluster.GetNetworkConfig().GetDnsConfig()) {
+		return nil
+	}
+	log.V(2).Info("DNS config update required", "current", existingCluster.GetNetworkConfig().GetDnsConfig(), "desired", desiredDNSConfig)
+
+	return &containerpb.ClusterUpdate{
+		DesiredDnsConfig: desiredDNSConfig,
+	}
+}
+
+func (s *Service) gatewayAPIConfigUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+	if cn == nil || cn.GatewayAPIChannel == nil {
+		return nil
+	}
+	desiredChannel := convertToSdkGatewayAPIChannel(*cn.GatewayAPIChannel)
+	existingChannel := existingCluster.GetNetworkConfig().GetGatewayApiConfig().GetChannel()
+	// A cluster without a Gateway API config has the Gateway API disabled.
+	if existingChannel == containerpb.GatewayAPIConfig_CHANNEL_UNSPECIFIED {
+		existingChannel = containerpb.GatewayAPIConfig_CHANNEL_DISABLED
+	}
+	if desiredChannel == existingChannel {
+		return nil
+	}
+	log.V(2).Info("Gateway API config update required", "current", existingChannel, "desired", desiredChannel)
+
+	return &containerpb.ClusterUpdate{
+		DesiredGatewayApiConfig: &containerpb.GatewayAPIConfig{
+			Channel: desiredChannel,
+		},
+	}
+}
+
+func (s *Service) multiNetworkingUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+	if cn == nil || cn.EnableMultiNetworking == nil || *cn.EnableMultiNetworking == existingCluster.GetNetworkConfig().GetEnableMultiNetworking() {
+		return nil
+	}
+	log.V(2).Info("Multi-networking update required", "current", existingCluster.GetNetworkConfig().GetEnableMultiNetworking(), "desired", *cn.EnableMultiNetworking)
+
+	return &containerpb.ClusterUpdate{
+		DesiredEnableMultiNetworking: cn.EnableMultiNetworking,
+	}
+}
+
+func (s *Service) defaultSnatStatusUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+	if cn == nil || cn.PrivateCluster == nil {
+		return nil
+	}
+	existingDisabled := existingCluster.GetNetworkConfig().GetDefaultSnatStatus().GetDisabled()
+	if cn.PrivateCluster.DisableDefaultSNAT == existingDisabled {
+		return nil
+	}
+	log.V(2).Info("Default SNAT update required", "currentDisabled", existingDisabled, "desiredDisabled", cn.PrivateCluster.DisableDefaultSNAT)
+
+	return &containerpb.ClusterUpdate{
+		DesiredDefaultSnatStatus: &containerpb.DefaultSnatStatus{
+			Disabled: cn.PrivateCluster.DisableDefaultSNAT,
+		},
+	}
+}
+
+func (s *Service) defaultEnablePrivateNodesUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+	if cn == nil || cn.PrivateCluster == nil {
+		return nil
+	}
+	// Clusters created before the network config default was introduced only report it in the private cluster config.
+	existingPrivateNodes := existingCluster.GetPrivateClusterConfig().GetEnablePrivateNodes()
+	if networkConfig := existingCluster.GetNetworkConfig(); networkConfig != nil && networkConfig.DefaultEnablePrivateNodes != nil {
+		existingPrivateNodes = networkConfig.GetDefaultEnablePrivateNodes()
+	}
+	if cn.PrivateCluster.EnablePrivateNodes == existingPrivateNodes {
+		return nil
+	}
+	log.V(2).Info("Private nodes update required", "current", existingPrivateNodes, "desired", cn.PrivateCluster.EnablePrivateNodes)
+
+	return &containerpb.ClusterUpdate{
+		DesiredDefaultEnablePrivateNodes: ptr.To(cn.PrivateCluster.EnablePrivateNodes),
+	}
+}
+
+func (s *Service) databaseEncryptionUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cs := s.scope.GCPManagedControlPlane.Spec.ClusterSecurity
+	if cs == nil {
+		return nil
+	}
+	desiredDatabaseEncryption := convertToSdkDatabaseEncryption(cs.DatabaseEncryption)
+	if desiredDatabaseEncryption == nil || compareDatabaseEncryption(desiredDatabaseEncryption, existingCluster.GetDatabaseEncryption()) {
+		return nil
+	}
+	log.V(2).Info("Database encryption update required", "current", existingCluster.GetDatabaseEncryption(), "desired", desiredDatabaseEncryption)
+
+	return &containerpb.ClusterUpdate{
+		DesiredDatabaseEncryption: desiredDatabaseEncryption,
+	}
+}
+
+func (s *Service) binaryAuthorizationUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	if s.scope.GCPManagedControlPlane.Spec.BinaryAuthorization == nil {
+		return nil
+	}
+	desiredEvaluationMode := convertToSdkBinaryAuthorizationEvaluationMode(s.scope.GCPManagedControlPlane.Spec.BinaryAuthorization)
+	existingEvaluationMode := existingCluster.GetBinaryAuthorization().GetEvaluationMode()
+	// Clusters that never had Binary Authorization configured don't report an evaluation mode.
+	if existingEvaluationMode == containerpb.BinaryAuthorization_EVALUATION_MODE_UNSPECIFIED && !existingCluster.GetBinaryAuthorization().GetEnabled() {
+		existingEvaluationMode = containerpb.BinaryAuthorization_DISABLED
+	}
+	if desiredEvaluationMode == existingEvaluationMode {
+		return nil
+	}
+	log.V(2).Info("Binary authorization update required", "current", existingEvaluationMode, "desired", desiredEvaluationMode)
+
+	return &containerpb.ClusterUpdate{
+		DesiredBinaryAuthorization: &containerpb.BinaryAuthorization{
+			EvaluationMode: desiredEvaluationMode,
+		},
+	}
+}
+
+func (s *Service) workloadIdentityConfigUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cs := s.scope.GCPManagedControlPlane.Spec.ClusterSecurity
+	if cs == nil || cs.WorkloadIdentityConfig == nil || cs.WorkloadIdentityConfig.WorkloadPool == existingCluster.GetWorkloadIdentityConfig().GetWorkloadPool() {
+		return nil
+	}
+	log.V(2).Info("Workload identity update required", "current", existingCluster.GetWorkloadIdentityConfig().GetWorkloadPool(), "desired", cs.WorkloadIdentityConfig.WorkloadPool)
+
+	return &containerpb.ClusterUpdate{
+		DesiredWorkloadIdentityConfig: &containerpb.WorkloadIdentityConfig{
+			WorkloadPool: cs.WorkloadIdentityConfig.WorkloadPool,
+		},
+	}
+}
+
+func (s *Service) identityServiceConfigUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	if s.scope.GCPManagedControlPlane.Spec.EnableIdentityService == nil {
+		return nil
+	}
+	desiredEnabled := *s.scope.GCPManagedControlPlane.Spec.EnableIdentityService
+	if desiredEnabled == existingCluster.GetIdentityServiceConfig().GetEnabled() {
+		return nil
+	}
+	log.V(2).Info("Identity service update required", "current", existingCluster.GetIdentityServiceConfig().GetEnabled(), "desired", desiredEnabled)
+
+	return &containerpb.ClusterUpdate{
+		DesiredIdentityServiceConfig: &containerpb.IdentityServiceConfig{
+			Enabled: desiredEnabled,
+		},
+	}
+}
+
+func (s *Service) authenticatorGroupsConfigUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cs := s.scope.GCPManagedControlPlane.Spec.ClusterSecurity
+	if cs == nil || cs.AuthenticatorGroupConfig == nil {
+		return nil
+	}
+	existingConfig := existingCluster.GetAuthenticatorGroupsConfig()
+	if existingConfig.GetEnabled() && existingConfig.GetSecurityGroup() == cs.AuthenticatorGroupConfig.SecurityGroups {
+		return nil
+	}
+	log.V(2).Info("Authenticator groups update required", "current", existingConfig, "desired", cs.AuthenticatorGroupConfig.SecurityGroups)
+
+	return &containerpb.ClusterUpdate{
+		DesiredAuthenticatorGroupsConfig: &containerpb.AuthenticatorGroupsConfig{
+			Enabled:       true,
+			SecurityGroup: cs.AuthenticatorGroupConfig.SecurityGroups,
+		},
+	}
+}
+
+func (s *Service) masterAuthorizedNetworksConfigUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	// When desiredMasterAuthorizedNetworksConfig is nil, it means that the user wants to disable the feature.
+	desiredMasterAuthorizedNetworksConfig := convertToSdkMasterAuthorizedNetworksConfig(s.scope.GCPManagedControlPlane.Spec.MasterAuthorizedNetworksConfig)
+	existingAuthorizedNetworksConfig := existingCluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetAuthorizedNetworksConfig()
+	log.V(4).Info("Master authorized networks config update check", "current", existingAuthorizedNetworksConfig, "desired", desiredMasterAuthorizedNetworksConfig)
+	if compareMasterAuthorizedNetworksConfig(desiredMasterAuthorizedNetworksConfig, existingAuthorizedNetworksConfig) {
+		return nil
+	}
+	log.V(2).Info("Master authorized networks config update required", "current", existingAuthorizedNetworksConfig, "desired", desiredMasterAuthorizedNetworksConfig)
+
+	return &containerpb.ClusterUpdate{
+		DesiredControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+				AuthorizedNetworksConfig: desiredMasterAuthorizedNetworksConfig,
+			},
+		},
+	}
+}
+
+func (s *Service) publicEndpointUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+	if cn == nil || cn.PrivateCluster == nil {
+		return nil
+	}
+	desiredPublicEndpoint := !cn.PrivateCluster.EnablePrivateEndpoint
+	// The public endpoint is enabled unless the cluster reports otherwise.
+	existingPublicEndpoint := true
+	if ipEndpointsConfig := existingCluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig(); ipEndpointsConfig != nil && ipEndpointsConfig.EnablePublicEndpoint != nil {
+		existingPublicEndpoint = ipEndpointsConfig.GetEnablePublicEndpoint()
+	}
+	if desiredPublicEndpoint == existingPublicEndpoint {
+		return nil
+	}
+	log.V(2).Info("Public endpoint update required", "current", existingPublicEndpoint, "desired", desiredPublicEndpoint)
+
+	return &containerpb.ClusterUpdate{
+		DesiredControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+				EnablePublicEndpoint: &desiredPublicEndpoint,
+			},
+		},
+	}
+}
+
+func (s *Service) controlPlaneGlobalAccessUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) *containerpb.ClusterUpdate {
+	cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+	if cn == nil || cn.PrivateCluster == nil {
+		return nil
+	}
+	existingGlobalAccess := existingCluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetGlobalAccess()
+	if cn.PrivateCluster.ControlPlaneGlobalAccess == existingGlobalAccess {
+		return nil
+	}
+	log.V(2).Info("Control plane global access update required", "current", existingGlobalAccess, "desired", cn.PrivateCluster.ControlPlaneGlobalAccess)
+
+	return &containerpb.ClusterUpdate{
+		DesiredControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+				GlobalAccess: ptr.To(cn.PrivateCluster.ControlPlaneGlobalAccess),
+			},
+		},
+	}
+}
+
+// checkDiffAndPrepareResourceLabels compares the resource labels of the cluster with the desired ones. The resource
+// labels are not part of UpdateCluster and have to be set with a dedicated request. Only the labels set by CAPG, as
+// recorded in the status, are removed, the labels set by other tools are kept.
+func (s *Service) checkDiffAndPrepareResourceLabels(existingCluster *containerpb.Cluster, log *logr.Logger) (bool, *containerpb.SetLabelsRequest) {
+	existingResourceLabels := existingCluster.GetResourceLabels()
+	desiredResourceLabels := maps.Clone(existingResourceLabels)
+	if desiredResourceLabels == nil {
+		desiredResourceLabels = map[string]string{}
+	}
+	for _, key := range s.scope.GCPManagedControlPlane.Status.ManagedResourceLabels {
+		delete(desiredResourceLabels, key)
+	}
+	maps.Copy(desiredResourceLabels, s.scope.ClusterResourceLabels())
+	if cmp.Equal(desiredResourceLabels, existingResourceLabels, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+	log.V(2).Info("Resource labels update required", "current", existingResourceLabels, "desired", desiredResourceLabels)
+
+	// The label fingerprint of the existing cluster guards against overwriting concurrent changes.
+	return true, &containerpb.SetLabelsRequest{
+		Name:             s.scope.ClusterFullName(),
+		ResourceLabels:   desiredResourceLabels,
+		LabelFingerprint: existingCluster.GetLabelFingerprint(),
+	}
+}
+
+// managedResourceLabels returns the keys of the resource labels set by CAPG, to record in the status.
+func (s *Service) managedResourceLabels() []string {
+	return slices.Sorted(maps.Keys(s.scope.ClusterResourceLabels()))
+}
+
+// checkImmutableFields returns the fields of the spec that differ from the existing cluster but can't be updated.
+// Fields that are not specified, or that GKE defaults, are not compared.
+func (s *Service) checkImmutableFields(existingCluster *containerpb.Cluster) []string {
+	var changedFields []string
+
+	spec := s.scope.GCPManagedControlPlane.Spec
+	if spec.EnableAutopilot != existingCluster.GetAutopilot().GetEnabled() {
+		changedFields = append(changedFields, "spec.enableAutopilot")
+	}
+	if cn := spec.ClusterNetwork; cn != nil {
+		existingIPAllocationPolicy := existingCluster.GetIpAllocationPolicy()
+		if cn.UseIPAliases && !existingIPAllocationPolicy.GetUseIpAliases() {
+			changedFields = append(changedFields, "spec.clusterNetwork.useIPAliases")
+		}
+		if cn.UseIPAliases && cn.Pod != nil && !compareCidrBlock(cn.Pod.CidrBlock, existingIPAllocationPolicy.GetClusterIpv4CidrBlock()) {
+			changedFields = append(changedFields, "spec.clusterNetwork.pod.cidrBlock")
+		}
+		if cn.UseIPAliases && cn.Service != nil && !compareCidrBlock(cn.Service.CidrBlock, existingIPAllocationPolicy.GetServicesIpv4CidrBlock()) {
+			changedFields = append(changedFields, "spec.clusterNetwork.service.cidrBlock")
+		}
+		if cn.PrivateCluster != nil && !compareCidrBlock(cn.PrivateCluster.ControlPlaneCidrBlock, existingCluster.GetPrivateClusterConfig().GetMasterIpv4CidrBlock()) {
+			changedFields = append(changedFields, "spec.clusterNetwork.privateCluster.controlPlaneCidrBlock")
+		}
+		if cn.DatapathProvider != nil {
+			existingDatapathProvider := existingCluster.GetNetworkConfig().GetDatapathProvider()
+			// Clusters using the legacy datapath don't always report it.
+			if existingDatapathProvider == containerpb.DatapathProvider_DATAPATH_PROVIDER_UNSPECIFIED {
+				existingDatapathProvider = containerpb.DatapathProvider_LEGACY_DATAPATH
+			}
+			if convertToSdkDatapathProvider(*cn.DatapathProvider) != existingDatapathProvider {
+				changedFields = append(changedFields, "spec.clusterNetwork.datapathProvider")
+			}
+		}
+	}
+
+	return changedFields
+}
+
+// compare if the desired CIDR block matches the existing one. A desired CIDR block that only specifies the netmask
+// size, such as "/14", only matches the size of the existing range, and an empty one lets GKE choose the range.
+func compareCidrBlock(desired, existing string) bool {
+	if desired == "" {
+		return true
+	}
+	if strings.HasPrefix(desired, "/") {
+		return strings.HasSuffix(existing, desired)
+	}
+	return desired == existing
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestCheckImmutableFields(t *testing.T) {
+	tests := []struct {
+		name            string
+		spec            infrav1exp.GCPManagedControlPlaneClassSpec
+		existingCluster *containerpb.Cluster
+		expected        []string
+	}{
+		{
+			name: "no change when the fields are not specified",
+			spec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				ClusterNetwork: &infrav1exp.ClusterNetwork{},
+			},
+			existingCluster: &containerpb.Cluster{
+				IpAllocationPolicy: &containerpb.IPAllocationPolicy{
+					UseIpAliases:         true,
+					ClusterIpv4CidrBlock: "10.0.0.0/14",
+				},
+				NetworkConfig: &containerpb.NetworkConfig{
+					DatapathProvider: containerpb.DatapathProvider_ADVANCED_DATAPATH,
+				},
+			},
+		},
+		{
+			name: "no change when the fields match",
+			spec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				EnableAutopilot: true,
+				ClusterNetwork: &infrav1exp.ClusterNetwork{
+					UseIPAliases:     true,
+					Pod:              &infrav1exp.ClusterNetworkPod{CidrBlock: "/14"},
+					Service:          &infrav1exp.ClusterNetworkService{CidrBlock: "10.4.0.0/20"},
+					PrivateCluster:   &infrav1exp.PrivateCluster{ControlPlaneCidrBlock: "172.16.0.0/28"},
+					DatapathProvider: ptr.To(infrav1exp.LegacyDatapath),
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				Autopilot: &containerpb.Autopilot{Enabled: true},
+				IpAllocationPolicy: &containerpb.IPAllocationPolicy{
+					UseIpAliases:          true,
+					ClusterIpv4CidrBlock:  "10.0.0.0/14",
+					ServicesIpv4CidrBlock: "10.4.0.0/20",
+				},
+				PrivateClusterConfig: &containerpb.PrivateClusterConfig{
+					MasterIpv4CidrBlock: "172.16.0.0/28",
+				},
+			},
+		},
+		{
+			name: "changes are reported",
+			spec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				ClusterNetwork: &infrav1exp.ClusterNetwork{
+					UseIPAliases:     true,
+					Pod:              &infrav1exp.ClusterNetworkPod{CidrBlock: "10.8.0.0/14"},
+					Service:          &infrav1exp.ClusterNetworkService{CidrBlock: "/22"},
+					PrivateCluster:   &infrav1exp.PrivateCluster{ControlPlaneCidrBlock: "172.16.0.16/28"},
+					DatapathProvider: ptr.To(infrav1exp.AdvancedDatapath),
+				},
+			},
+			existingCluster: &containerpb.Cluster{
+				Autopilot: &containerpb.Autopilot{Enabled: true},
+				IpAllocationPolicy: &containerpb.IPAllocationPolicy{
+					UseIpAliases:          true,
+					ClusterIpv4CidrBlock:  "10.0.0.0/14",
+					ServicesIpv4CidrBlock: "10.4.0.0/20",
+				},
+				PrivateClusterConfig: &containerpb.PrivateClusterConfig{
+					MasterIpv4CidrBlock: "172.16.0.0/28",
+				},
+			},
+			expected: []string{
+				"spec.enableAutopilot",
+				"spec.clusterNetwork.pod.cidrBlock",
+				"spec.clusterNetwork.service.cidrBlock",
+				"spec.clusterNetwork.privateCluster.controlPlaneCidrBlock",
+				"spec.clusterNetwork.datapathProvider",
+			},
+		},
+		{
+			name: "routes-based cluster is reported when IP aliases are enabled",
+			spec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				ClusterNetwork: &infrav1exp.ClusterNetwork{
+					UseIPAliases: true,
+				},
+			},
+			existingCluster: &containerpb.Cluster{},
+			expected:        []string{"spec.clusterNetwork.useIPAliases"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(&infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: tt.spec,
+				},
+			})
+			if got := svc.checkImmutableFields(tt.existingCluster); !cmp.Equal(got, tt.expected) {
+				t.Errorf("checkImmutableFields() mismatch (-got +want):\n%s", cmp.Diff(got, tt.expected))
+			}
+		})
+	}
+}
+
+func TestCheckDiffAndPrepareResourceLabels(t *testing.T) {
+	tests := []struct {
+		name                  string
+		additionalLabels      infrav1.Labels
+		managedResourceLabels []string
+		existingCluster       *containerpb.Cluster
+		wantNeedUpdate        bool
+		wantLabels            map[string]string
+	}{
+		{
+			name:             "no diff when the labels match",
+			additionalLabels: infrav1.Labels{"team": "a"},
+			existingCluster: &containerpb.Cluster{
+				ResourceLabels: map[string]string{
+					"team":                      "a",
+					"capg-cluster-test-cluster": "owned",
+				},
+			},
+			wantNeedUpdate: false,
+		},
+		{
+			name:             "update needed when a label is added",
+			additionalLabels: infrav1.Labels{"team": "a"},
+			existingCluster: &containerpb.Cluster{
+				ResourceLabels: map[string]string{
+					"capg-cluster-test-cluster": "owned",
+				},
+				LabelFingerprint: "fingerprint",
+			},
+			wantNeedUpdate: true,
+			wantLabels: map[string]string{
+				"team":                      "a",
+				"capg-cluster-test-cluster": "owned",
+			},
+		},
+		{
+			name:                  "update needed when a label set by CAPG is removed",
+			managedResourceLabels: []string{"capg-cluster-test-cluster", "team"},
+			existingCluster: &containerpb.Cluster{
+				ResourceLabels: map[string]string{
+					"team":                      "a",
+					"capg-cluster-test-cluster": "owned",
+				},
+				LabelFingerprint: "fingerprint",
+			},
+			wantNeedUpdate: true,
+			wantLabels: map[string]string{
+				"capg-cluster-test-cluster": "owned",
+			},
+		},
+		{
+			name: "no diff when a label is set by another tool",
+			existingCluster: &containerpb.Cluster{
+				ResourceLabels: map[string]string{
+					"team":                      "a",
+					"capg-cluster-test-cluster": "owned",
+				},
+			},
+			wantNeedUpdate: false,
+		},
+		{
+			name:                  "labels set by other tools are kept when a label is added",
+			additionalLabels:      infrav1.Labels{"env": "prod"},
+			managedResourceLabels: []string{"capg-cluster-test-cluster"},
+			existingCluster: &containerpb.Cluster{
+				ResourceLabels: map[string]string{
+					"team":                      "a",
+					"capg-cluster-test-cluster": "owned",
+				},
+				LabelFingerprint: "fingerprint",
+			},
+			wantNeedUpdate: true,
+			wantLabels: map[string]string{
+				"env":                       "prod",
+				"team":                      "a",
+				"capg-cluster-test-cluster": "owned",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(&infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						Project:  "test-project",
+						Location: "us-central1",
+					},
+					ClusterName: "test-cluster",
+				},
+				Status: infrav1exp.GCPManagedControlPlaneStatus{
+					ManagedResourceLabels: tt.managedResourceLabels,
+				},
+			})
+			svc.scope.GCPManagedCluster = &infrav1exp.GCPManagedCluster{
+				Spec: infrav1exp.GCPManagedClusterSpec{
+					AdditionalLabels: tt.additionalLabels,
+				},
+			}
+			log := ctrl.Log.WithName("test")
+			needUpdate, req := svc.checkDiffAndPrepareResourceLabels(tt.existingCluster, &log)
+			if needUpdate != tt.wantNeedUpdate {
+				t.Fatalf("checkDiffAndPrepareResourceLabels() needUpdate = %v, want %v", needUpdate, tt.wantNeedUpdate)
+			}
+			if !needUpdate {
+				return
+			}
+			if !cmp.Equal(req.GetResourceLabels(), tt.wantLabels) {
+				t.Errorf("resource labels mismatch (-got +want):\n%s", cmp.Diff(req.GetResourceLabels(), tt.wantLabels))
+			}
+			if req.GetLabelFingerprint() != tt.existingCluster.GetLabelFingerprint() {
+				t.Errorf("label fingerprint = %q, want %q", req.GetLabelFingerprint(), tt.existingCluster.GetLabelFingerprint())
+			}
+		})
+	}
+}
+
+func TestIdentityServiceConfigUpdate(t *testing.T) {
+	tests := []struct {
+		name                  string
+		enableIdentityService *bool
+		existingEnabled       bool
+		wantUpdate            bool
+	}{
+		{
+			name:            "unset leaves an enabled identity service as it is",
+			existingEnabled: true,
+		},
+		{
+			name:                  "enabling the identity service",
+			enableIdentityService: ptr.To(true),
+			wantUpdate:            true,
+		},
+		{
+			name:                  "disabling the identity service",
+			enableIdentityService: ptr.To(false),
+			existingEnabled:       true,
+			wantUpdate:            true,
+		},
+		{
+			name:                  "no update when the identity service matches",
+			enableIdentityService: ptr.To(false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(&infrav1exp.GCPManagedControlPlane{
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+						EnableIdentityService: tt.enableIdentityService,
+					},
+				},
+			})
+			existingCluster := &containerpb.Cluster{
+				IdentityServiceConfig: &containerpb.IdentityServiceConfig{Enabled: tt.existingEnabled},
+			}
+			log := ctrl.Log.WithName("test")
+			update := svc.identityServiceConfigUpdate(existingCluster, &log)
+			if (update != nil) != tt.wantUpdate {
+				t.Fatalf("identityServiceConfigUpdate() = %v, want update %v", update, tt.wantUpdate)
+			}
+			if update != nil && update.GetDesiredIdentityServiceConfig().GetEnabled() != *tt.enableIdentityService {
+				t.Errorf("desired identity service enabled = %v, want %v", update.GetDesiredIdentityServiceConfig().GetEnabled(), *tt.enableIdentityService)
+			}
+		})
+	}
+}
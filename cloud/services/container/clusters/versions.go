@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"strings"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/gkeversion"
+)
+
+// desiredMasterVersion returns the control plane version of the spec resolved to one of the versions available in
+// the release channel of the cluster, or the current version of the existing cluster when it already matches. The
+// latest alias of an existing cluster resolves to the latest version it can be upgraded to. It returns an error when
+// the version isn't available or the cluster can't be upgraded to it, and an empty version when unspecified.
+func (s *Service) desiredMasterVersion(existingCluster *containerpb.Cluster) (string, error) {
+	versionFromSpec := s.scope.GetControlPlaneVersion()
+	if versionFromSpec == nil {
+		return "", nil
+	}
+	version := gkeversion.Normalize(*versionFromSpec)
+	if err := gkeversion.Validate(version); err != nil {
+		return "", err
+	}
+
+	currentVersion := existingCluster.GetCurrentMasterVersion()
+	if currentVersion != "" && gkeversion.Matches(version, currentVersion) {
+		return currentVersion, nil
+	}
+	// Without the server config, the version is left to GKE to resolve.
+	if s.serverConfig == nil {
+		return convertToSdkMasterVersion(version), nil
+	}
+
+	validVersions := gkeversion.ValidMasterVersions(s.serverConfig, s.releaseChannel(existingCluster))
+	resolved, ok := gkeversion.Resolve(version, validVersions)
+	if !ok {
+		return "", errors.Errorf("version %s is not available in %s, valid versions are: %s", version, s.versionsLocation(existingCluster), strings.Join(validVersions, ", "))
+	}
+	if currentVersion == "" {
+		return resolved, nil
+	}
+	if version == gkeversion.Latest {
+		if resolved, ok = gkeversion.Resolve(version, gkeversion.UpgradeTargets(currentVersion, validVersions)); !ok {
+			return currentVersion, nil
+		}
+		return resolved, nil
+	}
+	if gkeversion.Compare(resolved, currentVersion) < 0 {
+		return "", errors.Errorf("control plane can't be downgraded from %s to %s", currentVersion, resolved)
+	}
+	if gkeversion.MinorSkew(resolved, currentVersion) > 1 {
+		return "", errors.Errorf("control plane can't be upgraded from %s to %s, minor versions are upgraded one at a time", currentVersion, resolved)
+	}
+
+	return resolved, nil
+}
+
+// upgradeTargets returns the versions available in the release channel of the existing cluster that its control
+// plane can be upgraded to.
+func (s *Service) upgradeTargets(existingCluster *containerpb.Cluster) []string {
+	if s.serverConfig == nil {
+		return nil
+	}
+	return gkeversion.UpgradeTargets(existingCluster.GetCurrentMasterVersion(), gkeversion.ValidMasterVersions(s.serverConfig, s.releaseChannel(existingCluster)))
+}
+
+// releaseChannel returns the release channel of the spec, or the one of the existing cluster when unspecified.
+func (s *Service) releaseChannel(existingCluster *containerpb.Cluster) containerpb.ReleaseChannel_Channel {
+	return gkeversion.ReleaseChannel(convertToSdkReleaseChannel(s.scope.GCPManagedControlPlane.Spec.ReleaseChannel), existingCluster)
+}
+
+func (s *Service) versionsLocation(existingCluster *containerpb.Cluster) string {
+	if channel := s.releaseChannel(existingCluster); channel != containerpb.ReleaseChannel_UNSPECIFIED {
+		return "the " + strings.ToLower(channel.String()) + " release channel of " + s.scope.GCPManagedControlPlane.Spec.Location
+	}
+	return s.scope.GCPManagedControlPlane.Spec.Location
+}
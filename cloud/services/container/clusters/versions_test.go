@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+var testServerConfig = &containerpb.ServerConfig{
+	ValidMasterVersions: []string{
+		"1.32.1-gke.1200000",
+		"1.31.5-gke.1023000",
+		"1.31.4-gke.1183000",
+		"1.30.9-gke.1009000",
+	},
+	Channels: []*containerpb.ServerConfig_ReleaseChannelConfig{
+		{
+			Channel:       containerpb.ReleaseChannel_STABLE,
+			ValidVersions: []string{"1.31.4-gke.1183000", "1.30.9-gke.1009000"},
+		},
+	},
+}
+
+func newVersionsTestService(version string, releaseChannel *infrav1exp.ReleaseChannel) *Service {
+	s := newTestService(&infrav1exp.GCPManagedControlPlane{
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				Location:       "us-central1",
+				ReleaseChannel: releaseChannel,
+			},
+			Version: ptr.To(version),
+		},
+	})
+	s.serverConfig = testServerConfig
+	return s
+}
+
+func TestDesiredMasterVersion(t *testing.T) {
+	tests := []struct {
+		name           string
+		version        string
+		releaseChannel *infrav1exp.ReleaseChannel
+		cluster        *containerpb.Cluster
+		expected       string
+		expectError    bool
+	}{
+		{
+			name:     "minor version of a new cluster",
+			version:  "1.31",
+			expected: "1.31.5-gke.1023000",
+		},
+		{
+			name:     "latest version of a new cluster",
+			version:  "latest",
+			expected: "1.32.1-gke.1200000",
+		},
+		{
+			name:           "latest version in the release channel",
+			version:        "latest",
+			releaseChannel: ptr.To(infrav1exp.Stable),
+			expected:       "1.31.4-gke.1183000",
+		},
+		{
+			name:           "version not available in the release channel",
+			version:        "1.32",
+			releaseChannel: ptr.To(infrav1exp.Stable),
+			expectError:    true,
+		},
+		{
+			name:     "version matching the current version",
+			version:  "v1.30.9",
+			cluster:  &containerpb.Cluster{CurrentMasterVersion: "1.30.9-gke.1000000"},
+			expected: "1.30.9-gke.1000000",
+		},
+		{
+			name:     "latest version of an existing cluster",
+			version:  "latest",
+			cluster:  &containerpb.Cluster{CurrentMasterVersion: "1.30.9-gke.1009000"},
+			expected: "1.31.5-gke.1023000",
+		},
+		{
+			name:        "upgrade skipping a minor version",
+			version:     "1.32",
+			cluster:     &containerpb.Cluster{CurrentMasterVersion: "1.30.9-gke.1009000"},
+			expectError: true,
+		},
+		{
+			name:        "downgrade",
+			version:     "1.30",
+			cluster:     &containerpb.Cluster{CurrentMasterVersion: "1.31.5-gke.1023000"},
+			expectError: true,
+		},
+		{
+			name:        "invalid version",
+			version:     "1.31.x",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newVersionsTestService(tt.version, tt.releaseChannel).desiredMasterVersion(tt.cluster)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("desiredMasterVersion() error = %v, expectError %v", err, tt.expectError)
+			}
+			if got != tt.expected {
+				t.Errorf("desiredMasterVersion() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUpgradeTargets(t *testing.T) {
+	s := newVersionsTestService("latest", nil)
+	got := s.upgradeTargets(&containerpb.Cluster{CurrentMasterVersion: "1.31.4-gke.1183000"})
+	expected := []string{"1.32.1-gke.1200000", "1.31.5-gke.1023000"}
+	if !cmp.Equal(got, expected) {
+		t.Errorf("upgradeTargets() mismatch (-want +got):\n%s", cmp.Diff(expected, got))
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/shared"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/cluster-api/util/record"
+)
+
+// reconcileImport imports the existing node pool and reports the settings that differ from the spec and will be
+// changed by the following reconciliations.
+func (s *Service) reconcileImport(ctx context.Context, nodePool *containerpb.NodePool, log *logr.Logger) error {
+	log.Info("Importing existing node pool", "nodepool", nodePool.GetName())
+	s.importNodePool(nodePool)
+	// The replicas are part of the MachinePool, which isn't persisted with the GCPManagedMachinePool.
+	if err := s.scope.PatchCAPIMachinePoolObject(ctx); err != nil {
+		return fmt.Errorf("patching replicas of MachinePool %s: %w", s.scope.MachinePool.Name, err)
+	}
+	v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolImportedCondition)
+
+	if settings := s.pendingNodePoolUpdates(nodePool); len(settings) > 0 {
+		log.Info("Imported node pool differs from the spec and will be updated", "settings", settings)
+		record.Eventf(s.scope.GCPManagedMachinePool, "ImportedNodePoolUpdatePending", "Imported node pool %s will be updated: %s", nodePool.GetName(), strings.Join(settings, ", "))
+	}
+
+	return nil
+}
+
+// importNodePool reads the settings of the existing node pool that are not specified into the spec of the
+// GCPManagedMachinePool, so that adopting the node pool doesn't change them. The replicas of the MachinePool are set
+// to the node count of a node pool without autoscaling, as they are always set by the MachinePool defaulting.
+func (s *Service) importNodePool(nodePool *containerpb.NodePool) {
+	spec := &s.scope.GCPManagedMachinePool.Spec
+	config := nodePool.GetConfig()
+
+	if spec.MachineType == nil && spec.InstanceType == nil && config.GetMachineType() != "" {
+		spec.MachineType = ptr.To(config.GetMachineType())
+	}
+	if spec.DiskSizeGb == nil && spec.DiskSizeGB == nil && config.GetDiskSizeGb() != 0 {
+		spec.DiskSizeGb = ptr.To(config.GetDiskSizeGb())
+	}
+	if spec.ImageType == nil && config.GetImageType() != "" {
+		spec.ImageType = ptr.To(config.GetImageType())
+	}
+	if spec.DiskType == nil && config.GetDiskType() != "" {
+		spec.DiskType = ptr.To(infrav1exp.DiskType(config.GetDiskType()))
+	}
+	if spec.Scaling == nil {
+		spec.Scaling = convertFromSdkAutoscaling(nodePool.GetAutoscaling(), len(nodePool.GetLocations()))
+	}
+	if spec.KubernetesLabels == nil && len(config.GetLabels()) > 0 {
+		spec.KubernetesLabels = config.GetLabels()
+	}
+	if spec.KubernetesTaints == nil && len(config.GetTaints()) > 0 {
+		spec.KubernetesTaints = convertFromSdkTaints(config.GetTaints())
+	}
+	if spec.AdditionalLabels == nil {
+		clusterTagKey := infrav1.ClusterTagKey(s.scope.GCPManagedControlPlane.Spec.ClusterName)
+		for key, value := range config.GetResourceLabels() {
+			if key == clusterTagKey {
+				continue
+			}
+			if spec.AdditionalLabels == nil {
+				spec.AdditionalLabels = infrav1.Labels{}
+			}
+			spec.AdditionalLabels[key] = value
+		}
+	}
+	if spec.NodeLocations == nil {
+		spec.NodeLocations = nodePool.GetLocations()
+	}
+	if spec.NodeNetwork.Tags == nil {
+		spec.NodeNetwork.Tags = config.GetTags()
+	}
+	if spec.Management == nil && nodePool.GetManagement() != nil {
+		spec.Management = &infrav1exp.NodePoolManagement{
+			AutoUpgrade: nodePool.GetManagement().GetAutoUpgrade(),
+			AutoRepair:  nodePool.GetManagement().GetAutoRepair(),
+		}
+	}
+	if !infrav1exp.ConvertToSdkAutoscaling(spec.Scaling).GetEnabled() {
+		// The inverse of checkDiffAndPrepareUpdateSize, the node count of regional node pools is per zone.
+		replicas := nodePool.GetInitialNodeCount()
+		if shared.IsRegional(s.scope.Region()) {
+			replicas *= int32(len(nodePool.GetLocations()))
+		}
+		s.scope.MachinePool.Spec.Replicas = ptr.To(replicas)
+	}
+}
+
+// pendingNodePoolUpdates returns the settings of the node pool that differ from the spec and will be updated.
+func (s *Service) pendingNodePoolUpdates(nodePool *containerpb.NodePool) []string {
+	var settings []string
+	if needUpdate, updateNodePoolRequest := s.checkDiffAndPrepareUpdateConfig(nodePool); needUpdate {
+		updateNodePoolRequest.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if fd.Name() != "name" {
+				settings = append(settings, string(fd.Name()))
+			}
+			return true
+		})
+	}
+	if needUpdate, _ := s.checkDiffAndPrepareUpdateAutoscaling(nodePool); needUpdate {
+		settings = append(settings, "autoscaling")
+	}
+	if needUpdate, _ := s.checkDiffAndPrepareUpdateSize(nodePool); needUpdate {
+		settings = append(settings, "node_count")
+	}
+
+	return settings
+}
+
+// convertFromSdkAutoscaling converts the SDK node pool autoscaling to the one defined in CRs. GKE reports either the
+// total node counts or the node counts per zone, the latter are converted to totals across the node pool locations.
+func convertFromSdkAutoscaling(autoscaling *containerpb.NodePoolAutoscaling, locations int) *infrav1exp.NodePoolAutoScaling {
+	if !autoscaling.GetEnabled() {
+		return &infrav1exp.NodePoolAutoScaling{EnableAutoscaling: ptr.To(false)}
+	}
+
+	minCount, maxCount := autoscaling.GetTotalMinNodeCount(), autoscaling.GetTotalMaxNodeCount()
+	if minCount == 0 && maxCount == 0 {
+		zones := int32(max(locations, 1)) //nolint:gosec
+		minCount, maxCount = autoscaling.GetMinNodeCount()*zones, autoscaling.GetMaxNodeCount()*zones
+	}
+	scaling := &infrav1exp.NodePoolAutoScaling{
+		MinCount: ptr.To(minCount),
+		MaxCount: ptr.To(maxCount),
+	}
+	switch autoscaling.GetLocationPolicy() {
+	case containerpb.NodePoolAutoscaling_BALANCED:
+		scaling.LocationPolicy = ptr.To(infrav1exp.ManagedNodePoolLocationPolicyBalanced)
+	case containerpb.NodePoolAutoscaling_ANY:
+		scaling.LocationPolicy = ptr.To(infrav1exp.ManagedNodePoolLocationPolicyAny)
+	}
+
+	return scaling
+}
+
+// convertFromSdkTaints converts the SDK node taints to the ones defined in CRs.
+func convertFromSdkTaints(taints []*containerpb.NodeTaint) infrav1exp.Taints {
+	res := infrav1exp.Taints{}
+	for _, taint := range taints {
+		var effect infrav1exp.TaintEffect
+		switch taint.GetEffect() {
+		case containerpb.NodeTaint_NO_SCHEDULE:
+			effect = "NoSchedule"
+		case containerpb.NodeTaint_NO_EXECUTE:
+			effect = "NoExecute"
+		case containerpb.NodeTaint_PREFER_NO_SCHEDULE:
+			effect = "PreferNoSchedule"
+		default:
+			continue
+		}
+		res = append(res, infrav1exp.Taint{
+			Key:    taint.GetKey(),
+			Value:  taint.GetValue(),
+			Effect: effect,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func newTestService(location string) *Service {
+	s := new(scope.ManagedMachinePoolScope)
+	s.GCPManagedControlPlane = &infrav1exp.GCPManagedControlPlane{
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				Project:  "test-project",
+				Location: location,
+			},
+			ClusterName: "test-cluster",
+		},
+	}
+	s.GCPManagedMachinePool = &infrav1exp.GCPManagedMachinePool{}
+	// The MachinePool defaulting sets the replicas to 1.
+	s.MachinePool = &clusterv1.MachinePool{
+		Spec: clusterv1.MachinePoolSpec{
+			Replicas: ptr.To[int32](1),
+		},
+	}
+	return New(s)
+}
+
+func TestImportNodePoolReplicas(t *testing.T) {
+	tests := []struct {
+		name     string
+		location string
+		nodePool *containerpb.NodePool
+		expected int32
+	}{
+		{
+			name:     "zonal node pool",
+			location: "us-central1-a",
+			nodePool: &containerpb.NodePool{
+				InitialNodeCount: 3,
+				Locations:        []string{"us-central1-a"},
+			},
+			expected: 3,
+		},
+		{
+			name:     "regional node pool counts the nodes of all its zones",
+			location: "us-central1",
+			nodePool: &containerpb.NodePool{
+				InitialNodeCount: 2,
+				Locations:        []string{"us-central1-a", "us-central1-b", "us-central1-c"},
+			},
+			expected: 6,
+		},
+		{
+			name:     "autoscaled node pool keeps the replicas",
+			location: "us-central1-a",
+			nodePool: &containerpb.NodePool{
+				InitialNodeCount: 3,
+				Locations:        []string{"us-central1-a"},
+				Autoscaling: &containerpb.NodePoolAutoscaling{
+					Enabled:      true,
+					MinNodeCount: 1,
+					MaxNodeCount: 5,
+				},
+			},
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(tt.location)
+			svc.importNodePool(tt.nodePool)
+			if got := *svc.scope.MachinePool.Spec.Replicas; got != tt.expected {
+				t.Errorf("replicas = %d, want %d", got, tt.expected)
+			}
+			// The imported node pool isn't resized.
+			if needUpdate, _ := svc.checkDiffAndPrepareUpdateSize(tt.nodePool); needUpdate {
+				t.Errorf("checkDiffAndPrepareUpdateSize() needUpdate = true, want false after the import")
+			}
+		})
+	}
+}
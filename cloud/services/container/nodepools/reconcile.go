@@ -19,7 +19,9 @@ package nodepools
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/cluster-api-provider-gcp/util/resourceurl"
 
@@ -33,6 +35,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/googleapis/gax-go/v2/apierror"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/providerid"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/shared"
@@ -68,8 +71,31 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "reading node pool: %v", err)
 		return ctrl.Result{}, err
 	}
+	// The server config and the release channel are only needed to resolve the version of the spec.
+	// Without the server config, the version is left to GKE to resolve.
+	if s.scope.NodePoolVersion() != "" {
+		s.serverConfig, err = s.scope.ServerConfig(ctx)
+		if err != nil {
+			log.Error(err, "Failed to get the GKE server config, the node pool version is resolved by GKE")
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolServerConfigAvailableCondition, infrav1exp.GKEMachinePoolServerConfigUnavailableReason, clusterv1beta1.ConditionSeverityWarning, "getting server config: %v", err)
+		} else {
+			v1beta1conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolServerConfigAvailableCondition)
+			s.releaseChannel, err = s.describeReleaseChannel(ctx)
+			if err != nil {
+				v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "getting release channel: %v", err)
+				return ctrl.Result{}, err
+			}
+		}
+	}
 	if nodePool == nil {
 		log.Info("Node pool not found, creating", "cluster", s.scope.Cluster.Name)
+		if _, err = s.desiredNodeVersion(nil); err != nil {
+			log.Info("Invalid node pool version", "reason", err.Error())
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition, infrav1exp.GKEMachinePoolInvalidVersionReason, clusterv1beta1.ConditionSeverityError, "%v", err)
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition, infrav1exp.GKEMachinePoolInvalidVersionReason, clusterv1beta1.ConditionSeverityError, "%v", err)
+			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolCreatingCondition, infrav1exp.GKEMachinePoolInvalidVersionReason, clusterv1beta1.ConditionSeverityError, "%v", err)
+			return ctrl.Result{}, nil
+		}
 		if err = s.createNodePool(ctx, &log); err != nil {
 			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1beta1.ReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "creating node pool: %v", err)
 			v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "creating node pool: %v", err)
@@ -102,6 +128,7 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 	}
 	s.scope.GCPManagedMachinePool.Spec.ProviderIDList = providerIDList
 	s.scope.GCPManagedMachinePool.Status.Replicas = int32(len(providerIDList))
+	s.scope.GCPManagedMachinePool.Status.BlueGreenUpgrade = convertFromSdkBlueGreenInfo(nodePool.GetUpdateInfo().GetBlueGreenInfo())
 
 	// Update GKEManagedMachinePool conditions based on GKE node pool status
 	switch nodePool.GetStatus() {
@@ -144,12 +171,25 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		return ctrl.Result{}, nil
 	}
 
+	if s.scope.GCPManagedControlPlane.Spec.Import && !v1beta1conditions.IsTrue(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolImportedCondition) {
+		if err := s.reconcileImport(ctx, nodePool, &log); err != nil {
+			return ctrl.Result{}, err
+		}
+		// Requeue so that the imported settings are persisted before the node pool is updated.
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
+
+	_, versionErr := s.desiredNodeVersion(nodePool)
+	if versionErr != nil {
+		log.Info("Invalid node pool version, keeping the current version", "reason", versionErr.Error())
+	}
+
 	needUpdateConfig, nodePoolUpdateConfigRequest := s.checkDiffAndPrepareUpdateConfig(nodePool)
 	if needUpdateConfig {
 		log.Info("Node pool config update required", "request", nodePoolUpdateConfigRequest)
 		err = s.updateNodePoolConfig(ctx, nodePoolUpdateConfigRequest)
 		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("node pool config update (either version/labels/taints/locations/image type/network tag/linux node config or all) failed: %w", err)
+			return ctrl.Result{}, fmt.Errorf("node pool config update (either version/labels/taints/locations/image type/network tag/linux node config/upgrade settings or all) failed: %w", err)
 		}
 		log.Info("Node pool config updating in progress")
 		s.scope.GCPManagedMachinePool.Status.Ready = true
@@ -181,7 +221,11 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
 	}
 
-	v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolUpdatingCondition, infrav1exp.GKEMachinePoolUpdatedReason, clusterv1beta1.ConditionSeverityInfo, "")
+	if versionErr != nil {
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolUpdatingCondition, infrav1exp.GKEMachinePoolInvalidVersionReason, clusterv1beta1.ConditionSeverityError, "%v", versionErr)
+	} else {
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolUpdatingCondition, infrav1exp.GKEMachinePoolUpdatedReason, clusterv1beta1.ConditionSeverityInfo, "")
+	}
 
 	s.scope.SetReplicas(int32(len(s.scope.GCPManagedMachinePool.Spec.ProviderIDList)))
 	log.Info("Node pool reconciled")
@@ -200,6 +244,15 @@ func (s *Service) Delete(ctx context.Context) (ctrl.Result, error) {
 
 	defer s.setReadyStatusFromConditions()
 
+	// CAPI deletes the machine pools of a cluster before its control plane. The node pools of a cluster being deleted
+	// are kept, along with their finalizer, until its control plane allows deletion.
+	if !s.scope.Cluster.DeletionTimestamp.IsZero() && s.scope.GCPManagedControlPlane.DeletionProtected() {
+		log.Info("Control plane has deletion protection, waiting for deletion to be allowed before deleting the node pool")
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolDeletingCondition, infrav1exp.GKEMachinePoolDeletionProtectedReason, clusterv1beta1.ConditionSeverityWarning,
+			"control plane has deletion protection, set the %s annotation on it to delete the cluster", infrav1exp.AllowDeletionAnnotation)
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
+
 	nodePool, err := s.describeNodePool(ctx, &log)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -226,6 +279,12 @@ func (s *Service) Delete(ctx context.Context) (ctrl.Result, error) {
 		break
 	}
 
+	if s.scope.DeletionPolicy() == infrav1exp.DeletionPolicyOrphan {
+		log.Info("Orphaning node pool, leaving it in place", "nodepool", nodePool.GetName())
+		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolDeletingCondition, infrav1exp.GKEMachinePoolOrphanedReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{}, nil
+	}
+
 	if err = s.deleteNodePool(ctx); err != nil {
 		v1beta1conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolDeletingCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "deleting node pool: %v", err)
 		return ctrl.Result{}, err
@@ -294,11 +353,20 @@ func (s *Service) createNodePool(ctx context.Context, log *logr.Logger) error {
 
 	isRegional := shared.IsRegional(s.scope.Region())
 
+	nodePool := scope.ConvertToSdkNodePool(*s.scope.GCPManagedMachinePool, *s.scope.MachinePool, isRegional, s.scope.GCPManagedControlPlane.Spec.ClusterName)
+	version, err := s.desiredNodeVersion(nil)
+	if err != nil {
+		return err
+	}
+	if version != "" {
+		nodePool.Version = version
+	}
+
 	createNodePoolRequest := &containerpb.CreateNodePoolRequest{
-		NodePool: scope.ConvertToSdkNodePool(*s.scope.GCPManagedMachinePool, *s.scope.MachinePool, isRegional, s.scope.GCPManagedControlPlane.Spec.ClusterName),
+		NodePool: nodePool,
 		Parent:   s.scope.NodePoolLocation(),
 	}
-	_, err := s.scope.ManagedMachinePoolClient().CreateNodePool(ctx, createNodePoolRequest)
+	_, err = s.scope.ManagedMachinePoolClient().CreateNodePool(ctx, createNodePoolRequest)
 	if err != nil {
 		return err
 	}
@@ -355,9 +423,8 @@ func (s *Service) checkDiffAndPrepareUpdateConfig(existingNodePool *containerpb.
 	desiredNodePool := scope.ConvertToSdkNodePool(*s.scope.GCPManagedMachinePool, *s.scope.MachinePool, isRegional, s.scope.GCPManagedControlPlane.Spec.ClusterName)
 
 	// Node version
-	if s.scope.NodePoolVersion() != "" {
-		desiredNodePoolVersion := infrav1exp.ConvertFromSdkNodeVersion(s.scope.NodePoolVersion())
-		if desiredNodePoolVersion != infrav1exp.ConvertFromSdkNodeVersion(existingNodePool.GetVersion()) {
+	if desiredNodePoolVersion, err := s.desiredNodeVersion(existingNodePool); err == nil && desiredNodePoolVersion != "" {
+		if desiredNodePoolVersion != existingNodePool.GetVersion() {
 			needUpdate = true
 			updateNodePoolRequest.NodeVersion = desiredNodePoolVersion
 		}
@@ -405,14 +472,168 @@ func (s *Service) checkDiffAndPrepareUpdateConfig(existingNodePool *containerpb.
 	}
 	// LinuxNodeConfig
 	desiredLinuxNodeConfig := infrav1exp.ConvertToSdkLinuxNodeConfig(s.scope.GCPManagedMachinePool.Spec.LinuxNodeConfig)
-	if !cmp.Equal(desiredLinuxNodeConfig, existingNodePool.GetConfig().GetLinuxNodeConfig(), cmpopts.IgnoreUnexported(containerpb.LinuxNodeConfig{})) {
+	if !cmp.Equal(desiredLinuxNodeConfig, existingNodePool.GetConfig().GetLinuxNodeConfig(), cmpopts.IgnoreUnexported(containerpb.LinuxNodeConfig{}, containerpb.LinuxNodeConfig_HugepagesConfig{})) {
 		needUpdate = true
 		updateNodePoolRequest.LinuxNodeConfig = desiredLinuxNodeConfig
 	}
+	// Kubelet config
+	// GKE defaults the kubelet settings that are not specified, so only the fields set in the spec are compared.
+	desiredKubeletConfig := s.scope.GCPManagedMachinePool.Spec.KubeletConfig
+	if desiredKubeletConfig != nil && kubeletConfigNeedUpdate(desiredKubeletConfig, existingNodePool.GetConfig().GetKubeletConfig()) {
+		needUpdate = true
+		updateNodePoolRequest.KubeletConfig = desiredNodePool.GetConfig().GetKubeletConfig()
+	}
+	// Containerd config
+	// GKE fills in the containerd settings that are not specified, so only the registry settings built from the spec are compared.
+	desiredContainerdConfig := s.scope.GCPManagedMachinePool.Spec.ContainerdConfig
+	if desiredContainerdConfig != nil && containerdConfigNeedUpdate(desiredContainerdConfig, existingNodePool.GetConfig().GetContainerdConfig()) {
+		needUpdate = true
+		updateNodePoolRequest.ContainerdConfig = desiredNodePool.GetConfig().GetContainerdConfig()
+	}
+	// Upgrade settings
+	// GKE defaults the upgrade settings when they are not specified, so only the fields set in the spec are compared.
+	desiredUpgradeSettings := s.scope.GCPManagedMachinePool.Spec.UpgradeSettings
+	if desiredUpgradeSettings != nil && upgradeSettingsNeedUpdate(desiredUpgradeSettings, existingNodePool.GetUpgradeSettings()) {
+		needUpdate = true
+		updateNodePoolRequest.UpgradeSettings = desiredNodePool.GetUpgradeSettings()
+	}
 
 	return needUpdate, &updateNodePoolRequest
 }
 
+// upgradeSettingsNeedUpdate reports whether the fields set in the desired upgrade settings differ from the existing ones.
+func upgradeSettingsNeedUpdate(desired *infrav1exp.NodePoolUpgradeSettings, existing *containerpb.NodePool_UpgradeSettings) bool {
+	desiredStrategy := containerpb.NodePoolUpdateStrategy_SURGE
+	if desired.Strategy == infrav1exp.NodePoolUpgradeStrategyBlueGreen {
+		desiredStrategy = containerpb.NodePoolUpdateStrategy_BLUE_GREEN
+	}
+	existingStrategy := existing.GetStrategy()
+	if existingStrategy == containerpb.NodePoolUpdateStrategy_NODE_POOL_UPDATE_STRATEGY_UNSPECIFIED {
+		existingStrategy = containerpb.NodePoolUpdateStrategy_SURGE
+	}
+	if desiredStrategy != existingStrategy {
+		return true
+	}
+	if desired.MaxSurge != nil && *desired.MaxSurge != existing.GetMaxSurge() {
+		return true
+	}
+	if desired.MaxUnavailable != nil && *desired.MaxUnavailable != existing.GetMaxUnavailable() {
+		return true
+	}
+
+	blueGreenSettings := desired.BlueGreenSettings
+	if blueGreenSettings == nil {
+		return false
+	}
+	existingRolloutPolicy := existing.GetBlueGreenSettings().GetStandardRolloutPolicy()
+	if blueGreenSettings.BatchNodeCount != nil && *blueGreenSettings.BatchNodeCount != existingRolloutPolicy.GetBatchNodeCount() {
+		return true
+	}
+	if blueGreenSettings.BatchPercentage != nil && float32(*blueGreenSettings.BatchPercentage)/100 != existingRolloutPolicy.GetBatchPercentage() {
+		return true
+	}
+	if blueGreenSettings.BatchSoakDuration != nil && blueGreenSettings.BatchSoakDuration.Duration != existingRolloutPolicy.GetBatchSoakDuration().AsDuration() {
+		return true
+	}
+	if blueGreenSettings.NodePoolSoakDuration != nil && blueGreenSettings.NodePoolSoakDuration.Duration != existing.GetBlueGreenSettings().GetNodePoolSoakDuration().AsDuration() {
+		return true
+	}
+
+	return false
+}
+
+// kubeletConfigNeedUpdate reports whether the fields set in the desired kubelet config differ from the existing ones.
+func kubeletConfigNeedUpdate(desired *infrav1exp.KubeletConfig, existing *containerpb.NodeKubeletConfig) bool {
+	if desired.CPUManagerPolicy != nil && string(*desired.CPUManagerPolicy) != existing.GetCpuManagerPolicy() {
+		return true
+	}
+	if desired.CPUCFSQuota != nil && (existing.GetCpuCfsQuota() == nil || *desired.CPUCFSQuota != existing.GetCpuCfsQuota().GetValue()) {
+		return true
+	}
+	if desired.CPUCFSQuotaPeriod != nil {
+		existingPeriod, err := time.ParseDuration(existing.GetCpuCfsQuotaPeriod())
+		if err != nil || desired.CPUCFSQuotaPeriod.Duration != existingPeriod {
+			return true
+		}
+	}
+	if desired.PodPIDsLimit != nil && *desired.PodPIDsLimit != existing.GetPodPidsLimit() {
+		return true
+	}
+	if desired.ImageGCLowThresholdPercent != nil && *desired.ImageGCLowThresholdPercent != existing.GetImageGcLowThresholdPercent() {
+		return true
+	}
+	if desired.ImageGCHighThresholdPercent != nil && *desired.ImageGCHighThresholdPercent != existing.GetImageGcHighThresholdPercent() {
+		return true
+	}
+	if desired.InsecureKubeletReadonlyPortEnabled != nil && *desired.InsecureKubeletReadonlyPortEnabled != existing.GetInsecureKubeletReadonlyPortEnabled() {
+		return true
+	}
+	if topologyManager := desired.TopologyManager; topologyManager != nil {
+		if topologyManager.Policy != "" && topologyManager.Policy != existing.GetTopologyManager().GetPolicy() {
+			return true
+		}
+		if topologyManager.Scope != "" && topologyManager.Scope != existing.GetTopologyManager().GetScope() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containerdConfigNeedUpdate reports whether the private registry access or the registry hosts built from the desired
+// containerd config differ from the existing ones. The private registry access is only compared when it is set in the spec.
+func containerdConfigNeedUpdate(desired *infrav1exp.ContainerdConfig, existing *containerpb.ContainerdConfig) bool {
+	desiredConfig := infrav1exp.ConvertToSdkContainerdConfig(desired)
+
+	if desired.PrivateRegistryAccess != nil {
+		desiredAccess, existingAccess := desiredConfig.GetPrivateRegistryAccessConfig(), existing.GetPrivateRegistryAccessConfig()
+		if desiredAccess.GetEnabled() != existingAccess.GetEnabled() ||
+			len(desiredAccess.GetCertificateAuthorityDomainConfig()) != len(existingAccess.GetCertificateAuthorityDomainConfig()) {
+			return true
+		}
+		for i, domain := range desiredAccess.GetCertificateAuthorityDomainConfig() {
+			existingDomain := existingAccess.GetCertificateAuthorityDomainConfig()[i]
+			if !slices.Equal(domain.GetFqdns(), existingDomain.GetFqdns()) ||
+				domain.GetGcpSecretManagerCertificateConfig().GetSecretUri() != existingDomain.GetGcpSecretManagerCertificateConfig().GetSecretUri() {
+				return true
+			}
+		}
+	}
+
+	if len(desiredConfig.GetRegistryHosts()) != len(existing.GetRegistryHosts()) {
+		return true
+	}
+	for i, registryHost := range desiredConfig.GetRegistryHosts() {
+		existingRegistryHost := existing.GetRegistryHosts()[i]
+		if registryHost.GetServer() != existingRegistryHost.GetServer() || len(registryHost.GetHosts()) != len(existingRegistryHost.GetHosts()) {
+			return true
+		}
+		for j, host := range registryHost.GetHosts() {
+			existingHost := existingRegistryHost.GetHosts()[j]
+			if host.GetHost() != existingHost.GetHost() || !slices.Equal(host.GetCapabilities(), existingHost.GetCapabilities()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// convertFromSdkBlueGreenInfo converts the blue-green upgrade info reported by GKE to the GCPManagedMachinePool status.
+func convertFromSdkBlueGreenInfo(info *containerpb.NodePool_UpdateInfo_BlueGreenInfo) *infrav1exp.NodePoolBlueGreenUpgradeStatus {
+	if info.GetPhase() == containerpb.NodePool_UpdateInfo_BlueGreenInfo_PHASE_UNSPECIFIED {
+		return nil
+	}
+	status := &infrav1exp.NodePoolBlueGreenUpgradeStatus{
+		Phase:            info.GetPhase().String(),
+		GreenPoolVersion: info.GetGreenPoolVersion(),
+	}
+	if deletionStartTime, err := time.Parse(time.RFC3339, info.GetBluePoolDeletionStartTime()); err == nil {
+		status.BluePoolDeletionStartTime = &metav1.Time{Time: deletionStartTime}
+	}
+	return status
+}
+
 func (s *Service) checkDiffAndPrepareUpdateAutoscaling(existingNodePool *containerpb.NodePool) (bool, *containerpb.SetNodePoolAutoscalingRequest) {
 	needUpdate := false
 	desiredAutoscaling := infrav1exp.ConvertToSdkAutoscaling(s.scope.GCPManagedMachinePool.Spec.Scaling)
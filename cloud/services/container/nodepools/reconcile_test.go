@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/reconciler"
+)
+
+func TestDeleteWaitsForDeletionProtection(t *testing.T) {
+	s := newTestService("us-central1")
+	s.scope.Cluster = &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: ptr.To(metav1.Now())}}
+	s.scope.GCPManagedControlPlane.Spec.DeletionProtection = true
+
+	// The node pool and its finalizer are kept while the control plane of the deleted cluster is protected.
+	result, err := s.Delete(context.Background())
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if result.RequeueAfter != reconciler.DefaultRetryTime {
+		t.Errorf("Delete() requeueAfter = %v, want %v", result.RequeueAfter, reconciler.DefaultRetryTime)
+	}
+	if reason := v1beta1conditions.GetReason(s.scope.GCPManagedMachinePool, infrav1exp.GKEMachinePoolDeletingCondition); reason != infrav1exp.GKEMachinePoolDeletionProtectedReason {
+		t.Errorf("deleting condition reason = %q, want %q", reason, infrav1exp.GKEMachinePoolDeletionProtectedReason)
+	}
+}
+
+func TestContainerdConfigNeedUpdate(t *testing.T) {
+	const secretURI = "projects/my-project/secrets/ca/versions/1"
+	desired := &infrav1exp.ContainerdConfig{
+		RegistryMirrors: []infrav1exp.RegistryMirror{
+			{Server: "docker.io", Mirrors: []string{"mirror.example.com"}},
+		},
+	}
+	registryHosts := []*containerpb.ContainerdConfig_RegistryHostConfig{
+		{
+			Server: "docker.io",
+			Hosts: []*containerpb.ContainerdConfig_RegistryHostConfig_HostConfig{
+				{
+					Host: "mirror.example.com",
+					Capabilities: []containerpb.ContainerdConfig_RegistryHostConfig_HostCapability{
+						containerpb.ContainerdConfig_RegistryHostConfig_HOST_CAPABILITY_PULL,
+						containerpb.ContainerdConfig_RegistryHostConfig_HOST_CAPABILITY_RESOLVE,
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		desired  *infrav1exp.ContainerdConfig
+		existing *containerpb.ContainerdConfig
+		expected bool
+	}{
+		{
+			name:    "private registry access defaulted by GKE",
+			desired: desired,
+			existing: &containerpb.ContainerdConfig{
+				PrivateRegistryAccessConfig: &containerpb.ContainerdConfig_PrivateRegistryAccessConfig{Enabled: false},
+				RegistryHosts:               registryHosts,
+			},
+			expected: false,
+		},
+		{
+			name:     "registry mirror added",
+			desired:  desired,
+			existing: &containerpb.ContainerdConfig{},
+			expected: true,
+		},
+		{
+			name:    "registry mirror changed",
+			desired: &infrav1exp.ContainerdConfig{RegistryMirrors: []infrav1exp.RegistryMirror{{Server: "docker.io", Mirrors: []string{"other.example.com"}}}},
+			existing: &containerpb.ContainerdConfig{
+				RegistryHosts: registryHosts,
+			},
+			expected: true,
+		},
+		{
+			name: "private registry access up to date",
+			desired: &infrav1exp.ContainerdConfig{
+				PrivateRegistryAccess: &infrav1exp.PrivateRegistryAccess{
+					Enabled: true,
+					CertificateAuthorityDomains: []infrav1exp.CertificateAuthorityDomain{
+						{FQDNs: []string{"registry.example.com"}, SecretURI: secretURI},
+					},
+				},
+			},
+			existing: &containerpb.ContainerdConfig{
+				PrivateRegistryAccessConfig: &containerpb.ContainerdConfig_PrivateRegistryAccessConfig{
+					Enabled: true,
+					CertificateAuthorityDomainConfig: []*containerpb.ContainerdConfig_PrivateRegistryAccessConfig_CertificateAuthorityDomainConfig{
+						{
+							Fqdns: []string{"registry.example.com"},
+							CertificateConfig: &containerpb.ContainerdConfig_PrivateRegistryAccessConfig_CertificateAuthorityDomainConfig_GcpSecretManagerCertificateConfig{
+								GcpSecretManagerCertificateConfig: &containerpb.ContainerdConfig_PrivateRegistryAccessConfig_CertificateAuthorityDomainConfig_GCPSecretManagerCertificateConfig{
+									SecretUri: secretURI,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "private registry access disabled",
+			desired: &infrav1exp.ContainerdConfig{
+				PrivateRegistryAccess: &infrav1exp.PrivateRegistryAccess{Enabled: false},
+			},
+			existing: &containerpb.ContainerdConfig{
+				PrivateRegistryAccessConfig: &containerpb.ContainerdConfig_PrivateRegistryAccessConfig{Enabled: true},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := containerdConfigNeedUpdate(tt.desired, tt.existing); actual != tt.expected {
+				t.Errorf("containerdConfigNeedUpdate() = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package nodepools
 
 import (
+	"cloud.google.com/go/container/apiv1/containerpb"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
 )
@@ -24,6 +25,11 @@ import (
 // Service implements node pool reconciler.
 type Service struct {
 	scope *scope.ManagedMachinePoolScope
+	// serverConfig lists the versions available in the location of the cluster, it is loaded by Reconcile.
+	serverConfig *containerpb.ServerConfig
+	// releaseChannel is the release channel of the cluster the node versions are looked up in, it is loaded by
+	// Reconcile.
+	releaseChannel containerpb.ReleaseChannel_Channel
 }
 
 var _ cloud.ReconcilerWithResult = &Service{}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/pkg/errors"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/gkeversion"
+)
+
+// maxNodeMinorSkew is how many minor versions the nodes can be older than the control plane.
+const maxNodeMinorSkew = 2
+
+// desiredNodeVersion returns the node version of the machine pool resolved to one of the versions available in the
+// release channel of the cluster, or the current version of the existing node pool when it already matches. The
+// version of a MachinePool is a semantic version, so it is either a GKE version or a Kubernetes version resolved to
+// the latest GKE version of the patch, the minor and latest aliases of the control plane aren't available. It returns
+// an error when the version isn't available, is newer than the control plane or is too old for it, and an empty
+// version when unspecified.
+func (s *Service) desiredNodeVersion(existingNodePool *containerpb.NodePool) (string, error) {
+	if s.scope.NodePoolVersion() == "" {
+		return "", nil
+	}
+	version := gkeversion.Normalize(s.scope.NodePoolVersion())
+	if err := gkeversion.Validate(version); err != nil {
+		return "", err
+	}
+
+	currentVersion := existingNodePool.GetVersion()
+	if currentVersion != "" && gkeversion.Matches(version, currentVersion) {
+		return currentVersion, nil
+	}
+	// Without the server config, the version is left to GKE to resolve.
+	if s.serverConfig == nil {
+		return infrav1exp.ConvertFromSdkNodeVersion(version), nil
+	}
+
+	validVersions := gkeversion.ValidNodeVersions(s.serverConfig, s.releaseChannel)
+	resolved, ok := gkeversion.Resolve(version, validVersions)
+	if !ok {
+		return "", errors.Errorf("node version %s is not available, valid versions are: %s", version, strings.Join(validVersions, ", "))
+	}
+
+	controlPlaneVersion := s.scope.GCPManagedControlPlane.Status.CurrentVersion
+	if controlPlaneVersion == "" {
+		return resolved, nil
+	}
+	if gkeversion.Compare(infrav1exp.ConvertFromSdkNodeVersion(resolved), controlPlaneVersion) > 0 {
+		return "", errors.Errorf("node version %s is newer than the control plane version %s", resolved, controlPlaneVersion)
+	}
+	if gkeversion.MinorSkew(controlPlaneVersion, resolved) > maxNodeMinorSkew {
+		return "", errors.Errorf("node version %s is more than %d minor versions older than the control plane version %s", resolved, maxNodeMinorSkew, controlPlaneVersion)
+	}
+
+	return resolved, nil
+}
+
+// describeReleaseChannel returns the release channel of the spec of the control plane, or the one of the GKE cluster
+// when unspecified, as the control plane versions are.
+func (s *Service) describeReleaseChannel(ctx context.Context) (containerpb.ReleaseChannel_Channel, error) {
+	var channel containerpb.ReleaseChannel_Channel
+	if releaseChannel := s.scope.GCPManagedControlPlane.Spec.ReleaseChannel; releaseChannel != nil {
+		channel = containerpb.ReleaseChannel_Channel(containerpb.ReleaseChannel_Channel_value[strings.ToUpper(string(*releaseChannel))])
+	}
+	if channel != containerpb.ReleaseChannel_UNSPECIFIED {
+		return channel, nil
+	}
+
+	cluster, err := s.scope.ManagedMachinePoolClient().GetCluster(ctx, &containerpb.GetClusterRequest{
+		Name: s.scope.NodePoolLocation(),
+	})
+	if err != nil {
+		return containerpb.ReleaseChannel_UNSPECIFIED, errors.Wrap(err, "failed to get gke cluster")
+	}
+
+	return gkeversion.ReleaseChannel(channel, cluster), nil
+}
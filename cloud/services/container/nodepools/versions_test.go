@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+)
+
+var testServerConfig = &containerpb.ServerConfig{
+	ValidNodeVersions: []string{
+		"1.31.5-gke.1023000",
+		"1.31.4-gke.1183000",
+		"1.30.9-gke.1009000",
+	},
+	Channels: []*containerpb.ServerConfig_ReleaseChannelConfig{
+		{
+			Channel:       containerpb.ReleaseChannel_STABLE,
+			ValidVersions: []string{"1.31.4-gke.1183000", "1.30.9-gke.1009000"},
+		},
+	},
+}
+
+func TestDesiredNodeVersion(t *testing.T) {
+	tests := []struct {
+		name                string
+		version             string
+		releaseChannel      containerpb.ReleaseChannel_Channel
+		controlPlaneVersion string
+		nodePool            *containerpb.NodePool
+		expected            string
+		expectError         bool
+	}{
+		{
+			name:     "kubernetes version",
+			version:  "v1.31.5",
+			expected: "1.31.5-gke.1023000",
+		},
+		{
+			name:           "kubernetes version in the release channel of the cluster",
+			version:        "v1.31.4",
+			releaseChannel: containerpb.ReleaseChannel_STABLE,
+			expected:       "1.31.4-gke.1183000",
+		},
+		{
+			name:           "version not available in the release channel of the cluster",
+			version:        "v1.31.5",
+			releaseChannel: containerpb.ReleaseChannel_STABLE,
+			expectError:    true,
+		},
+		{
+			name:     "version matching the current version",
+			version:  "v1.30.9",
+			nodePool: &containerpb.NodePool{Version: "1.30.9-gke.1000000"},
+			expected: "1.30.9-gke.1000000",
+		},
+		{
+			name:                "version newer than the control plane",
+			version:             "v1.31.5",
+			controlPlaneVersion: "1.30.9-gke.1009000",
+			expectError:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService("us-central1")
+			s.scope.MachinePool.Spec.Template.Spec.Version = tt.version
+			s.scope.GCPManagedControlPlane.Status.CurrentVersion = tt.controlPlaneVersion
+			s.serverConfig = testServerConfig
+			s.releaseChannel = tt.releaseChannel
+
+			got, err := s.desiredNodeVersion(tt.nodePool)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("desiredNodeVersion() error = %v, expectError %v", err, tt.expectError)
+			}
+			if got != tt.expected {
+				t.Errorf("desiredNodeVersion() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
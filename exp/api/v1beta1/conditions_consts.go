@@ -33,6 +33,25 @@ const (
 	GKEControlPlaneUpdatingCondition clusterv1beta1.ConditionType = "GKEControlPlaneUpdating"
 	// GKEControlPlaneDeletingCondition condition reports on whether the GKE control plane is deleting.
 	GKEControlPlaneDeletingCondition clusterv1beta1.ConditionType = "GKEControlPlaneDeleting"
+	// GKEControlPlaneImmutableFieldsInSyncCondition condition reports on whether the fields of the GKE control plane that
+	// can't be updated match the existing cluster.
+	GKEControlPlaneImmutableFieldsInSyncCondition clusterv1beta1.ConditionType = "GKEControlPlaneImmutableFieldsInSync"
+	// GKEControlPlaneImportedCondition condition reports on whether the existing GKE cluster has been imported.
+	GKEControlPlaneImportedCondition clusterv1beta1.ConditionType = "GKEControlPlaneImported"
+	// GKEControlPlaneFleetRegisteredCondition condition reports on whether the GKE cluster is registered in its fleet.
+	GKEControlPlaneFleetRegisteredCondition clusterv1beta1.ConditionType = "GKEControlPlaneFleetRegistered"
+	// GKEControlPlaneCredentialsRotatedCondition condition reports on the credential rotation requested by the
+	// rotate-credentials annotation, it is False while the rotation is in progress.
+	GKEControlPlaneCredentialsRotatedCondition clusterv1beta1.ConditionType = "GKEControlPlaneCredentialsRotated"
+	// GKEControlPlaneUpgradeAvailableCondition condition reports on whether an upgrade-available notification of the GKE
+	// cluster was received since the last upgrade of its control plane. It has a negative polarity.
+	GKEControlPlaneUpgradeAvailableCondition clusterv1beta1.ConditionType = "GKEControlPlaneUpgradeAvailable"
+	// GKEControlPlaneSecurityBulletinCondition condition reports on whether a security bulletin notification of the GKE
+	// cluster was received since the last upgrade of its control plane. It has a negative polarity.
+	GKEControlPlaneSecurityBulletinCondition clusterv1beta1.ConditionType = "GKEControlPlaneSecurityBulletin"
+	// GKEControlPlaneServerConfigAvailableCondition condition reports on whether the GKE server config, which lists the
+	// versions available in the location of the cluster, could be fetched. Without it, versions are resolved by GKE.
+	GKEControlPlaneServerConfigAvailableCondition clusterv1beta1.ConditionType = "GKEControlPlaneServerConfigAvailable"
 
 	// GKEControlPlaneCreatingReason used to report GKE control plane being created.
 	GKEControlPlaneCreatingReason = "GKEControlPlaneCreating"
@@ -44,12 +63,44 @@ const (
 	GKEControlPlaneDeletingReason = "GKEControlPlaneDeleting"
 	// GKEControlPlaneDeletedReason used to report GKE control plane is deleted.
 	GKEControlPlaneDeletedReason = "GKEControlPlaneDeleted"
+	// GKEControlPlaneOrphanedReason used to report GKE control plane is left in place by its deletion policy.
+	GKEControlPlaneOrphanedReason = "GKEControlPlaneOrphaned"
 	// GKEControlPlaneErrorReason used to report GKE control plane is in error state.
 	GKEControlPlaneErrorReason = "GKEControlPlaneError"
 	// GKEControlPlaneReconciliationFailedReason used to report failures while reconciling GKE control plane.
 	GKEControlPlaneReconciliationFailedReason = "GKEControlPlaneReconciliationFailed"
 	// GKEControlPlaneRequiresAtLeastOneNodePoolReason used to report that no node pool is specified for the GKE control plane.
 	GKEControlPlaneRequiresAtLeastOneNodePoolReason = "GKEControlPlaneRequiresAtLeastOneNodePool"
+	// GKEControlPlaneImmutableFieldsChangedReason used to report fields of the GKE control plane that differ from the
+	// existing cluster but can't be updated.
+	GKEControlPlaneImmutableFieldsChangedReason = "GKEControlPlaneImmutableFieldsChanged"
+	// GKEControlPlaneImportFailedReason used to report that the GKE cluster to import doesn't exist.
+	GKEControlPlaneImportFailedReason = "GKEControlPlaneImportFailed"
+	// GKEControlPlaneFleetRegisteringReason used to report that the GKE cluster is being registered in its fleet.
+	GKEControlPlaneFleetRegisteringReason = "GKEControlPlaneFleetRegistering"
+	// GKEControlPlaneFleetRegistrationFailedReason used to report failures while registering the GKE cluster in its
+	// fleet.
+	GKEControlPlaneFleetRegistrationFailedReason = "GKEControlPlaneFleetRegistrationFailed"
+	// GKEControlPlaneInvalidVersionReason used to report that the version of the GKE control plane isn't available in
+	// its location and release channel, or can't be upgraded to.
+	GKEControlPlaneInvalidVersionReason = "GKEControlPlaneInvalidVersion"
+	// GKEControlPlaneRotationStartingReason used to report that the CA and the control plane IP of the GKE cluster are
+	// being rotated.
+	GKEControlPlaneRotationStartingReason = "GKEControlPlaneRotationStarting"
+	// GKEControlPlaneRotationRecreatingNodesReason used to report that the nodes of the GKE cluster are being recreated
+	// to use the new credentials.
+	GKEControlPlaneRotationRecreatingNodesReason = "GKEControlPlaneRotationRecreatingNodes"
+	// GKEControlPlaneRotationCompletingReason used to report that the old credentials of the GKE cluster are being
+	// removed.
+	GKEControlPlaneRotationCompletingReason = "GKEControlPlaneRotationCompleting"
+	// GKEControlPlaneRotationFailedReason used to report failures while rotating the credentials of the GKE cluster.
+	GKEControlPlaneRotationFailedReason = "GKEControlPlaneRotationFailed"
+	// GKEControlPlaneUpgradeAvailableReason used to report that a new version is available for the GKE control plane.
+	GKEControlPlaneUpgradeAvailableReason = "GKEControlPlaneUpgradeAvailable"
+	// GKEControlPlaneSecurityBulletinReason used to report that a security bulletin affects the GKE cluster.
+	GKEControlPlaneSecurityBulletinReason = "GKEControlPlaneSecurityBulletin"
+	// GKEControlPlaneServerConfigUnavailableReason used to report that the GKE server config could not be fetched.
+	GKEControlPlaneServerConfigUnavailableReason = "GKEControlPlaneServerConfigUnavailable"
 
 	// GKEMachinePoolReadyCondition condition reports on the successful reconciliation of GKE node pool.
 	GKEMachinePoolReadyCondition clusterv1beta1.ConditionType = "GKEMachinePoolReady"
@@ -59,6 +110,11 @@ const (
 	GKEMachinePoolUpdatingCondition clusterv1beta1.ConditionType = "GKEMachinePoolUpdating"
 	// GKEMachinePoolDeletingCondition condition reports on whether the GKE node pool is deleting.
 	GKEMachinePoolDeletingCondition clusterv1beta1.ConditionType = "GKEMachinePoolDeleting"
+	// GKEMachinePoolImportedCondition condition reports on whether the existing GKE node pool has been imported.
+	GKEMachinePoolImportedCondition clusterv1beta1.ConditionType = "GKEMachinePoolImported"
+	// GKEMachinePoolServerConfigAvailableCondition condition reports on whether the GKE server config, which lists the
+	// versions available in the location of the node pool, could be fetched. Without it, versions are resolved by GKE.
+	GKEMachinePoolServerConfigAvailableCondition clusterv1beta1.ConditionType = "GKEMachinePoolServerConfigAvailable"
 
 	// WaitingForGKEControlPlaneReason used when the machine pool is waiting for GKE control plane infrastructure to be ready before proceeding.
 	WaitingForGKEControlPlaneReason = "WaitingForGKEControlPlane"
@@ -72,10 +128,19 @@ const (
 	GKEMachinePoolDeletingReason = "GKEMachinePoolDeleting"
 	// GKEMachinePoolDeletedReason used to report GKE node pool is deleted.
 	GKEMachinePoolDeletedReason = "GKEMachinePoolDeleted"
+	// GKEMachinePoolOrphanedReason used to report GKE node pool is left in place by its deletion policy.
+	GKEMachinePoolOrphanedReason = "GKEMachinePoolOrphaned"
+	// GKEMachinePoolDeletionProtectedReason used to report GKE node pool deletion waits for its control plane to allow deletion.
+	GKEMachinePoolDeletionProtectedReason = "GKEMachinePoolDeletionProtected"
+	// GKEMachinePoolServerConfigUnavailableReason used to report that the GKE server config could not be fetched.
+	GKEMachinePoolServerConfigUnavailableReason = "GKEMachinePoolServerConfigUnavailable"
 	// GKEMachinePoolErrorReason used to report GKE node pool is in error state.
 	GKEMachinePoolErrorReason = "GKEMachinePoolError"
 	// GKEMachinePoolReconciliationFailedReason used to report failures while reconciling GKE node pool.
 	GKEMachinePoolReconciliationFailedReason = "GKEMachinePoolReconciliationFailed"
+	// GKEMachinePoolInvalidVersionReason used to report that the version of the GKE node pool isn't available or
+	// doesn't respect the version skew with the control plane.
+	GKEMachinePoolInvalidVersionReason = "GKEMachinePoolInvalidVersion"
 
 	// MIGReadyCondition reports on current status of the managed instance group. Ready indicates the group is provisioned.
 	MIGReadyCondition clusterv1.ConditionType = "ManagedInstanceGroupReady"
@@ -84,6 +149,32 @@ const (
 	// MIGDeletionInProgress MIG is in a deletion in progress state.
 	MIGDeletionInProgress = "ManagedInstanceGroupDeletionInProgress"
 
+	// MIGUpToDateCondition reports on whether all instances of the managed instance group run the current instance template.
+	MIGUpToDateCondition clusterv1.ConditionType = "ManagedInstanceGroupUpToDate"
+	// MIGUpToDateReason used when all instances run the current instance template and the group is stable.
+	MIGUpToDateReason = "ManagedInstanceGroupUpToDate"
+	// MIGRolloutInProgressReason used while instances are being updated to the current instance template.
+	MIGRolloutInProgressReason = "ManagedInstanceGroupRolloutInProgress"
+	// MIGNotStableReason used when all instances run the current instance template, but the group is still performing actions on them.
+	MIGNotStableReason = "ManagedInstanceGroupNotStable"
+
+	// AutoscalerReadyCondition reports on whether the GCE autoscaler of the managed instance group is active.
+	AutoscalerReadyCondition clusterv1.ConditionType = "AutoscalerReady"
+	// AutoscalerActiveReason used when the autoscaler is active.
+	AutoscalerActiveReason = "AutoscalerActive"
+	// AutoscalerNotActiveReason used when the autoscaler is pending or reports an error.
+	AutoscalerNotActiveReason = "AutoscalerNotActive"
+	// AutoscalerReconcileFailedReason used for failures during autoscaler reconciliation.
+	AutoscalerReconcileFailedReason = "AutoscalerReconcileFailed"
+
+	// InstanceReadyCondition reports on whether the instance of a GCPMachinePoolMachine is running
+	// and the managed instance group is not performing any action on it.
+	InstanceReadyCondition clusterv1.ConditionType = "InstanceReady"
+	// InstanceRunningReason used when the instance is running and not being changed by the managed instance group.
+	InstanceRunningReason = "InstanceRunning"
+	// InstanceNotReadyReason used when the instance is not running, or the managed instance group is performing an action on it.
+	InstanceNotReadyReason = "InstanceNotReady"
+
 	// InstanceTemplateReadyCondition represents the status of an AWSMachinePool's associated Launch Template.
 	InstanceTemplateReadyCondition clusterv1.ConditionType = "InstanceTemplateReady"
 	// InstanceTemplateReconcileFailedReason used for failures during Launch Template reconciliation.
@@ -17,8 +17,10 @@ limitations under the License.
 package v1beta1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	capg "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -28,6 +30,10 @@ import (
 const (
 	// LaunchTemplateLatestVersion defines the launching of the latest version of the template.
 	LaunchTemplateLatestVersion = "$Latest"
+
+	// ReplicasManagedByGCEAutoscaler is the value of the cluster.x-k8s.io/replicas-managed-by annotation
+	// set on the MachinePool when its replicas are managed by the GCE autoscaler.
+	ReplicasManagedByGCEAutoscaler = "gce-autoscaler"
 )
 
 // GCPMachinePoolSpec defines the desired state of GCPMachinePool.
@@ -156,6 +162,487 @@ type GCPMachinePoolSpec struct {
 	// attached to the instance.
 	// +optional
 	GuestAccelerators []capg.Accelerator `json:"guestAccelerators,omitempty"`
+
+	// Strategy defines how the managed instance group replaces existing instances when the
+	// instance template changes, for example after an image or bootstrap data update.
+	// If omitted, existing instances are proactively replaced using the GCP defaults.
+	// +optional
+	Strategy *GCPMachinePoolStrategy `json:"strategy,omitempty"`
+
+	// DistributionTargetShape is the distribution of instances across zones when the machine pool
+	// spans several failure domains, in which case a regional managed instance group is used.
+	// If Even, instances are evenly distributed across zones.
+	// If Balanced, instances are evenly distributed across zones, favoring zones with available resources.
+	// If Any, instances are placed in zones with available resources, without balancing them.
+	// Balanced and Any require proactive instance redistribution to be disabled.
+	// If omitted, GCP defaults to Even.
+	// +kubebuilder:validation:Enum=Even;Balanced;Any
+	// +optional
+	DistributionTargetShape *GCPMachinePoolDistributionTargetShape `json:"distributionTargetShape,omitempty"`
+
+	// InstanceTemplateHistoryLimit is the number of superseded instance templates to keep for rollback,
+	// once the managed instance group has fully moved to the current instance template.
+	// Older instance templates of the machine pool are deleted, unless they are still used by a managed instance group.
+	// Defaults to 2.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InstanceTemplateHistoryLimit *int32 `json:"instanceTemplateHistoryLimit,omitempty"`
+
+	// AutoHealing configures the managed instance group to recreate instances that fail a health check.
+	// The health check is created and deleted together with the machine pool.
+	// If omitted, instances are only recreated when they stop running.
+	// +optional
+	AutoHealing *GCPMachinePoolAutoHealing `json:"autoHealing,omitempty"`
+
+	// Stateful configures the disks and IP addresses preserved when instances are recreated,
+	// for example by autohealing or an update.
+	// Stateful machine pools replace instances with the Recreate replacement method.
+	// +optional
+	Stateful *GCPMachinePoolStatefulPolicy `json:"stateful,omitempty"`
+
+	// Autoscaling configures a GCE autoscaler for the managed instance group.
+	// When set, the autoscaler manages the number of instances: the MachinePool is annotated with
+	// cluster.x-k8s.io/replicas-managed-by and its replicas follow the size of the managed instance group.
+	// +optional
+	Autoscaling *GCPMachinePoolAutoscaling `json:"autoscaling,omitempty"`
+
+	// InstanceSelections lets the managed instance group create instances with other machine types,
+	// for example when Spot capacity for instanceType runs out.
+	// New instances are created from the selection with the lowest rank that has capacity,
+	// machine types with the same rank are equally preferred.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	InstanceSelections []GCPMachinePoolInstanceSelection `json:"instanceSelections,omitempty"`
+
+	// ProvisioningModelMix mixes Standard and Spot instances in the managed instance group.
+	// It requires the Spot provisioning model, Standard instances are created up to the configured capacity.
+	// This feature uses the beta compute API.
+	// +optional
+	ProvisioningModelMix *GCPMachinePoolProvisioningModelMix `json:"provisioningModelMix,omitempty"`
+
+	// TargetSizePolicy configures how the managed instance group creates instances to reach its target size.
+	// It can only be set when the GCPMachinePool is created. This feature uses the beta compute API.
+	// +optional
+	TargetSizePolicy *GCPMachinePoolTargetSizePolicy `json:"targetSizePolicy,omitempty"`
+}
+
+// GCPMachinePoolInstanceSelection is a ranked set of machine types the managed instance group can create instances with.
+type GCPMachinePoolInstanceSelection struct {
+	// Name identifies the instance selection.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// MachineTypes are the machine types of the instance selection. Example: n2-standard-4
+	// +kubebuilder:validation:MinItems=1
+	MachineTypes []string `json:"machineTypes"`
+
+	// Rank is the preference of the instance selection, lower ranks are preferred.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Rank int32 `json:"rank,omitempty"`
+}
+
+// GCPMachinePoolProvisioningModelMix defines the share of Standard instances in a Spot managed instance group.
+type GCPMachinePoolProvisioningModelMix struct {
+	// StandardCapacityBase is the number of instances that are always Standard instances.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StandardCapacityBase *int32 `json:"standardCapacityBase,omitempty"`
+
+	// StandardCapacityPercentAboveBase is the percentage of Standard instances above standardCapacityBase,
+	// the other instances are Spot instances.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	StandardCapacityPercentAboveBase *int32 `json:"standardCapacityPercentAboveBase,omitempty"`
+}
+
+// GCPMachinePoolTargetSizePolicy defines how the managed instance group creates instances.
+type GCPMachinePoolTargetSizePolicy struct {
+	// Mode is Individual to create instances one at a time, as capacity allows, or Bulk to wait
+	// until all the instances needed to reach the target size can be created at once.
+	// +kubebuilder:validation:Enum=Individual;Bulk
+	// +kubebuilder:default=Individual
+	// +optional
+	Mode GCPMachinePoolTargetSizeMode `json:"mode,omitempty"`
+}
+
+// GCPMachinePoolTargetSizeMode defines how the managed instance group creates instances.
+type GCPMachinePoolTargetSizeMode string
+
+const (
+	// IndividualGCPMachinePoolTargetSizeMode creates instances one at a time.
+	IndividualGCPMachinePoolTargetSizeMode GCPMachinePoolTargetSizeMode = "Individual"
+	// BulkGCPMachinePoolTargetSizeMode creates all the instances at once.
+	BulkGCPMachinePoolTargetSizeMode GCPMachinePoolTargetSizeMode = "Bulk"
+)
+
+// GCPMachinePoolAutoscalingMode defines which scaling operations the autoscaler performs.
+type GCPMachinePoolAutoscalingMode string
+
+const (
+	// OnGCPMachinePoolAutoscalingMode scales the managed instance group out and in.
+	OnGCPMachinePoolAutoscalingMode GCPMachinePoolAutoscalingMode = "On"
+	// OnlyScaleOutGCPMachinePoolAutoscalingMode only adds instances to the managed instance group.
+	OnlyScaleOutGCPMachinePoolAutoscalingMode GCPMachinePoolAutoscalingMode = "OnlyScaleOut"
+	// OffGCPMachinePoolAutoscalingMode only computes recommendations, without scaling the managed instance group.
+	OffGCPMachinePoolAutoscalingMode GCPMachinePoolAutoscalingMode = "Off"
+)
+
+// GCPMachinePoolCustomMetricTargetType defines how the target of a custom metric is interpreted.
+type GCPMachinePoolCustomMetricTargetType string
+
+const (
+	// GaugeGCPMachinePoolCustomMetricTargetType targets the average value of the metric across instances.
+	GaugeGCPMachinePoolCustomMetricTargetType GCPMachinePoolCustomMetricTargetType = "Gauge"
+	// DeltaPerSecondGCPMachinePoolCustomMetricTargetType targets the rate of growth of the metric per second.
+	DeltaPerSecondGCPMachinePoolCustomMetricTargetType GCPMachinePoolCustomMetricTargetType = "DeltaPerSecond"
+	// DeltaPerMinuteGCPMachinePoolCustomMetricTargetType targets the rate of growth of the metric per minute.
+	DeltaPerMinuteGCPMachinePoolCustomMetricTargetType GCPMachinePoolCustomMetricTargetType = "DeltaPerMinute"
+)
+
+// GCPMachinePoolAutoscaling describes the autoscaling policy of the managed instance group.
+// If no utilization target is set, GCP scales on a CPU utilization of 60%.
+type GCPMachinePoolAutoscaling struct {
+	// MinReplicas is the minimum number of instances.
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas int32 `json:"minReplicas"`
+
+	// MaxReplicas is the maximum number of instances.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Mode defines which scaling operations the autoscaler performs.
+	// +kubebuilder:validation:Enum=On;OnlyScaleOut;Off
+	// +kubebuilder:default=On
+	// +optional
+	Mode GCPMachinePoolAutoscalingMode `json:"mode,omitempty"`
+
+	// CoolDownPeriodSec is the time in seconds the autoscaler waits before collecting information
+	// from a new instance, and should cover its initialization time.
+	// If omitted, GCP defaults to 60.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	CoolDownPeriodSec *int32 `json:"coolDownPeriodSec,omitempty"`
+
+	// CPUUtilization scales on the average CPU utilization of the instances.
+	// +optional
+	CPUUtilization *GCPMachinePoolCPUUtilization `json:"cpuUtilization,omitempty"`
+
+	// LoadBalancingUtilization scales on the serving capacity of a load balancer backend service
+	// using the managed instance group.
+	// +optional
+	LoadBalancingUtilization *GCPMachinePoolLoadBalancingUtilization `json:"loadBalancingUtilization,omitempty"`
+
+	// CustomMetrics scales on Cloud Monitoring metrics.
+	// +listType=map
+	// +listMapKey=metric
+	// +optional
+	CustomMetrics []GCPMachinePoolCustomMetric `json:"customMetrics,omitempty"`
+
+	// ScaleIn limits how fast the autoscaler removes instances.
+	// +optional
+	ScaleIn *GCPMachinePoolScaleInControl `json:"scaleIn,omitempty"`
+}
+
+// GCPMachinePoolCPUUtilization describes a CPU utilization target.
+type GCPMachinePoolCPUUtilization struct {
+	// TargetPercent is the target average CPU utilization of the instances, in percent.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	TargetPercent int32 `json:"targetPercent"`
+
+	// PredictiveMethod enables predictive autoscaling based on the CPU utilization history.
+	// +kubebuilder:validation:Enum=None;OptimizeAvailability
+	// +optional
+	PredictiveMethod *string `json:"predictiveMethod,omitempty"`
+}
+
+// GCPMachinePoolLoadBalancingUtilization describes a load balancing utilization target.
+type GCPMachinePoolLoadBalancingUtilization struct {
+	// TargetPercent is the target utilization of the serving capacity of the backend service, in percent.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	TargetPercent int32 `json:"targetPercent"`
+}
+
+// GCPMachinePoolCustomMetric describes a Cloud Monitoring metric target.
+type GCPMachinePoolCustomMetric struct {
+	// Metric is the identifier of the Cloud Monitoring metric, for example
+	// custom.googleapis.com/http/requests.
+	// +kubebuilder:validation:MinLength=1
+	Metric string `json:"metric"`
+
+	// Filter is a Cloud Monitoring filter selecting the time series of the metric.
+	// +optional
+	Filter *string `json:"filter,omitempty"`
+
+	// Target is the target value of the metric.
+	// Either target or singleInstanceAssignment must be set.
+	// +optional
+	Target *resource.Quantity `json:"target,omitempty"`
+
+	// TargetType defines how the target is interpreted.
+	// +kubebuilder:validation:Enum=Gauge;DeltaPerSecond;DeltaPerMinute
+	// +optional
+	TargetType *GCPMachinePoolCustomMetricTargetType `json:"targetType,omitempty"`
+
+	// SingleInstanceAssignment is the amount of work each instance can handle, for metrics
+	// that describe the work of the whole group rather than per instance.
+	// +optional
+	SingleInstanceAssignment *resource.Quantity `json:"singleInstanceAssignment,omitempty"`
+}
+
+// GCPMachinePoolScaleInControl limits the number of instances removed within a time window.
+type GCPMachinePoolScaleInControl struct {
+	// MaxScaledInReplicas is the maximum number of instances removed within the time window.
+	// Value can be an absolute number (ex: 5) or a percentage of the recommended size (ex: 10%).
+	MaxScaledInReplicas intstr.IntOrString `json:"maxScaledInReplicas"`
+
+	// TimeWindowSec is the time window in seconds over which maxScaledInReplicas applies.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=3600
+	TimeWindowSec int32 `json:"timeWindowSec"`
+}
+
+// GCPMachinePoolHealthCheckType is the protocol used by an autohealing health check.
+type GCPMachinePoolHealthCheckType string
+
+const (
+	// TCPGCPMachinePoolHealthCheckType checks that a TCP connection can be established.
+	TCPGCPMachinePoolHealthCheckType GCPMachinePoolHealthCheckType = "TCP"
+	// HTTPGCPMachinePoolHealthCheckType checks that an HTTP request returns 200.
+	HTTPGCPMachinePoolHealthCheckType GCPMachinePoolHealthCheckType = "HTTP"
+	// HTTPSGCPMachinePoolHealthCheckType checks that an HTTPS request returns 200.
+	HTTPSGCPMachinePoolHealthCheckType GCPMachinePoolHealthCheckType = "HTTPS"
+)
+
+// GCPMachinePoolAutoHealing describes the autohealing policy of the managed instance group.
+type GCPMachinePoolAutoHealing struct {
+	// HealthCheck defines the health check used to detect unhealthy instances.
+	// +optional
+	HealthCheck GCPMachinePoolHealthCheck `json:"healthCheck,omitempty"`
+
+	// InitialDelaySec is the time in seconds given to a new instance to start before it is checked.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	InitialDelaySec *int32 `json:"initialDelaySec,omitempty"`
+}
+
+// GCPMachinePoolHealthCheck describes the health check used for autohealing.
+// The health check probes come from 35.191.0.0/16 and 130.211.0.0/22. A firewall rule allowing them
+// on the health check port is created along with the health check, unless the cluster uses a shared VPC.
+type GCPMachinePoolHealthCheck struct {
+	// Type is the protocol of the health check.
+	// +kubebuilder:validation:Enum=TCP;HTTP;HTTPS
+	// +kubebuilder:default=TCP
+	// +optional
+	Type GCPMachinePoolHealthCheckType `json:"type,omitempty"`
+
+	// Port is the port checked on the instances.
+	// Defaults to 10250, the kubelet port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// RequestPath is the path of the HTTP or HTTPS request.
+	// Defaults to /.
+	// +optional
+	RequestPath *string `json:"requestPath,omitempty"`
+
+	// CheckIntervalSec is how often in seconds to check an instance.
+	// Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	CheckIntervalSec *int32 `json:"checkIntervalSec,omitempty"`
+
+	// TimeoutSec is how long in seconds to wait before the check fails. It must not exceed checkIntervalSec.
+	// Defaults to 5.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	TimeoutSec *int32 `json:"timeoutSec,omitempty"`
+
+	// HealthyThreshold is the number of consecutive successful checks for an instance to be healthy.
+	// Defaults to 2.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	HealthyThreshold *int32 `json:"healthyThreshold,omitempty"`
+
+	// UnhealthyThreshold is the number of consecutive failed checks for an instance to be unhealthy.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	UnhealthyThreshold *int32 `json:"unhealthyThreshold,omitempty"`
+}
+
+// GCPMachinePoolStatefulAutoDelete defines when a preserved resource is deleted.
+type GCPMachinePoolStatefulAutoDelete string
+
+const (
+	// NeverGCPMachinePoolStatefulAutoDelete never deletes the resource, it is left behind when the instance is deleted.
+	NeverGCPMachinePoolStatefulAutoDelete GCPMachinePoolStatefulAutoDelete = "Never"
+	// OnPermanentInstanceDeletionGCPMachinePoolStatefulAutoDelete deletes the resource when the instance is
+	// permanently deleted, for example on scale down, but preserves it when the instance is recreated.
+	OnPermanentInstanceDeletionGCPMachinePoolStatefulAutoDelete GCPMachinePoolStatefulAutoDelete = "OnPermanentInstanceDeletion"
+)
+
+// GCPMachinePoolStatefulPolicy describes the state preserved by the managed instance group.
+type GCPMachinePoolStatefulPolicy struct {
+	// Disks lists the disks to preserve, by device name.
+	// The boot disk is persistent-disk-0, additional disks are persistent-disk-1, persistent-disk-2 and so on.
+	// +listType=map
+	// +listMapKey=deviceName
+	// +optional
+	Disks []GCPMachinePoolStatefulDisk `json:"disks,omitempty"`
+
+	// InternalIPs lists the network interfaces whose internal IP address is preserved.
+	// +listType=map
+	// +listMapKey=interfaceName
+	// +optional
+	InternalIPs []GCPMachinePoolStatefulIP `json:"internalIPs,omitempty"`
+
+	// ExternalIPs lists the network interfaces whose external IP address is preserved.
+	// +listType=map
+	// +listMapKey=interfaceName
+	// +optional
+	ExternalIPs []GCPMachinePoolStatefulIP `json:"externalIPs,omitempty"`
+}
+
+// GCPMachinePoolStatefulDisk describes a disk preserved by the managed instance group.
+type GCPMachinePoolStatefulDisk struct {
+	// DeviceName is the device name of the disk.
+	// +kubebuilder:validation:MinLength=1
+	DeviceName string `json:"deviceName"`
+
+	// AutoDelete defines when the disk is deleted.
+	// +kubebuilder:validation:Enum=Never;OnPermanentInstanceDeletion
+	// +kubebuilder:default=Never
+	// +optional
+	AutoDelete GCPMachinePoolStatefulAutoDelete `json:"autoDelete,omitempty"`
+}
+
+// GCPMachinePoolStatefulIP describes an IP address preserved by the managed instance group.
+type GCPMachinePoolStatefulIP struct {
+	// InterfaceName is the name of the network interface, for example nic0.
+	// +kubebuilder:validation:MinLength=1
+	InterfaceName string `json:"interfaceName"`
+
+	// AutoDelete defines when the IP address is released.
+	// +kubebuilder:validation:Enum=Never;OnPermanentInstanceDeletion
+	// +kubebuilder:default=Never
+	// +optional
+	AutoDelete GCPMachinePoolStatefulAutoDelete `json:"autoDelete,omitempty"`
+}
+
+// GCPMachinePoolDistributionTargetShape is the distribution of instances across the zones of a regional managed instance group.
+type GCPMachinePoolDistributionTargetShape string
+
+const (
+	// EvenGCPMachinePoolDistributionTargetShape distributes instances evenly across zones.
+	EvenGCPMachinePoolDistributionTargetShape GCPMachinePoolDistributionTargetShape = "Even"
+	// BalancedGCPMachinePoolDistributionTargetShape distributes instances evenly across zones, favoring zones with available resources.
+	BalancedGCPMachinePoolDistributionTargetShape GCPMachinePoolDistributionTargetShape = "Balanced"
+	// AnyGCPMachinePoolDistributionTargetShape places instances in zones with available resources.
+	AnyGCPMachinePoolDistributionTargetShape GCPMachinePoolDistributionTargetShape = "Any"
+)
+
+// GCPMachinePoolStrategyType is the type of update process of the managed instance group.
+type GCPMachinePoolStrategyType string
+
+const (
+	// ProactiveGCPMachinePoolStrategyType updates existing instances to the new instance template.
+	ProactiveGCPMachinePoolStrategyType GCPMachinePoolStrategyType = "Proactive"
+	// OpportunisticGCPMachinePoolStrategyType only applies the new instance template to new instances,
+	// or to existing instances when they are recreated, for example by autohealing.
+	OpportunisticGCPMachinePoolStrategyType GCPMachinePoolStrategyType = "Opportunistic"
+)
+
+// GCPMachinePoolMinimalAction is the action taken on an instance to apply an update.
+type GCPMachinePoolMinimalAction string
+
+const (
+	// NoneGCPMachinePoolMinimalAction does not perform any action.
+	NoneGCPMachinePoolMinimalAction GCPMachinePoolMinimalAction = "None"
+	// RefreshGCPMachinePoolMinimalAction updates the instance without stopping it.
+	RefreshGCPMachinePoolMinimalAction GCPMachinePoolMinimalAction = "Refresh"
+	// RestartGCPMachinePoolMinimalAction stops the instance and starts it again.
+	RestartGCPMachinePoolMinimalAction GCPMachinePoolMinimalAction = "Restart"
+	// ReplaceGCPMachinePoolMinimalAction replaces the instance according to the replacement method.
+	ReplaceGCPMachinePoolMinimalAction GCPMachinePoolMinimalAction = "Replace"
+)
+
+// GCPMachinePoolReplacementMethod is the method used to replace instances.
+type GCPMachinePoolReplacementMethod string
+
+const (
+	// SubstituteGCPMachinePoolReplacementMethod deletes the instance and creates a new one with a new name.
+	SubstituteGCPMachinePoolReplacementMethod GCPMachinePoolReplacementMethod = "Substitute"
+	// RecreateGCPMachinePoolReplacementMethod recreates the instance, preserving its name.
+	RecreateGCPMachinePoolReplacementMethod GCPMachinePoolReplacementMethod = "Recreate"
+)
+
+// GCPMachinePoolInstanceRedistributionType is the instance redistribution policy of a multi-zone managed instance group.
+type GCPMachinePoolInstanceRedistributionType string
+
+const (
+	// ProactiveGCPMachinePoolInstanceRedistributionType keeps instances evenly distributed across zones.
+	ProactiveGCPMachinePoolInstanceRedistributionType GCPMachinePoolInstanceRedistributionType = "Proactive"
+	// NoneGCPMachinePoolInstanceRedistributionType disables proactive redistribution.
+	NoneGCPMachinePoolInstanceRedistributionType GCPMachinePoolInstanceRedistributionType = "None"
+)
+
+// GCPMachinePoolStrategy describes the update policy of the managed instance group.
+type GCPMachinePoolStrategy struct {
+	// Type is the type of update process.
+	// If Proactive, existing instances are updated to the new instance template.
+	// If Opportunistic, the new instance template is only applied to instances that are
+	// created or recreated.
+	// +kubebuilder:validation:Enum=Proactive;Opportunistic
+	// +kubebuilder:default=Proactive
+	// +optional
+	Type GCPMachinePoolStrategyType `json:"type,omitempty"`
+
+	// MaxSurge is the maximum number of instances that can be created above the target size
+	// during the update. Value can be an absolute number (ex: 5) or a percentage of the target
+	// size (ex: 10%). Percentages are only allowed for groups of 10 or more instances.
+	// If omitted, GCP defaults to the number of zones the group operates in.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the maximum number of instances that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of the target size (ex: 10%).
+	// Percentages are only allowed for groups of 10 or more instances.
+	// If omitted, GCP defaults to the number of zones the group operates in.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MinimalAction is the minimal action taken on an instance to apply an update.
+	// If omitted, GCP defaults to Replace.
+	// +kubebuilder:validation:Enum=None;Refresh;Restart;Replace
+	// +optional
+	MinimalAction *GCPMachinePoolMinimalAction `json:"minimalAction,omitempty"`
+
+	// ReplacementMethod is the method used to replace instances.
+	// If Recreate, instance names are preserved and maxSurge must be 0, which is its default.
+	// If omitted, GCP defaults to Substitute.
+	// +kubebuilder:validation:Enum=Substitute;Recreate
+	// +optional
+	ReplacementMethod *GCPMachinePoolReplacementMethod `json:"replacementMethod,omitempty"`
+
+	// InstanceRedistributionType is the instance redistribution policy for groups spanning multiple zones.
+	// If omitted, GCP defaults to Proactive.
+	// +kubebuilder:validation:Enum=Proactive;None
+	// +optional
+	InstanceRedistributionType *GCPMachinePoolInstanceRedistributionType `json:"instanceRedistributionType,omitempty"`
 }
 
 // GCPMachinePoolStatus defines the observed state of GCPMachinePool.
@@ -171,6 +658,51 @@ type GCPMachinePoolStatus struct {
 	// Conditions defines current service state of the GCPMachinePool.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// InfrastructureMachineKind is the kind of the infrastructure resources behind MachinePool Machines.
+	// +optional
+	InfrastructureMachineKind string `json:"infrastructureMachineKind,omitempty"`
+
+	// Autoscaler is the observed state of the GCE autoscaler, when autoscaling is enabled.
+	// +optional
+	Autoscaler *GCPMachinePoolAutoscalerStatus `json:"autoscaler,omitempty"`
+
+	// MachineTypes reports the number of instances running per machine type and provisioning model.
+	// +optional
+	MachineTypes []GCPMachinePoolMachineTypeStatus `json:"machineTypes,omitempty"`
+
+	// InstanceGroupManager is the instanceGroupManager of the machine pool, in the format
+	// zones/{zone}/instanceGroupManagers/{name} or regions/{region}/instanceGroupManagers/{name}. It is used to
+	// delete the previous instanceGroupManager when the machine pool moves between a single zone and several zones.
+	// +optional
+	InstanceGroupManager string `json:"instanceGroupManager,omitempty"`
+}
+
+// GCPMachinePoolMachineTypeStatus is the number of instances of a machine type and provisioning model.
+type GCPMachinePoolMachineTypeStatus struct {
+	// MachineType is the machine type of the instances.
+	MachineType string `json:"machineType"`
+
+	// ProvisioningModel is the provisioning model of the instances.
+	ProvisioningModel capg.ProvisioningModel `json:"provisioningModel"`
+
+	// Replicas is the number of instances.
+	Replicas int32 `json:"replicas"`
+}
+
+// GCPMachinePoolAutoscalerStatus describes the observed state of the GCE autoscaler.
+type GCPMachinePoolAutoscalerStatus struct {
+	// RecommendedReplicas is the number of instances recommended by the autoscaler.
+	// +optional
+	RecommendedReplicas int32 `json:"recommendedReplicas"`
+
+	// Status is the status of the autoscaler, one of PENDING, ACTIVE, ERROR or DELETING.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Message contains the details reported by the autoscaler, for example why it cannot scale.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capg "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// MachinePoolMachineFinalizer allows ReconcileGCPMachinePoolMachine to clean up the instance
+	// of the managed instance group before removing it from the apiserver.
+	MachinePoolMachineFinalizer = "gcpmachinepoolmachine.infrastructure.cluster.x-k8s.io"
+)
+
+// GCPMachinePoolMachineSpec defines the desired state of GCPMachinePoolMachine.
+type GCPMachinePoolMachineSpec struct {
+	// ProviderID is the identification ID of the instance, in the form gce://<project>/<zone>/<name>.
+	ProviderID string `json:"providerID"`
+
+	// InstanceID is the unique identifier of the instance, assigned by GCP.
+	// +optional
+	InstanceID string `json:"instanceID,omitempty"`
+}
+
+// GCPMachinePoolMachineStatus defines the observed state of GCPMachinePoolMachine.
+type GCPMachinePoolMachineStatus struct {
+	// Ready is true when the instance is running and the managed instance group is not performing any action on it.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Zone is the zone of the instance.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// InstanceStatus is the status of the instance.
+	// +optional
+	InstanceStatus *capg.InstanceStatus `json:"instanceStatus,omitempty"`
+
+	// CurrentAction is the action the managed instance group is currently performing on the instance,
+	// for example CREATING, RECREATING or DELETING. NONE if the instance is not being changed.
+	// +optional
+	CurrentAction string `json:"currentAction,omitempty"`
+
+	// InstanceTemplate is the name of the instance template the instance was created from.
+	// +optional
+	InstanceTemplate string `json:"instanceTemplate,omitempty"`
+
+	// LatestModelApplied is true when the instance runs the current instance template of the machine pool.
+	// +optional
+	LatestModelApplied bool `json:"latestModelApplied"`
+
+	// Addresses contains the addresses of the instance.
+	// +optional
+	Addresses []corev1.NodeAddress `json:"addresses,omitempty"`
+
+	// Conditions defines current service state of the GCPMachinePoolMachine.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=gcpmachinepoolmachines,scope=Namespaced,categories=cluster-api,shortName=gcpmpm
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Instance ready status"
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".status.zone",description="Zone of the instance"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.instanceStatus",description="GCE instance state"
+// +kubebuilder:printcolumn:name="Latest",type="string",JSONPath=".status.latestModelApplied",description="Instance runs the current instance template"
+// +kubebuilder:printcolumn:name="ProviderID",type="string",JSONPath=".spec.providerID",description="Provider ID",priority=1
+
+// GCPMachinePoolMachine is the Schema for the gcpmachinepoolmachines API.
+// It represents a single instance of the managed instance group backing a GCPMachinePool.
+type GCPMachinePoolMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GCPMachinePoolMachineSpec   `json:"spec,omitempty"`
+	Status GCPMachinePoolMachineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// GCPMachinePoolMachineList contains a list of GCPMachinePoolMachine.
+type GCPMachinePoolMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPMachinePoolMachine `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GCPMachinePoolMachine{}, &GCPMachinePoolMachineList{})
+}
+
+// GCPMachinePoolMachine implements the conditions.Setter interface.
+var _ conditions.Setter = &GCPMachinePoolMachine{}
+
+// SetConditions sets conditions for a GCPMachinePoolMachine.
+func (r *GCPMachinePoolMachine) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+// GetConditions gets conditions for a GCPMachinePoolMachine.
+func (r *GCPMachinePoolMachine) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
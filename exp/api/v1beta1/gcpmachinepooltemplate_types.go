@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+// GCPMachinePoolTemplateSpec defines the desired state of GCPMachinePoolTemplate.
+type GCPMachinePoolTemplateSpec struct {
+	Template GCPMachinePoolTemplateResource `json:"template"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=gcpmachinepooltemplates,scope=Namespaced,categories=cluster-api,shortName=gcpmpt
+// +kubebuilder:storageversion
+
+// GCPMachinePoolTemplate is the Schema for the gcpmachinepooltemplates API.
+type GCPMachinePoolTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GCPMachinePoolTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GCPMachinePoolTemplateList contains a list of GCPMachinePoolTemplates.
+type GCPMachinePoolTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPMachinePoolTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GCPMachinePoolTemplate{}, &GCPMachinePoolTemplateList{})
+}
+
+// GCPMachinePoolTemplateResource describes the data needed to create a GCPMachinePool from a template.
+type GCPMachinePoolTemplateResource struct {
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	ObjectMeta clusterv1beta1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the desired behavior of the machine pool.
+	Spec GCPMachinePoolSpec `json:"spec"`
+}
@@ -29,6 +29,13 @@ const (
 	// ManagedControlPlaneFinalizer allows Reconcile to clean up GCP resources associated with the GCPManagedControlPlane before
 	// removing it from the apiserver.
 	ManagedControlPlaneFinalizer = "gcpmanagedcontrolplane.infrastructure.cluster.x-k8s.io"
+
+	// AllowDeletionAnnotation allows the deletion of a GCPManagedControlPlane with deletion protection enabled.
+	AllowDeletionAnnotation = "infrastructure.cluster.x-k8s.io/allow-deletion"
+
+	// RotateCredentialsAnnotation requests a rotation of the CA and control plane IP of the GKE cluster. A rotation is
+	// started whenever its value differs from the ID of the last rotation, for example a timestamp.
+	RotateCredentialsAnnotation = "infrastructure.cluster.x-k8s.io/rotate-credentials"
 )
 
 // PrivateCluster defines a private Cluster.
@@ -95,8 +102,94 @@ type ClusterNetwork struct {
 	// Service defines the range of CIDRBlock list from where it gets the IP address.
 	// +optional
 	Service *ClusterNetworkService `json:"service,omitempty"`
+
+	// DatapathProvider is the datapath provider of the cluster. advanced_datapath enables GKE Dataplane V2.
+	// If unspecified, the legacy datapath is used. This setting is permanent.
+	// +optional
+	DatapathProvider *DatapathProvider `json:"datapathProvider,omitempty"`
+
+	// EnableNetworkPolicy enables network policy enforcement with Calico. It can't be enabled with
+	// advanced_datapath, which enforces network policies natively.
+	// +optional
+	EnableNetworkPolicy *bool `json:"enableNetworkPolicy,omitempty"`
+
+	// EnableIntraNodeVisibility makes the traffic between pods on the same node visible to the VPC network.
+	// +optional
+	EnableIntraNodeVisibility *bool `json:"enableIntraNodeVisibility,omitempty"`
+
+	// DNS defines the DNS provider of the cluster.
+	// +optional
+	DNS *ClusterDNS `json:"dns,omitempty"`
+
+	// GatewayAPIChannel is the Gateway API release channel of the cluster.
+	// +optional
+	GatewayAPIChannel *GatewayAPIChannel `json:"gatewayAPIChannel,omitempty"`
+
+	// EnableMultiNetworking enables multiple networks for pods. It requires advanced_datapath.
+	// +optional
+	EnableMultiNetworking *bool `json:"enableMultiNetworking,omitempty"`
+}
+
+// DatapathProvider is the datapath provider of the GKE cluster.
+// +kubebuilder:validation:Enum=legacy_datapath;advanced_datapath
+type DatapathProvider string
+
+const (
+	// LegacyDatapath uses the IPTables-based kube-proxy implementation.
+	LegacyDatapath DatapathProvider = "legacy_datapath"
+	// AdvancedDatapath uses the eBPF-based GKE Dataplane V2.
+	AdvancedDatapath DatapathProvider = "advanced_datapath"
+)
+
+// ClusterDNSProvider is the DNS provider of the GKE cluster.
+// +kubebuilder:validation:Enum=platform_default;cloud_dns;kube_dns
+type ClusterDNSProvider string
+
+const (
+	// ClusterDNSProviderPlatformDefault uses the GKE default DNS provider.
+	ClusterDNSProviderPlatformDefault ClusterDNSProvider = "platform_default"
+	// ClusterDNSProviderCloudDNS uses Cloud DNS for the cluster DNS.
+	ClusterDNSProviderCloudDNS ClusterDNSProvider = "cloud_dns"
+	// ClusterDNSProviderKubeDNS uses kube-dns for the cluster DNS.
+	ClusterDNSProviderKubeDNS ClusterDNSProvider = "kube_dns"
+)
+
+// ClusterDNSScope is the scope of the Cloud DNS records of the GKE cluster.
+// +kubebuilder:validation:Enum=cluster_scope;vpc_scope
+type ClusterDNSScope string
+
+const (
+	// ClusterDNSScopeCluster makes the DNS records resolvable from within the cluster only.
+	ClusterDNSScopeCluster ClusterDNSScope = "cluster_scope"
+	// ClusterDNSScopeVPC makes the DNS records resolvable from the whole VPC network.
+	ClusterDNSScopeVPC ClusterDNSScope = "vpc_scope"
+)
+
+// ClusterDNS defines the DNS configuration of the GKE cluster.
+type ClusterDNS struct {
+	// Provider is the DNS provider of the cluster.
+	Provider ClusterDNSProvider `json:"provider"`
+
+	// Scope is the scope of the DNS records when the provider is cloud_dns.
+	// +optional
+	Scope ClusterDNSScope `json:"scope,omitempty"`
+
+	// Domain is the cluster DNS domain. It is required when the scope is vpc_scope.
+	// +optional
+	Domain string `json:"domain,omitempty"`
 }
 
+// GatewayAPIChannel is the Gateway API release channel of the GKE cluster.
+// +kubebuilder:validation:Enum=disabled;standard
+type GatewayAPIChannel string
+
+const (
+	// GatewayAPIChannelDisabled disables the Gateway API.
+	GatewayAPIChannelDisabled GatewayAPIChannel = "disabled"
+	// GatewayAPIChannelStandard installs the standard Gateway API resources.
+	GatewayAPIChannelStandard GatewayAPIChannel = "standard"
+)
+
 // WorkloadIdentityConfig allows workloads in your GKE clusters to impersonate Identity and Access Management (IAM)
 // service accounts to access Google Cloud services.
 type WorkloadIdentityConfig struct {
@@ -135,6 +228,224 @@ type ClusterSecurity struct {
 	// AuthenticatorGroupConfig is RBAC security group for use with Google security groups in Kubernetes RBAC.
 	// +optional
 	AuthenticatorGroupConfig *AuthenticatorGroupConfig `json:"authenticatorGroupConfig,omitempty"`
+
+	// DatabaseEncryption configures the application-layer encryption of the Kubernetes secrets stored in etcd
+	// with a Cloud KMS key.
+	// +optional
+	DatabaseEncryption *DatabaseEncryption `json:"databaseEncryption,omitempty"`
+}
+
+// DatabaseEncryptionState is the state of the application-layer secrets encryption.
+// +kubebuilder:validation:Enum=encrypted;decrypted
+type DatabaseEncryptionState string
+
+const (
+	// DatabaseEncryptionStateEncrypted encrypts the secrets with the Cloud KMS key.
+	DatabaseEncryptionStateEncrypted DatabaseEncryptionState = "encrypted"
+	// DatabaseEncryptionStateDecrypted stores the secrets without application-layer encryption.
+	DatabaseEncryptionStateDecrypted DatabaseEncryptionState = "decrypted"
+)
+
+// DatabaseEncryption defines the application-layer secrets encryption of the GKE cluster.
+type DatabaseEncryption struct {
+	// State is the desired state of the secrets encryption.
+	// +kubebuilder:default=encrypted
+	// +optional
+	State DatabaseEncryptionState `json:"state,omitempty"`
+	// KeyName is the Cloud KMS key used to encrypt the secrets, in the format
+	// projects/[PROJECT]/locations/[LOCATION]/keyRings/[RING]/cryptoKeys/[KEY]. It is required when the state is
+	// encrypted.
+	// +optional
+	KeyName string `json:"keyName,omitempty"`
+}
+
+// DatabaseEncryptionStatus reports the application-layer secrets encryption of the GKE cluster.
+type DatabaseEncryptionStatus struct {
+	// State is the current state of the secrets encryption, for example encrypted or encryption_pending.
+	// +optional
+	State string `json:"state,omitempty"`
+	// KeyName is the Cloud KMS key used to encrypt the secrets.
+	// +optional
+	KeyName string `json:"keyName,omitempty"`
+	// LastOperationError is the error of the last failed encryption or decryption operation.
+	// +optional
+	LastOperationError string `json:"lastOperationError,omitempty"`
+}
+
+// Fleet defines the registration of the GKE cluster in a fleet.
+type Fleet struct {
+	// Project is the fleet host project where the cluster is registered. If unspecified, the project of the cluster
+	// is used.
+	// +optional
+	Project string `json:"project,omitempty"`
+	// MembershipName is the name of the fleet membership of the cluster. If unspecified, the cluster name is used.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	MembershipName string `json:"membershipName,omitempty"`
+	// ConnectGatewayKubeconfig creates the <cluster>-connect-gateway-kubeconfig secret, which reaches the cluster
+	// through the Connect Gateway of the fleet instead of its endpoint.
+	// +optional
+	ConnectGatewayKubeconfig bool `json:"connectGatewayKubeconfig,omitempty"`
+}
+
+// NotificationType is a type of notification of the GKE cluster.
+// +kubebuilder:validation:Enum=UpgradeAvailableEvent;UpgradeEvent;SecurityBulletinEvent;UpgradeInfoEvent
+type NotificationType string
+
+const (
+	// UpgradeAvailableNotification is published when a new version is available in the release channel of the cluster.
+	UpgradeAvailableNotification NotificationType = "UpgradeAvailableEvent"
+	// UpgradeNotification is published when an upgrade of the control plane or of a node pool starts.
+	UpgradeNotification NotificationType = "UpgradeEvent"
+	// SecurityBulletinNotification is published when a security bulletin affects the cluster.
+	SecurityBulletinNotification NotificationType = "SecurityBulletinEvent"
+	// UpgradeInfoNotification is published with the progress of the upgrades of the cluster.
+	UpgradeInfoNotification NotificationType = "UpgradeInfoEvent"
+)
+
+// Notifications defines the publication of the notifications of the GKE cluster to Pub/Sub.
+type Notifications struct {
+	// Topic is the Pub/Sub topic the notifications are published to, in the format projects/{project}/topics/{topic}.
+	// The notifications are disabled when empty.
+	// +kubebuilder:validation:Pattern=`^(projects/[^/]+/topics/[^/]+)?$`
+	// +optional
+	Topic string `json:"topic,omitempty"`
+	// Filter lists the types of notifications to publish. All the types are published when empty.
+	// +optional
+	Filter []NotificationType `json:"filter,omitempty"`
+}
+
+// CredentialRotationPhase is the phase of a credential rotation of the GKE cluster.
+type CredentialRotationPhase string
+
+const (
+	// CredentialRotationStarting means the CA and the control plane IP of the cluster are being rotated.
+	CredentialRotationStarting CredentialRotationPhase = "Starting"
+	// CredentialRotationRecreatingNodes means the nodes are being recreated to use the new credentials and IP.
+	CredentialRotationRecreatingNodes CredentialRotationPhase = "RecreatingNodes"
+	// CredentialRotationCompleting means the old credentials and IP of the control plane are being removed.
+	CredentialRotationCompleting CredentialRotationPhase = "Completing"
+	// CredentialRotationCompleted means the cluster only uses the new credentials and IP.
+	CredentialRotationCompleted CredentialRotationPhase = "Completed"
+)
+
+// CredentialRotationStatus reports the progress of a credential rotation of the GKE cluster.
+type CredentialRotationStatus struct {
+	// ID is the value of the rotate-credentials annotation that requested the rotation.
+	ID string `json:"id"`
+	// Phase is the current phase of the rotation.
+	Phase CredentialRotationPhase `json:"phase"`
+	// RecreatedNodePools lists the node pools whose nodes were recreated during the rotation.
+	// +optional
+	RecreatedNodePools []string `json:"recreatedNodePools,omitempty"`
+	// StartTime is when the rotation was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the rotation was completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// ClusterAddons defines the add-ons of the GKE cluster. Each add-on is enabled when set to true and disabled when
+// set to false.
+type ClusterAddons struct {
+	// HTTPLoadBalancing enables the HTTP (L7) load balancing controller, used by Ingress.
+	// +optional
+	HTTPLoadBalancing *bool `json:"httpLoadBalancing,omitempty"`
+	// NetworkPolicy enables the network policy add-on on the control plane. Network policy enforcement on the nodes
+	// additionally requires the network policy to be enabled on the cluster network.
+	// +optional
+	NetworkPolicy *bool `json:"networkPolicy,omitempty"`
+	// GCEPersistentDiskCSIDriver enables the Compute Engine Persistent Disk CSI driver.
+	// +optional
+	GCEPersistentDiskCSIDriver *bool `json:"gcePersistentDiskCsiDriver,omitempty"`
+	// GCPFilestoreCSIDriver enables the Filestore CSI driver.
+	// +optional
+	GCPFilestoreCSIDriver *bool `json:"gcpFilestoreCsiDriver,omitempty"`
+	// GCSFuseCSIDriver enables the Cloud Storage FUSE CSI driver.
+	// +optional
+	GCSFuseCSIDriver *bool `json:"gcsFuseCsiDriver,omitempty"`
+	// ConfigConnector enables Config Connector. It requires workload identity to be configured.
+	// +optional
+	ConfigConnector *bool `json:"configConnector,omitempty"`
+	// GKEBackupAgent enables the Backup for GKE agent.
+	// +optional
+	GKEBackupAgent *bool `json:"gkeBackupAgent,omitempty"`
+	// DNSCache enables NodeLocal DNSCache.
+	// +optional
+	DNSCache *bool `json:"dnsCache,omitempty"`
+	// RayOperator enables the Ray operator.
+	// +optional
+	RayOperator *bool `json:"rayOperator,omitempty"`
+}
+
+// AutoscalingProfile is the profile of the cluster autoscaler of the GKE cluster.
+// +kubebuilder:validation:Enum=balanced;optimize_utilization
+type AutoscalingProfile string
+
+const (
+	// AutoscalingProfileBalanced keeps spare capacity to schedule new pods faster.
+	AutoscalingProfileBalanced AutoscalingProfile = "balanced"
+	// AutoscalingProfileOptimizeUtilization removes underutilized nodes more aggressively.
+	AutoscalingProfileOptimizeUtilization AutoscalingProfile = "optimize_utilization"
+)
+
+// ClusterAutoscaling defines the cluster-wide autoscaling of the GKE cluster.
+type ClusterAutoscaling struct {
+	// EnableNodeAutoprovisioning enables node auto-provisioning, which creates and deletes node pools based on the
+	// requirements of the pending pods. It requires resource limits for cpu and memory.
+	// +optional
+	EnableNodeAutoprovisioning bool `json:"enableNodeAutoprovisioning,omitempty"`
+	// ResourceLimits limits the total amount of resources in the cluster. The resource type can be cpu, memory
+	// (in GB) or a GPU type such as nvidia-tesla-t4.
+	// +listType=map
+	// +listMapKey=resourceType
+	// +optional
+	ResourceLimits []ResourceLimit `json:"resourceLimits,omitempty"`
+	// AutoscalingProfile defines how the cluster autoscaler removes nodes.
+	// If unspecified, the balanced profile is used.
+	// +optional
+	AutoscalingProfile *AutoscalingProfile `json:"autoscalingProfile,omitempty"`
+	// AutoprovisioningNodePoolDefaults defines the settings of the node pools created by node auto-provisioning.
+	// +optional
+	AutoprovisioningNodePoolDefaults *AutoprovisioningNodePoolDefaults `json:"autoprovisioningNodePoolDefaults,omitempty"`
+	// AutoprovisioningLocations is the list of zones in which node auto-provisioning can create node pools.
+	// If unspecified, the zones of the cluster are used.
+	// +optional
+	AutoprovisioningLocations []string `json:"autoprovisioningLocations,omitempty"`
+}
+
+// ResourceLimit defines the minimum and maximum amount of a resource in the GKE cluster.
+type ResourceLimit struct {
+	// ResourceType is the name of the resource: cpu, memory or a GPU type.
+	// +kubebuilder:validation:MinLength=1
+	ResourceType string `json:"resourceType"`
+	// Minimum is the minimum amount of the resource in the cluster.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Minimum int64 `json:"minimum,omitempty"`
+	// Maximum is the maximum amount of the resource in the cluster.
+	// +kubebuilder:validation:Minimum=0
+	Maximum int64 `json:"maximum"`
+}
+
+// AutoprovisioningNodePoolDefaults defines the settings of the node pools created by node auto-provisioning.
+type AutoprovisioningNodePoolDefaults struct {
+	// ServiceAccount is the email of the Google Cloud service account used by the nodes.
+	// If unspecified, the Compute Engine default service account is used.
+	// +optional
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// OAuthScopes is the list of OAuth scopes available to the nodes.
+	// +optional
+	OAuthScopes []string `json:"oauthScopes,omitempty"`
+	// ImageType is the image type of the nodes.
+	// +optional
+	ImageType string `json:"imageType,omitempty"`
+	// BootDiskKMSKey is the Cloud KMS key used to encrypt the boot disks of the nodes, in the format
+	// projects/[KEY_PROJECT_ID]/locations/[LOCATION]/keyRings/[RING_NAME]/cryptoKeys/[KEY_NAME].
+	// +optional
+	BootDiskKMSKey string `json:"bootDiskKmsKey,omitempty"`
 }
 
 // GCPManagedControlPlaneSpec defines the desired state of GCPManagedControlPlane.
@@ -147,6 +458,20 @@ type GCPManagedControlPlaneSpec struct {
 	// +optional
 	ClusterName string `json:"clusterName,omitempty"`
 
+	// Import adopts the existing GKE cluster named ClusterName instead of creating a new one. The settings of the
+	// cluster and of its node pools that are not specified are read into the spec when the cluster is imported, and
+	// the cluster is orphaned on deletion unless DeletionPolicy is Delete.
+	// +optional
+	Import bool `json:"import,omitempty"`
+
+	// Fleet registers the GKE cluster in a fleet. The cluster is unregistered when the field is removed.
+	// +optional
+	Fleet *Fleet `json:"fleet,omitempty"`
+
+	// Notifications publishes the upgrade and security notifications of the GKE cluster to Pub/Sub.
+	// +optional
+	Notifications *Notifications `json:"notifications,omitempty"`
+
 	// Description describe the cluster.
 	// +optional
 	Description string `json:"description,omitempty"`
@@ -161,6 +486,8 @@ type GCPManagedControlPlaneSpec struct {
 	ControlPlaneVersion *string `json:"controlPlaneVersion,omitempty"`
 
 	// Version represents the control plane version of the GKE cluster.
+	// It is either a GKE version like 1.30.5-gke.1014000, a Kubernetes version
+	// like 1.30.5, a minor version like 1.30, or latest.
 	// If not specified, the default version currently supported by GKE will be
 	// used.
 	// +optional
@@ -196,6 +523,27 @@ type GCPManagedControlPlaneStatus struct {
 	// Version represents the version of the GKE control plane.
 	// +optional
 	Version *string `json:"version,omitempty"`
+
+	// DatabaseEncryption reports the application-layer secrets encryption of the GKE cluster.
+	// +optional
+	DatabaseEncryption *DatabaseEncryptionStatus `json:"databaseEncryption,omitempty"`
+
+	// FleetMembership is the full name of the fleet membership of the GKE cluster.
+	// +optional
+	FleetMembership string `json:"fleetMembership,omitempty"`
+
+	// UpgradeTargets lists the versions the GKE control plane can be upgraded to in its location and release channel.
+	// +optional
+	UpgradeTargets []string `json:"upgradeTargets,omitempty"`
+
+	// CredentialRotation reports the progress of the last credential rotation of the GKE cluster.
+	// +optional
+	CredentialRotation *CredentialRotationStatus `json:"credentialRotation,omitempty"`
+
+	// ManagedResourceLabels lists the keys of the resource labels of the GKE cluster set by CAPG. Only these labels
+	// are removed once they aren't desired anymore, the labels set by other tools are left as they are.
+	// +optional
+	ManagedResourceLabels []string `json:"managedResourceLabels,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -226,6 +574,20 @@ type GCPManagedControlPlaneList struct {
 	Items           []GCPManagedControlPlane `json:"items"`
 }
 
+// UserKubeconfigMode defines how the user kubeconfig authenticates to the GKE cluster.
+// +kubebuilder:validation:Enum=exec;token
+type UserKubeconfigMode string
+
+const (
+	// UserKubeconfigModeExec uses the gke-gcloud-auth-plugin exec credential plugin, which authenticates with the
+	// Google credentials of the user running kubectl.
+	UserKubeconfigModeExec UserKubeconfigMode = "exec"
+	// UserKubeconfigModeToken embeds the short-lived access token of the CAPI kubeconfig, which is refreshed before it
+	// expires. It is meant for clients that can't run the exec plugin. The token grants the cloud-platform scoped
+	// permissions of the controller credentials, not only access to the cluster.
+	UserKubeconfigModeToken UserKubeconfigMode = "token"
+)
+
 // ReleaseChannel is the release channel of the GKE cluster
 // +kubebuilder:validation:Enum=rapid;regular;stable;extended
 type ReleaseChannel string
@@ -241,6 +603,65 @@ const (
 	Extended ReleaseChannel = "extended"
 )
 
+// MaintenancePolicy defines the maintenance policy of the GKE cluster.
+type MaintenancePolicy struct {
+	// DailyMaintenanceWindow specifies a daily maintenance window.
+	// Only one of dailyMaintenanceWindow and recurringWindow can be set.
+	// +optional
+	DailyMaintenanceWindow *DailyMaintenanceWindow `json:"dailyMaintenanceWindow,omitempty"`
+	// RecurringWindow specifies a maintenance window that recurs according to an RRULE.
+	// Only one of dailyMaintenanceWindow and recurringWindow can be set.
+	// +optional
+	RecurringWindow *RecurringMaintenanceWindow `json:"recurringWindow,omitempty"`
+	// MaintenanceExclusions specifies time windows during which automatic maintenance is restricted.
+	// +optional
+	MaintenanceExclusions []MaintenanceExclusion `json:"maintenanceExclusions,omitempty"`
+}
+
+// DailyMaintenanceWindow is a daily maintenance window of four hours.
+type DailyMaintenanceWindow struct {
+	// StartTime is the time the window starts each day, in the "HH:MM" format (UTC).
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+}
+
+// RecurringMaintenanceWindow is a maintenance window that recurs according to an RRULE.
+type RecurringMaintenanceWindow struct {
+	// StartTime is the start time of the first occurrence of the window.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the end time of the first occurrence of the window.
+	// The duration of every occurrence is the difference between endTime and startTime.
+	EndTime metav1.Time `json:"endTime"`
+	// Recurrence is an RFC 5545 RRULE describing how the window recurs,
+	// for example FREQ=WEEKLY;BYDAY=SA,SU.
+	Recurrence string `json:"recurrence"`
+}
+
+// MaintenanceExclusionScope is the scope of the automatic maintenance restricted by a maintenance exclusion.
+// +kubebuilder:validation:Enum=no_upgrades;no_minor_upgrades
+type MaintenanceExclusionScope string
+
+const (
+	// MaintenanceExclusionScopeNoUpgrades prevents all upgrades, including patch upgrades.
+	MaintenanceExclusionScopeNoUpgrades MaintenanceExclusionScope = "no_upgrades"
+	// MaintenanceExclusionScopeNoMinorUpgrades prevents minor upgrades but allows patch upgrades.
+	MaintenanceExclusionScopeNoMinorUpgrades MaintenanceExclusionScope = "no_minor_upgrades"
+)
+
+// MaintenanceExclusion is a time window during which automatic maintenance is restricted.
+type MaintenanceExclusion struct {
+	// Name identifies the maintenance exclusion.
+	Name string `json:"name"`
+	// StartTime is the time the exclusion starts.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the time the exclusion ends.
+	EndTime metav1.Time `json:"endTime"`
+	// Scope specifies which upgrades are prevented during the exclusion.
+	// +kubebuilder:default=no_upgrades
+	// +optional
+	Scope MaintenanceExclusionScope `json:"scope,omitempty"`
+}
+
 // MasterAuthorizedNetworksConfig contains configuration options for the master authorized networks feature.
 // Enabled master authorized networks will disallow all external traffic to access
 // Kubernetes master through HTTPS except traffic from the given CIDR blocks,
@@ -310,6 +731,12 @@ func (r *GCPManagedControlPlane) SetConditions(conditions clusterv1beta1.Conditi
 	r.Status.Conditions = conditions
 }
 
+// DeletionProtected returns whether the deletion of the GCPManagedControlPlane is rejected, which is the case when
+// deletion protection is enabled and the allow deletion annotation isn't set.
+func (r *GCPManagedControlPlane) DeletionProtected() bool {
+	return r.Spec.DeletionProtection && r.Annotations[AllowDeletionAnnotation] != "true"
+}
+
 func init() {
 	SchemeBuilder.Register(&GCPManagedControlPlane{}, &GCPManagedControlPlaneList{})
 }
@@ -123,6 +123,22 @@ type GCPManagedMachinePoolStatus struct {
 	// InfrastructureMachineKind is the kind of the infrastructure resources behind MachinePool Machines.
 	// +optional
 	InfrastructureMachineKind string `json:"infrastructureMachineKind,omitempty"`
+	// BlueGreenUpgrade reports the progress of an ongoing blue-green upgrade of the node pool.
+	// +optional
+	BlueGreenUpgrade *NodePoolBlueGreenUpgradeStatus `json:"blueGreenUpgrade,omitempty"`
+}
+
+// NodePoolBlueGreenUpgradeStatus describes the progress of a blue-green upgrade.
+type NodePoolBlueGreenUpgradeStatus struct {
+	// Phase is the current phase of the upgrade, for example CREATING_GREEN_POOL, DRAINING_BLUE_POOL
+	// or NODE_POOL_SOAKING.
+	Phase string `json:"phase"`
+	// GreenPoolVersion is the Kubernetes version of the green pool.
+	// +optional
+	GreenPoolVersion string `json:"greenPoolVersion,omitempty"`
+	// BluePoolDeletionStartTime is the time at which the blue pool will be deleted to complete the upgrade.
+	// +optional
+	BluePoolDeletionStartTime *metav1.Time `json:"bluePoolDeletionStartTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -183,6 +199,160 @@ type NodePoolManagement struct {
 // ManagedNodePoolLocationPolicy specifies the location policy of the node pool when autoscaling is enabled.
 type ManagedNodePoolLocationPolicy string
 
+// NodePoolUpgradeStrategy is the strategy used to upgrade the nodes of a node pool.
+type NodePoolUpgradeStrategy string
+
+const (
+	// NodePoolUpgradeStrategySurge upgrades nodes in place in a rolling fashion, creating surge nodes.
+	NodePoolUpgradeStrategySurge NodePoolUpgradeStrategy = "surge"
+	// NodePoolUpgradeStrategyBlueGreen creates a new set of nodes, drains the old ones and deletes them after a soak time.
+	NodePoolUpgradeStrategyBlueGreen NodePoolUpgradeStrategy = "blue_green"
+)
+
+// NodePoolUpgradeSettings specifies how the nodes of a node pool are upgraded.
+type NodePoolUpgradeSettings struct {
+	// Strategy is the node pool upgrade strategy.
+	// +kubebuilder:validation:Enum=surge;blue_green
+	// +kubebuilder:default=surge
+	// +optional
+	Strategy NodePoolUpgradeStrategy `json:"strategy,omitempty"`
+	// MaxSurge is the maximum number of nodes that can be created beyond the current size of the node pool
+	// during a surge upgrade. Defaults to 1 with the surge strategy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxSurge *int32 `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of nodes that can be simultaneously unavailable during a surge upgrade.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+	// BlueGreenSettings specifies the settings of a blue-green upgrade.
+	// +optional
+	BlueGreenSettings *NodePoolBlueGreenSettings `json:"blueGreenSettings,omitempty"`
+}
+
+// NodePoolBlueGreenSettings specifies the settings of a blue-green upgrade.
+type NodePoolBlueGreenSettings struct {
+	// BatchNodeCount is the number of blue nodes drained in a batch.
+	// Only one of batchNodeCount and batchPercentage can be set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BatchNodeCount *int32 `json:"batchNodeCount,omitempty"`
+	// BatchPercentage is the percentage of blue nodes drained in a batch.
+	// Only one of batchNodeCount and batchPercentage can be set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	BatchPercentage *int32 `json:"batchPercentage,omitempty"`
+	// BatchSoakDuration is the time to wait after draining a batch of blue nodes.
+	// +optional
+	BatchSoakDuration *metav1.Duration `json:"batchSoakDuration,omitempty"`
+	// NodePoolSoakDuration is the time to wait after all blue nodes are drained, before the blue pool is deleted.
+	// +optional
+	NodePoolSoakDuration *metav1.Duration `json:"nodePoolSoakDuration,omitempty"`
+}
+
+// GPUDriverVersion is the version of the GPU driver installed by GKE on the nodes.
+// +kubebuilder:validation:Enum=disabled;default;latest
+type GPUDriverVersion string
+
+const (
+	// GPUDriverVersionDisabled disables the installation of the GPU driver, which has to be installed manually.
+	GPUDriverVersionDisabled GPUDriverVersion = "disabled"
+	// GPUDriverVersionDefault installs the default GPU driver version of the GKE version.
+	GPUDriverVersionDefault GPUDriverVersion = "default"
+	// GPUDriverVersionLatest installs the latest GPU driver version available for the GKE version.
+	GPUDriverVersionLatest GPUDriverVersion = "latest"
+)
+
+// GPUSharingStrategy is the strategy used to share a GPU between containers.
+// +kubebuilder:validation:Enum=time_sharing;mps
+type GPUSharingStrategy string
+
+const (
+	// GPUSharingStrategyTimeSharing shares the GPU by time slicing.
+	GPUSharingStrategyTimeSharing GPUSharingStrategy = "time_sharing"
+	// GPUSharingStrategyMPS shares the GPU with the NVIDIA Multi-Process Service.
+	GPUSharingStrategyMPS GPUSharingStrategy = "mps"
+)
+
+// Accelerator specifies a type of GPU attached to each node of the node pool.
+type Accelerator struct {
+	// Type is the accelerator type, for example nvidia-tesla-t4.
+	// +kubebuilder:validation:MinLength=1
+	Type string `json:"type"`
+	// Count is the number of accelerators attached to each node.
+	// +kubebuilder:validation:Minimum=1
+	Count int64 `json:"count"`
+	// GPUDriverVersion specifies the GPU driver installed by GKE.
+	// If unspecified, the GPU driver is not installed by GKE.
+	// +optional
+	GPUDriverVersion *GPUDriverVersion `json:"gpuDriverVersion,omitempty"`
+	// GPUPartitionSize is the size of the Multi-Instance GPU partitions, for example 1g.5gb.
+	// +optional
+	GPUPartitionSize string `json:"gpuPartitionSize,omitempty"`
+	// GPUSharing specifies how a GPU is shared between containers.
+	// +optional
+	GPUSharing *GPUSharing `json:"gpuSharing,omitempty"`
+}
+
+// GPUSharing specifies how a GPU is shared between containers.
+type GPUSharing struct {
+	// Strategy is the GPU sharing strategy.
+	Strategy GPUSharingStrategy `json:"strategy"`
+	// MaxSharedClientsPerGPU is the maximum number of containers that can share a GPU.
+	// +kubebuilder:validation:Minimum=2
+	MaxSharedClientsPerGPU int64 `json:"maxSharedClientsPerGPU"`
+}
+
+// ReservationAffinityType is the type of Compute Engine reservations consumed by the nodes.
+// +kubebuilder:validation:Enum=no_reservation;any_reservation;specific_reservation
+type ReservationAffinityType string
+
+const (
+	// ReservationAffinityTypeNoReservation does not consume any reservation.
+	ReservationAffinityTypeNoReservation ReservationAffinityType = "no_reservation"
+	// ReservationAffinityTypeAnyReservation consumes any matching reservation.
+	ReservationAffinityTypeAnyReservation ReservationAffinityType = "any_reservation"
+	// ReservationAffinityTypeSpecificReservation consumes the reservations selected by key and values.
+	ReservationAffinityTypeSpecificReservation ReservationAffinityType = "specific_reservation"
+)
+
+// ReservationAffinity specifies the Compute Engine reservations consumed by the nodes.
+type ReservationAffinity struct {
+	// ConsumeReservationType is the type of reservations consumed by the nodes.
+	ConsumeReservationType ReservationAffinityType `json:"consumeReservationType"`
+	// Key is the label key of the reservation resource, for example compute.googleapis.com/reservation-name.
+	// Only valid with specific_reservation.
+	// +optional
+	Key string `json:"key,omitempty"`
+	// Values are the label values of the reservation resource, for example the reservation names.
+	// Only valid with specific_reservation.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// PlacementPolicyType is the type of placement of the nodes.
+// +kubebuilder:validation:Enum=compact
+type PlacementPolicyType string
+
+const (
+	// PlacementPolicyTypeCompact places the nodes close to each other to reduce the network latency.
+	PlacementPolicyTypeCompact PlacementPolicyType = "compact"
+)
+
+// PlacementPolicy specifies the placement of the nodes of the node pool.
+type PlacementPolicy struct {
+	// Type is the type of placement.
+	// +optional
+	Type PlacementPolicyType `json:"type,omitempty"`
+	// TPUTopology is the topology of the TPU slice, for example 2x2x1. It is only valid with TPU machine types.
+	// +optional
+	TPUTopology string `json:"tpuTopology,omitempty"`
+	// PolicyName is the name of an existing Compute Engine resource policy used for the placement.
+	// +optional
+	PolicyName string `json:"policyName,omitempty"`
+}
+
 // LinuxNodeConfig specifies the settings for Linux agent nodes.
 type LinuxNodeConfig struct {
 	// Sysctls specifies the sysctl settings for this node pool.
@@ -191,6 +361,120 @@ type LinuxNodeConfig struct {
 	// CgroupMode specifies the cgroup mode for this node pool.
 	// +optional
 	CgroupMode *ManagedNodePoolCgroupMode `json:"cgroupMode,omitempty"`
+	// Hugepages specifies the number of hugepages allocated on each node.
+	// +optional
+	Hugepages *HugepagesConfig `json:"hugepages,omitempty"`
+}
+
+// HugepagesConfig specifies the number of hugepages allocated on each node.
+type HugepagesConfig struct {
+	// HugepageSize2M is the number of 2M hugepages.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HugepageSize2M *int32 `json:"hugepageSize2m,omitempty"`
+	// HugepageSize1G is the number of 1G hugepages.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HugepageSize1G *int32 `json:"hugepageSize1g,omitempty"`
+}
+
+// CPUManagerPolicy is the CPU management policy of the kubelet.
+// +kubebuilder:validation:Enum=none;static
+type CPUManagerPolicy string
+
+const (
+	// CPUManagerPolicyNone uses the default CPU affinity scheme.
+	CPUManagerPolicyNone CPUManagerPolicy = "none"
+	// CPUManagerPolicyStatic gives exclusive CPUs to the containers of Guaranteed pods requesting integer CPUs.
+	CPUManagerPolicyStatic CPUManagerPolicy = "static"
+)
+
+// KubeletConfig specifies the kubelet settings of the nodes. Settings that are not specified are left at the
+// GKE defaults.
+type KubeletConfig struct {
+	// CPUManagerPolicy is the CPU management policy of the kubelet.
+	// +optional
+	CPUManagerPolicy *CPUManagerPolicy `json:"cpuManagerPolicy,omitempty"`
+	// CPUCFSQuota enables the enforcement of the CPU limits of the containers with the CPU CFS quota.
+	// +optional
+	CPUCFSQuota *bool `json:"cpuCFSQuota,omitempty"`
+	// CPUCFSQuotaPeriod is the CPU CFS quota period, between 1ms and 1s.
+	// +optional
+	CPUCFSQuotaPeriod *metav1.Duration `json:"cpuCFSQuotaPeriod,omitempty"`
+	// PodPIDsLimit is the maximum number of processes allowed to run in a pod.
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=4194304
+	// +optional
+	PodPIDsLimit *int64 `json:"podPIDsLimit,omitempty"`
+	// ImageGCLowThresholdPercent is the percent of disk usage under which image garbage collection never runs.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=85
+	// +optional
+	ImageGCLowThresholdPercent *int32 `json:"imageGCLowThresholdPercent,omitempty"`
+	// ImageGCHighThresholdPercent is the percent of disk usage after which image garbage collection always runs.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=85
+	// +optional
+	ImageGCHighThresholdPercent *int32 `json:"imageGCHighThresholdPercent,omitempty"`
+	// InsecureKubeletReadonlyPortEnabled enables the insecure kubelet read-only port 10255.
+	// +optional
+	InsecureKubeletReadonlyPortEnabled *bool `json:"insecureKubeletReadonlyPortEnabled,omitempty"`
+	// TopologyManager specifies the topology manager of the kubelet.
+	// +optional
+	TopologyManager *TopologyManager `json:"topologyManager,omitempty"`
+}
+
+// TopologyManager specifies the topology manager of the kubelet.
+type TopologyManager struct {
+	// Policy is the topology manager policy.
+	// +kubebuilder:validation:Enum=none;best-effort;restricted;single-numa-node
+	// +optional
+	Policy string `json:"policy,omitempty"`
+	// Scope is the scope of the topology alignment.
+	// +kubebuilder:validation:Enum=container;pod
+	// +optional
+	Scope string `json:"scope,omitempty"`
+}
+
+// ContainerdConfig specifies the containerd settings of the nodes.
+type ContainerdConfig struct {
+	// PrivateRegistryAccess configures the access to private registries using certificates
+	// signed by a private certificate authority.
+	// +optional
+	PrivateRegistryAccess *PrivateRegistryAccess `json:"privateRegistryAccess,omitempty"`
+	// RegistryMirrors configures the mirrors used to pull images from registries.
+	// +listType=map
+	// +listMapKey=server
+	// +optional
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty"`
+}
+
+// PrivateRegistryAccess configures the access to private registries.
+type PrivateRegistryAccess struct {
+	// Enabled enables the access to private registries.
+	Enabled bool `json:"enabled"`
+	// CertificateAuthorityDomains are the certificate authorities trusted for the private registries.
+	// +optional
+	CertificateAuthorityDomains []CertificateAuthorityDomain `json:"certificateAuthorityDomains,omitempty"`
+}
+
+// CertificateAuthorityDomain is a certificate authority trusted for a set of registry domains.
+type CertificateAuthorityDomain struct {
+	// FQDNs are the fully qualified domain names of the registries, optionally with a port.
+	// +kubebuilder:validation:MinItems=1
+	FQDNs []string `json:"fqdns"`
+	// SecretURI is the URI of the Secret Manager secret version holding the certificate, in the format
+	// projects/[PROJECT]/secrets/[SECRET]/versions/[VERSION].
+	SecretURI string `json:"secretURI"`
+}
+
+// RegistryMirror configures the mirrors of a registry.
+type RegistryMirror struct {
+	// Server is the registry being mirrored, for example docker.io.
+	Server string `json:"server"`
+	// Mirrors are the URLs of the mirrors, tried in order before the registry itself.
+	// +kubebuilder:validation:MinItems=1
+	Mirrors []string `json:"mirrors"`
 }
 
 // SysctlConfig specifies the sysctl settings for Linux nodes.
@@ -20,6 +20,19 @@ import (
 	"strings"
 
 	"cloud.google.com/go/container/apiv1/containerpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// DeletionPolicy defines what happens to the GKE resource when the object managing it is deleted.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the GKE resource.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan leaves the GKE resource in place.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
 )
 
 // TaintEffect is the effect for a Kubernetes taint.
@@ -141,6 +154,226 @@ func ConvertToSdkLinuxNodeConfig(linuxNodeConfig *LinuxNodeConfig) *containerpb.
 		if linuxNodeConfig.CgroupMode != nil {
 			sdkLinuxNodeConfig.CgroupMode = ConvertToSdkCgroupMode(*linuxNodeConfig.CgroupMode)
 		}
+		if linuxNodeConfig.Hugepages != nil {
+			sdkLinuxNodeConfig.Hugepages = &containerpb.LinuxNodeConfig_HugepagesConfig{
+				HugepageSize2M: linuxNodeConfig.Hugepages.HugepageSize2M,
+				HugepageSize1G: linuxNodeConfig.Hugepages.HugepageSize1G,
+			}
+		}
 	}
 	return &sdkLinuxNodeConfig
 }
+
+// ConvertToSdkUpgradeSettings converts GCPManagedMachinePool upgrade settings to format that is used by GCP SDK.
+func ConvertToSdkUpgradeSettings(upgradeSettings *NodePoolUpgradeSettings) *containerpb.NodePool_UpgradeSettings {
+	if upgradeSettings == nil {
+		return nil
+	}
+	sdkUpgradeSettings := containerpb.NodePool_UpgradeSettings{}
+	if upgradeSettings.MaxSurge != nil {
+		sdkUpgradeSettings.MaxSurge = *upgradeSettings.MaxSurge
+	} else if upgradeSettings.Strategy != NodePoolUpgradeStrategyBlueGreen {
+		// GKE rejects surge upgrades with neither surge nor unavailable nodes, keep its default surge of 1.
+		sdkUpgradeSettings.MaxSurge = 1
+	}
+	if upgradeSettings.MaxUnavailable != nil {
+		sdkUpgradeSettings.MaxUnavailable = *upgradeSettings.MaxUnavailable
+	}
+	switch upgradeSettings.Strategy {
+	case NodePoolUpgradeStrategyBlueGreen:
+		strategy := containerpb.NodePoolUpdateStrategy_BLUE_GREEN
+		sdkUpgradeSettings.Strategy = &strategy
+	case NodePoolUpgradeStrategySurge:
+		strategy := containerpb.NodePoolUpdateStrategy_SURGE
+		sdkUpgradeSettings.Strategy = &strategy
+	}
+	if blueGreenSettings := upgradeSettings.BlueGreenSettings; blueGreenSettings != nil {
+		standardRolloutPolicy := &containerpb.BlueGreenSettings_StandardRolloutPolicy{}
+		if blueGreenSettings.BatchNodeCount != nil {
+			standardRolloutPolicy.UpdateBatchSize = &containerpb.BlueGreenSettings_StandardRolloutPolicy_BatchNodeCount{
+				BatchNodeCount: *blueGreenSettings.BatchNodeCount,
+			}
+		}
+		if blueGreenSettings.BatchPercentage != nil {
+			standardRolloutPolicy.UpdateBatchSize = &containerpb.BlueGreenSettings_StandardRolloutPolicy_BatchPercentage{
+				BatchPercentage: float32(*blueGreenSettings.BatchPercentage) / 100,
+			}
+		}
+		if blueGreenSettings.BatchSoakDuration != nil {
+			standardRolloutPolicy.BatchSoakDuration = durationpb.New(blueGreenSettings.BatchSoakDuration.Duration)
+		}
+		sdkUpgradeSettings.BlueGreenSettings = &containerpb.BlueGreenSettings{
+			RolloutPolicy: &containerpb.BlueGreenSettings_StandardRolloutPolicy_{
+				StandardRolloutPolicy: standardRolloutPolicy,
+			},
+		}
+		if blueGreenSettings.NodePoolSoakDuration != nil {
+			sdkUpgradeSettings.BlueGreenSettings.NodePoolSoakDuration = durationpb.New(blueGreenSettings.NodePoolSoakDuration.Duration)
+		}
+	}
+	return &sdkUpgradeSettings
+}
+
+// ConvertToSdkAccelerators converts GCPManagedMachinePool accelerators to format that is used by GCP SDK.
+func ConvertToSdkAccelerators(accelerators []Accelerator) []*containerpb.AcceleratorConfig {
+	if accelerators == nil {
+		return nil
+	}
+	res := []*containerpb.AcceleratorConfig{}
+	for _, accelerator := range accelerators {
+		sdkAccelerator := &containerpb.AcceleratorConfig{
+			AcceleratorType:  accelerator.Type,
+			AcceleratorCount: accelerator.Count,
+			GpuPartitionSize: accelerator.GPUPartitionSize,
+		}
+		if accelerator.GPUDriverVersion != nil {
+			gpuDriverVersion := convertToSdkGPUDriverVersion(*accelerator.GPUDriverVersion)
+			sdkAccelerator.GpuDriverInstallationConfig = &containerpb.GPUDriverInstallationConfig{
+				GpuDriverVersion: &gpuDriverVersion,
+			}
+		}
+		if accelerator.GPUSharing != nil {
+			gpuSharingStrategy := convertToSdkGPUSharingStrategy(accelerator.GPUSharing.Strategy)
+			sdkAccelerator.GpuSharingConfig = &containerpb.GPUSharingConfig{
+				MaxSharedClientsPerGpu: accelerator.GPUSharing.MaxSharedClientsPerGPU,
+				GpuSharingStrategy:     &gpuSharingStrategy,
+			}
+		}
+		res = append(res, sdkAccelerator)
+	}
+	return res
+}
+
+func convertToSdkGPUDriverVersion(version GPUDriverVersion) containerpb.GPUDriverInstallationConfig_GPUDriverVersion {
+	switch version {
+	case GPUDriverVersionDisabled:
+		return containerpb.GPUDriverInstallationConfig_INSTALLATION_DISABLED
+	case GPUDriverVersionDefault:
+		return containerpb.GPUDriverInstallationConfig_DEFAULT
+	case GPUDriverVersionLatest:
+		return containerpb.GPUDriverInstallationConfig_LATEST
+	}
+	return containerpb.GPUDriverInstallationConfig_GPU_DRIVER_VERSION_UNSPECIFIED
+}
+
+func convertToSdkGPUSharingStrategy(strategy GPUSharingStrategy) containerpb.GPUSharingConfig_GPUSharingStrategy {
+	switch strategy {
+	case GPUSharingStrategyTimeSharing:
+		return containerpb.GPUSharingConfig_TIME_SHARING
+	case GPUSharingStrategyMPS:
+		return containerpb.GPUSharingConfig_MPS
+	}
+	return containerpb.GPUSharingConfig_GPU_SHARING_STRATEGY_UNSPECIFIED
+}
+
+// ConvertToSdkReservationAffinity converts GCPManagedMachinePool reservation affinity to format that is used by GCP SDK.
+func ConvertToSdkReservationAffinity(reservationAffinity *ReservationAffinity) *containerpb.ReservationAffinity {
+	if reservationAffinity == nil {
+		return nil
+	}
+	sdkReservationAffinity := containerpb.ReservationAffinity{
+		Key:    reservationAffinity.Key,
+		Values: reservationAffinity.Values,
+	}
+	switch reservationAffinity.ConsumeReservationType {
+	case ReservationAffinityTypeNoReservation:
+		sdkReservationAffinity.ConsumeReservationType = containerpb.ReservationAffinity_NO_RESERVATION
+	case ReservationAffinityTypeAnyReservation:
+		sdkReservationAffinity.ConsumeReservationType = containerpb.ReservationAffinity_ANY_RESERVATION
+	case ReservationAffinityTypeSpecificReservation:
+		sdkReservationAffinity.ConsumeReservationType = containerpb.ReservationAffinity_SPECIFIC_RESERVATION
+	}
+	return &sdkReservationAffinity
+}
+
+// ConvertToSdkPlacementPolicy converts GCPManagedMachinePool placement policy to format that is used by GCP SDK.
+func ConvertToSdkPlacementPolicy(placementPolicy *PlacementPolicy) *containerpb.NodePool_PlacementPolicy {
+	if placementPolicy == nil {
+		return nil
+	}
+	sdkPlacementPolicy := containerpb.NodePool_PlacementPolicy{
+		TpuTopology: placementPolicy.TPUTopology,
+		PolicyName:  placementPolicy.PolicyName,
+	}
+	if placementPolicy.Type == PlacementPolicyTypeCompact {
+		sdkPlacementPolicy.Type = containerpb.NodePool_PlacementPolicy_COMPACT
+	}
+	return &sdkPlacementPolicy
+}
+
+// ConvertToSdkKubeletConfig converts GCPManagedMachinePool kubelet config to format that is used by GCP SDK.
+func ConvertToSdkKubeletConfig(kubeletConfig *KubeletConfig) *containerpb.NodeKubeletConfig {
+	if kubeletConfig == nil {
+		return nil
+	}
+	sdkKubeletConfig := containerpb.NodeKubeletConfig{
+		InsecureKubeletReadonlyPortEnabled: kubeletConfig.InsecureKubeletReadonlyPortEnabled,
+	}
+	if kubeletConfig.CPUManagerPolicy != nil {
+		sdkKubeletConfig.CpuManagerPolicy = string(*kubeletConfig.CPUManagerPolicy)
+	}
+	if kubeletConfig.CPUCFSQuota != nil {
+		sdkKubeletConfig.CpuCfsQuota = wrapperspb.Bool(*kubeletConfig.CPUCFSQuota)
+	}
+	if kubeletConfig.CPUCFSQuotaPeriod != nil {
+		sdkKubeletConfig.CpuCfsQuotaPeriod = kubeletConfig.CPUCFSQuotaPeriod.Duration.String()
+	}
+	if kubeletConfig.PodPIDsLimit != nil {
+		sdkKubeletConfig.PodPidsLimit = *kubeletConfig.PodPIDsLimit
+	}
+	if kubeletConfig.ImageGCLowThresholdPercent != nil {
+		sdkKubeletConfig.ImageGcLowThresholdPercent = *kubeletConfig.ImageGCLowThresholdPercent
+	}
+	if kubeletConfig.ImageGCHighThresholdPercent != nil {
+		sdkKubeletConfig.ImageGcHighThresholdPercent = *kubeletConfig.ImageGCHighThresholdPercent
+	}
+	if kubeletConfig.TopologyManager != nil {
+		sdkKubeletConfig.TopologyManager = &containerpb.TopologyManager{
+			Policy: kubeletConfig.TopologyManager.Policy,
+			Scope:  kubeletConfig.TopologyManager.Scope,
+		}
+	}
+	return &sdkKubeletConfig
+}
+
+// ConvertToSdkContainerdConfig converts GCPManagedMachinePool containerd config to format that is used by GCP SDK.
+// Registry mirrors are configured as hosts with the pull and resolve capabilities.
+func ConvertToSdkContainerdConfig(containerdConfig *ContainerdConfig) *containerpb.ContainerdConfig {
+	if containerdConfig == nil {
+		return nil
+	}
+	sdkContainerdConfig := containerpb.ContainerdConfig{}
+	if privateRegistryAccess := containerdConfig.PrivateRegistryAccess; privateRegistryAccess != nil {
+		sdkPrivateRegistryAccess := containerpb.ContainerdConfig_PrivateRegistryAccessConfig{
+			Enabled: privateRegistryAccess.Enabled,
+		}
+		for _, domain := range privateRegistryAccess.CertificateAuthorityDomains {
+			sdkPrivateRegistryAccess.CertificateAuthorityDomainConfig = append(sdkPrivateRegistryAccess.CertificateAuthorityDomainConfig,
+				&containerpb.ContainerdConfig_PrivateRegistryAccessConfig_CertificateAuthorityDomainConfig{
+					Fqdns: domain.FQDNs,
+					CertificateConfig: &containerpb.ContainerdConfig_PrivateRegistryAccessConfig_CertificateAuthorityDomainConfig_GcpSecretManagerCertificateConfig{
+						GcpSecretManagerCertificateConfig: &containerpb.ContainerdConfig_PrivateRegistryAccessConfig_CertificateAuthorityDomainConfig_GCPSecretManagerCertificateConfig{
+							SecretUri: domain.SecretURI,
+						},
+					},
+				})
+		}
+		sdkContainerdConfig.PrivateRegistryAccessConfig = &sdkPrivateRegistryAccess
+	}
+	for _, registryMirror := range containerdConfig.RegistryMirrors {
+		sdkRegistryHost := containerpb.ContainerdConfig_RegistryHostConfig{
+			Server: registryMirror.Server,
+		}
+		for _, mirror := range registryMirror.Mirrors {
+			sdkRegistryHost.Hosts = append(sdkRegistryHost.Hosts, &containerpb.ContainerdConfig_RegistryHostConfig_HostConfig{
+				Host: mirror,
+				Capabilities: []containerpb.ContainerdConfig_RegistryHostConfig_HostCapability{
+					containerpb.ContainerdConfig_RegistryHostConfig_HOST_CAPABILITY_PULL,
+					containerpb.ContainerdConfig_RegistryHostConfig_HOST_CAPABILITY_RESOLVE,
+				},
+			})
+		}
+		sdkContainerdConfig.RegistryHosts = append(sdkContainerdConfig.RegistryHosts, &sdkRegistryHost)
+	}
+	return &sdkContainerdConfig
+}
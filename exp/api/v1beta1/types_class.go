@@ -37,6 +37,16 @@ type GCPManagedControlPlaneClassSpec struct {
 	// +optional
 	ClusterSecurity *ClusterSecurity `json:"clusterSecurity,omitempty"`
 
+	// Addons configures the add-ons of the GKE cluster.
+	// Add-ons that are not specified are left at the GKE defaults.
+	// +optional
+	Addons *ClusterAddons `json:"addons,omitempty"`
+
+	// ClusterAutoscaling configures the cluster-wide autoscaling and node auto-provisioning of the GKE cluster.
+	// It can't be set when enableAutopilot is true, Autopilot manages the nodes of the cluster.
+	// +optional
+	ClusterAutoscaling *ClusterAutoscaling `json:"clusterAutoscaling,omitempty"`
+
 	// Project is the name of the project to deploy the cluster to.
 	Project string `json:"project"`
 
@@ -49,13 +59,19 @@ type GCPManagedControlPlaneClassSpec struct {
 	EnableAutopilot bool `json:"enableAutopilot"`
 
 	// EnableIdentityService indicates whether to enable Identity Service component for this GKE cluster.
+	// If unspecified, the Identity Service of an existing cluster is left as it is.
 	// +optional
-	EnableIdentityService bool `json:"enableIdentityService"`
+	EnableIdentityService *bool `json:"enableIdentityService,omitempty"`
 
 	// ReleaseChannel represents the release channel of the GKE cluster.
 	// +optional
 	ReleaseChannel *ReleaseChannel `json:"releaseChannel,omitempty"`
 
+	// MaintenancePolicy defines when GKE is allowed to perform automatic maintenance, such as upgrades, on the cluster.
+	// If unspecified, GKE may perform maintenance at any time.
+	// +optional
+	MaintenancePolicy *MaintenancePolicy `json:"maintenancePolicy,omitempty"`
+
 	// BinaryAuthorization represents the mode of operation of Binary Authorization for the GKE cluster.
 	// This feature is disabled if this field is not specified.
 	// +optional
@@ -77,6 +93,23 @@ type GCPManagedControlPlaneClassSpec struct {
 	// Value is ignored when enableAutopilot = true.
 	// +optional
 	MonitoringService *MonitoringService `json:"monitoringService,omitempty"`
+
+	// UserKubeconfigMode defines how the user kubeconfig, stored in the <cluster>-user-kubeconfig secret,
+	// authenticates to the GKE cluster. If unspecified, the exec mode is used. The token mode embeds the access
+	// token of the controller credentials, with the cloud-platform scope, so anyone who can read the secret gets the
+	// GCP permissions of the controller until the token expires.
+	// +optional
+	UserKubeconfigMode *UserKubeconfigMode `json:"userKubeconfigMode,omitempty"`
+
+	// DeletionPolicy defines whether the GKE cluster is deleted or left in place when the GCPManagedControlPlane is
+	// deleted. If unspecified, imported clusters are orphaned and the other clusters are deleted.
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// DeletionProtection prevents the deletion of the GCPManagedControlPlane, unless it has the
+	// infrastructure.cluster.x-k8s.io/allow-deletion annotation.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
 }
 
 // GCPManagedMachinePoolClassSpec defines the GCPManagedMachinePool properties that may be shared across several GCP managed machinepools.
@@ -113,6 +146,10 @@ type GCPManagedMachinePoolClassSpec struct {
 	// DiskType is type of the disk attached to each node.
 	// +optional
 	DiskType *DiskType `json:"diskType,omitempty"`
+	// BootDiskKMSKey is the Cloud KMS key used to encrypt the boot disks of the nodes, in the format
+	// projects/[PROJECT]/locations/[LOCATION]/keyRings/[RING]/cryptoKeys/[KEY].
+	// +optional
+	BootDiskKMSKey string `json:"bootDiskKmsKey,omitempty"`
 	// DiskSizeGB is size of the disk attached to each node,
 	// specified in GB.
 	// +kubebuilder:validation:Minimum:=10
@@ -147,4 +184,37 @@ type GCPManagedMachinePoolClassSpec struct {
 	// LinuxNodeConfig specifies the settings for Linux agent nodes.
 	// +optional
 	LinuxNodeConfig *LinuxNodeConfig `json:"linuxNodeConfig,omitempty"`
+	// KubeletConfig specifies the kubelet settings of the nodes.
+	// +optional
+	KubeletConfig *KubeletConfig `json:"kubeletConfig,omitempty"`
+	// ContainerdConfig specifies the containerd settings of the nodes.
+	// +optional
+	ContainerdConfig *ContainerdConfig `json:"containerdConfig,omitempty"`
+	// UpgradeSettings specifies how the nodes of the node pool are upgraded.
+	// If unspecified, GKE upgrades nodes with a surge of 1.
+	// +optional
+	UpgradeSettings *NodePoolUpgradeSettings `json:"upgradeSettings,omitempty"`
+	// Accelerators specifies the GPUs attached to each node of the node pool.
+	// +optional
+	Accelerators []Accelerator `json:"accelerators,omitempty"`
+	// Spot specifies whether the nodes are Spot VMs.
+	// +optional
+	Spot bool `json:"spot,omitempty"`
+	// Preemptible specifies whether the nodes are preemptible VMs. Spot VMs should be preferred over preemptible VMs.
+	// +optional
+	Preemptible bool `json:"preemptible,omitempty"`
+	// ReservationAffinity specifies the Compute Engine reservations consumed by the nodes.
+	// +optional
+	ReservationAffinity *ReservationAffinity `json:"reservationAffinity,omitempty"`
+	// PlacementPolicy specifies the placement of the nodes of the node pool.
+	// +optional
+	PlacementPolicy *PlacementPolicy `json:"placementPolicy,omitempty"`
+	// EnableQueuedProvisioning specifies whether the nodes are provisioned with queued provisioning, which creates
+	// all the requested nodes at once when the resources are available. It requires autoscaling.
+	// +optional
+	EnableQueuedProvisioning bool `json:"enableQueuedProvisioning,omitempty"`
+	// DeletionPolicy defines whether the GKE node pool is deleted or left in place when the GCPManagedMachinePool is
+	// deleted. If unspecified, the node pool is deleted, unless its cluster is deleted and orphaned.
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
@@ -21,12 +21,59 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apiv1beta1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	corev1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoprovisioningNodePoolDefaults) DeepCopyInto(out *AutoprovisioningNodePoolDefaults) {
+	*out = *in
+	if in.OAuthScopes != nil {
+		in, out := &in.OAuthScopes, &out.OAuthScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoprovisioningNodePoolDefaults.
+func (in *AutoprovisioningNodePoolDefaults) DeepCopy() *AutoprovisioningNodePoolDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoprovisioningNodePoolDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Accelerator) DeepCopyInto(out *Accelerator) {
+	*out = *in
+	if in.GPUDriverVersion != nil {
+		in, out := &in.GPUDriverVersion, &out.GPUDriverVersion
+		*out = new(GPUDriverVersion)
+		**out = **in
+	}
+	if in.GPUSharing != nil {
+		in, out := &in.GPUSharing, &out.GPUSharing
+		*out = new(GPUSharing)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Accelerator.
+func (in *Accelerator) DeepCopy() *Accelerator {
+	if in == nil {
+		return nil
+	}
+	out := new(Accelerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticatorGroupConfig) DeepCopyInto(out *AuthenticatorGroupConfig) {
 	*out = *in
@@ -42,6 +89,136 @@ func (in *AuthenticatorGroupConfig) DeepCopy() *AuthenticatorGroupConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAuthorityDomain) DeepCopyInto(out *CertificateAuthorityDomain) {
+	*out = *in
+	if in.FQDNs != nil {
+		in, out := &in.FQDNs, &out.FQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityDomain.
+func (in *CertificateAuthorityDomain) DeepCopy() *CertificateAuthorityDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAuthorityDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAddons) DeepCopyInto(out *ClusterAddons) {
+	*out = *in
+	if in.HTTPLoadBalancing != nil {
+		in, out := &in.HTTPLoadBalancing, &out.HTTPLoadBalancing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GCEPersistentDiskCSIDriver != nil {
+		in, out := &in.GCEPersistentDiskCSIDriver, &out.GCEPersistentDiskCSIDriver
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GCPFilestoreCSIDriver != nil {
+		in, out := &in.GCPFilestoreCSIDriver, &out.GCPFilestoreCSIDriver
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GCSFuseCSIDriver != nil {
+		in, out := &in.GCSFuseCSIDriver, &out.GCSFuseCSIDriver
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfigConnector != nil {
+		in, out := &in.ConfigConnector, &out.ConfigConnector
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GKEBackupAgent != nil {
+		in, out := &in.GKEBackupAgent, &out.GKEBackupAgent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNSCache != nil {
+		in, out := &in.DNSCache, &out.DNSCache
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RayOperator != nil {
+		in, out := &in.RayOperator, &out.RayOperator
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAddons.
+func (in *ClusterAddons) DeepCopy() *ClusterAddons {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAddons)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscaling) DeepCopyInto(out *ClusterAutoscaling) {
+	*out = *in
+	if in.ResourceLimits != nil {
+		in, out := &in.ResourceLimits, &out.ResourceLimits
+		*out = make([]ResourceLimit, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoscalingProfile != nil {
+		in, out := &in.AutoscalingProfile, &out.AutoscalingProfile
+		*out = new(AutoscalingProfile)
+		**out = **in
+	}
+	if in.AutoprovisioningNodePoolDefaults != nil {
+		in, out := &in.AutoprovisioningNodePoolDefaults, &out.AutoprovisioningNodePoolDefaults
+		*out = new(AutoprovisioningNodePoolDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoprovisioningLocations != nil {
+		in, out := &in.AutoprovisioningLocations, &out.AutoprovisioningLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscaling.
+func (in *ClusterAutoscaling) DeepCopy() *ClusterAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDNS) DeepCopyInto(out *ClusterDNS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDNS.
+func (in *ClusterDNS) DeepCopy() *ClusterDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterNetwork) DeepCopyInto(out *ClusterNetwork) {
 	*out = *in
@@ -60,6 +237,36 @@ func (in *ClusterNetwork) DeepCopyInto(out *ClusterNetwork) {
 		*out = new(ClusterNetworkService)
 		**out = **in
 	}
+	if in.DatapathProvider != nil {
+		in, out := &in.DatapathProvider, &out.DatapathProvider
+		*out = new(DatapathProvider)
+		**out = **in
+	}
+	if in.EnableNetworkPolicy != nil {
+		in, out := &in.EnableNetworkPolicy, &out.EnableNetworkPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableIntraNodeVisibility != nil {
+		in, out := &in.EnableIntraNodeVisibility, &out.EnableIntraNodeVisibility
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(ClusterDNS)
+		**out = **in
+	}
+	if in.GatewayAPIChannel != nil {
+		in, out := &in.GatewayAPIChannel, &out.GatewayAPIChannel
+		*out = new(GatewayAPIChannel)
+		**out = **in
+	}
+	if in.EnableMultiNetworking != nil {
+		in, out := &in.EnableMultiNetworking, &out.EnableMultiNetworking
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetwork.
@@ -115,6 +322,11 @@ func (in *ClusterSecurity) DeepCopyInto(out *ClusterSecurity) {
 		*out = new(AuthenticatorGroupConfig)
 		**out = **in
 	}
+	if in.DatabaseEncryption != nil {
+		in, out := &in.DatabaseEncryption, &out.DatabaseEncryption
+		*out = new(DatabaseEncryption)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSecurity.
@@ -127,6 +339,121 @@ func (in *ClusterSecurity) DeepCopy() *ClusterSecurity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdConfig) DeepCopyInto(out *ContainerdConfig) {
+	*out = *in
+	if in.PrivateRegistryAccess != nil {
+		in, out := &in.PrivateRegistryAccess, &out.PrivateRegistryAccess
+		*out = new(PrivateRegistryAccess)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]RegistryMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdConfig.
+func (in *ContainerdConfig) DeepCopy() *ContainerdConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRotationStatus) DeepCopyInto(out *CredentialRotationStatus) {
+	*out = *in
+	if in.RecreatedNodePools != nil {
+		in, out := &in.RecreatedNodePools, &out.RecreatedNodePools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRotationStatus.
+func (in *CredentialRotationStatus) DeepCopy() *CredentialRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DailyMaintenanceWindow) DeepCopyInto(out *DailyMaintenanceWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DailyMaintenanceWindow.
+func (in *DailyMaintenanceWindow) DeepCopy() *DailyMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DailyMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseEncryption) DeepCopyInto(out *DatabaseEncryption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseEncryption.
+func (in *DatabaseEncryption) DeepCopy() *DatabaseEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseEncryptionStatus) DeepCopyInto(out *DatabaseEncryptionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseEncryptionStatus.
+func (in *DatabaseEncryptionStatus) DeepCopy() *DatabaseEncryptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseEncryptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Fleet) DeepCopyInto(out *Fleet) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Fleet.
+func (in *Fleet) DeepCopy() *Fleet {
+	if in == nil {
+		return nil
+	}
+	out := new(Fleet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPMachinePool) DeepCopyInto(out *GCPMachinePool) {
 	*out = *in
@@ -136,54 +463,429 @@ func (in *GCPMachinePool) DeepCopyInto(out *GCPMachinePool) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePool.
-func (in *GCPMachinePool) DeepCopy() *GCPMachinePool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePool.
+func (in *GCPMachinePool) DeepCopy() *GCPMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolAutoHealing) DeepCopyInto(out *GCPMachinePoolAutoHealing) {
+	*out = *in
+	in.HealthCheck.DeepCopyInto(&out.HealthCheck)
+	if in.InitialDelaySec != nil {
+		in, out := &in.InitialDelaySec, &out.InitialDelaySec
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolAutoHealing.
+func (in *GCPMachinePoolAutoHealing) DeepCopy() *GCPMachinePoolAutoHealing {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolAutoHealing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolAutoscalerStatus) DeepCopyInto(out *GCPMachinePoolAutoscalerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolAutoscalerStatus.
+func (in *GCPMachinePoolAutoscalerStatus) DeepCopy() *GCPMachinePoolAutoscalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolAutoscalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolAutoscaling) DeepCopyInto(out *GCPMachinePoolAutoscaling) {
+	*out = *in
+	if in.CoolDownPeriodSec != nil {
+		in, out := &in.CoolDownPeriodSec, &out.CoolDownPeriodSec
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(GCPMachinePoolCPUUtilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancingUtilization != nil {
+		in, out := &in.LoadBalancingUtilization, &out.LoadBalancingUtilization
+		*out = new(GCPMachinePoolLoadBalancingUtilization)
+		**out = **in
+	}
+	if in.CustomMetrics != nil {
+		in, out := &in.CustomMetrics, &out.CustomMetrics
+		*out = make([]GCPMachinePoolCustomMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScaleIn != nil {
+		in, out := &in.ScaleIn, &out.ScaleIn
+		*out = new(GCPMachinePoolScaleInControl)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolAutoscaling.
+func (in *GCPMachinePoolAutoscaling) DeepCopy() *GCPMachinePoolAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolCPUUtilization) DeepCopyInto(out *GCPMachinePoolCPUUtilization) {
+	*out = *in
+	if in.PredictiveMethod != nil {
+		in, out := &in.PredictiveMethod, &out.PredictiveMethod
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolCPUUtilization.
+func (in *GCPMachinePoolCPUUtilization) DeepCopy() *GCPMachinePoolCPUUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolCPUUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolCustomMetric) DeepCopyInto(out *GCPMachinePoolCustomMetric) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(string)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(GCPMachinePoolCustomMetricTargetType)
+		**out = **in
+	}
+	if in.SingleInstanceAssignment != nil {
+		in, out := &in.SingleInstanceAssignment, &out.SingleInstanceAssignment
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolCustomMetric.
+func (in *GCPMachinePoolCustomMetric) DeepCopy() *GCPMachinePoolCustomMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolCustomMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolHealthCheck) DeepCopyInto(out *GCPMachinePoolHealthCheck) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequestPath != nil {
+		in, out := &in.RequestPath, &out.RequestPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.CheckIntervalSec != nil {
+		in, out := &in.CheckIntervalSec, &out.CheckIntervalSec
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSec != nil {
+		in, out := &in.TimeoutSec, &out.TimeoutSec
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthyThreshold != nil {
+		in, out := &in.HealthyThreshold, &out.HealthyThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnhealthyThreshold != nil {
+		in, out := &in.UnhealthyThreshold, &out.UnhealthyThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolHealthCheck.
+func (in *GCPMachinePoolHealthCheck) DeepCopy() *GCPMachinePoolHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolInstanceSelection) DeepCopyInto(out *GCPMachinePoolInstanceSelection) {
+	*out = *in
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolInstanceSelection.
+func (in *GCPMachinePoolInstanceSelection) DeepCopy() *GCPMachinePoolInstanceSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolInstanceSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolList) DeepCopyInto(out *GCPMachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPMachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolList.
+func (in *GCPMachinePoolList) DeepCopy() *GCPMachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolLoadBalancingUtilization) DeepCopyInto(out *GCPMachinePoolLoadBalancingUtilization) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolLoadBalancingUtilization.
+func (in *GCPMachinePoolLoadBalancingUtilization) DeepCopy() *GCPMachinePoolLoadBalancingUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolLoadBalancingUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolMachine) DeepCopyInto(out *GCPMachinePoolMachine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolMachine.
+func (in *GCPMachinePoolMachine) DeepCopy() *GCPMachinePoolMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachinePoolMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolMachineList) DeepCopyInto(out *GCPMachinePoolMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPMachinePoolMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolMachineList.
+func (in *GCPMachinePoolMachineList) DeepCopy() *GCPMachinePoolMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachinePoolMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolMachineSpec) DeepCopyInto(out *GCPMachinePoolMachineSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolMachineSpec.
+func (in *GCPMachinePoolMachineSpec) DeepCopy() *GCPMachinePoolMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolMachineStatus) DeepCopyInto(out *GCPMachinePoolMachineStatus) {
+	*out = *in
+	if in.InstanceStatus != nil {
+		in, out := &in.InstanceStatus, &out.InstanceStatus
+		*out = new(apiv1beta1.InstanceStatus)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]corev1.NodeAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolMachineStatus.
+func (in *GCPMachinePoolMachineStatus) DeepCopy() *GCPMachinePoolMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolMachineTypeStatus) DeepCopyInto(out *GCPMachinePoolMachineTypeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolMachineTypeStatus.
+func (in *GCPMachinePoolMachineTypeStatus) DeepCopy() *GCPMachinePoolMachineTypeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPMachinePool)
+	out := new(GCPMachinePoolMachineTypeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GCPMachinePool) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPMachinePoolList) DeepCopyInto(out *GCPMachinePoolList) {
+func (in *GCPMachinePoolProvisioningModelMix) DeepCopyInto(out *GCPMachinePoolProvisioningModelMix) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]GCPMachinePool, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.StandardCapacityBase != nil {
+		in, out := &in.StandardCapacityBase, &out.StandardCapacityBase
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StandardCapacityPercentAboveBase != nil {
+		in, out := &in.StandardCapacityPercentAboveBase, &out.StandardCapacityPercentAboveBase
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolList.
-func (in *GCPMachinePoolList) DeepCopy() *GCPMachinePoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolProvisioningModelMix.
+func (in *GCPMachinePoolProvisioningModelMix) DeepCopy() *GCPMachinePoolProvisioningModelMix {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPMachinePoolList)
+	out := new(GCPMachinePoolProvisioningModelMix)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GCPMachinePoolList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolScaleInControl) DeepCopyInto(out *GCPMachinePoolScaleInControl) {
+	*out = *in
+	out.MaxScaledInReplicas = in.MaxScaledInReplicas
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolScaleInControl.
+func (in *GCPMachinePoolScaleInControl) DeepCopy() *GCPMachinePoolScaleInControl {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(GCPMachinePoolScaleInControl)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -290,6 +992,53 @@ func (in *GCPMachinePoolSpec) DeepCopyInto(out *GCPMachinePoolSpec) {
 		*out = make([]apiv1beta1.Accelerator, len(*in))
 		copy(*out, *in)
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(GCPMachinePoolStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DistributionTargetShape != nil {
+		in, out := &in.DistributionTargetShape, &out.DistributionTargetShape
+		*out = new(GCPMachinePoolDistributionTargetShape)
+		**out = **in
+	}
+	if in.InstanceTemplateHistoryLimit != nil {
+		in, out := &in.InstanceTemplateHistoryLimit, &out.InstanceTemplateHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AutoHealing != nil {
+		in, out := &in.AutoHealing, &out.AutoHealing
+		*out = new(GCPMachinePoolAutoHealing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stateful != nil {
+		in, out := &in.Stateful, &out.Stateful
+		*out = new(GCPMachinePoolStatefulPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(GCPMachinePoolAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSelections != nil {
+		in, out := &in.InstanceSelections, &out.InstanceSelections
+		*out = make([]GCPMachinePoolInstanceSelection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProvisioningModelMix != nil {
+		in, out := &in.ProvisioningModelMix, &out.ProvisioningModelMix
+		*out = new(GCPMachinePoolProvisioningModelMix)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetSizePolicy != nil {
+		in, out := &in.TargetSizePolicy, &out.TargetSizePolicy
+		*out = new(GCPMachinePoolTargetSizePolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolSpec.
@@ -302,6 +1051,66 @@ func (in *GCPMachinePoolSpec) DeepCopy() *GCPMachinePoolSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolStatefulDisk) DeepCopyInto(out *GCPMachinePoolStatefulDisk) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolStatefulDisk.
+func (in *GCPMachinePoolStatefulDisk) DeepCopy() *GCPMachinePoolStatefulDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolStatefulDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolStatefulIP) DeepCopyInto(out *GCPMachinePoolStatefulIP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolStatefulIP.
+func (in *GCPMachinePoolStatefulIP) DeepCopy() *GCPMachinePoolStatefulIP {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolStatefulIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolStatefulPolicy) DeepCopyInto(out *GCPMachinePoolStatefulPolicy) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]GCPMachinePoolStatefulDisk, len(*in))
+		copy(*out, *in)
+	}
+	if in.InternalIPs != nil {
+		in, out := &in.InternalIPs, &out.InternalIPs
+		*out = make([]GCPMachinePoolStatefulIP, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExternalIPs != nil {
+		in, out := &in.ExternalIPs, &out.ExternalIPs
+		*out = make([]GCPMachinePoolStatefulIP, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolStatefulPolicy.
+func (in *GCPMachinePoolStatefulPolicy) DeepCopy() *GCPMachinePoolStatefulPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolStatefulPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPMachinePoolStatus) DeepCopyInto(out *GCPMachinePoolStatus) {
 	*out = *in
@@ -312,6 +1121,16 @@ func (in *GCPMachinePoolStatus) DeepCopyInto(out *GCPMachinePoolStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Autoscaler != nil {
+		in, out := &in.Autoscaler, &out.Autoscaler
+		*out = new(GCPMachinePoolAutoscalerStatus)
+		**out = **in
+	}
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]GCPMachinePoolMachineTypeStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolStatus.
@@ -324,6 +1143,152 @@ func (in *GCPMachinePoolStatus) DeepCopy() *GCPMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolStrategy) DeepCopyInto(out *GCPMachinePoolStrategy) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MinimalAction != nil {
+		in, out := &in.MinimalAction, &out.MinimalAction
+		*out = new(GCPMachinePoolMinimalAction)
+		**out = **in
+	}
+	if in.ReplacementMethod != nil {
+		in, out := &in.ReplacementMethod, &out.ReplacementMethod
+		*out = new(GCPMachinePoolReplacementMethod)
+		**out = **in
+	}
+	if in.InstanceRedistributionType != nil {
+		in, out := &in.InstanceRedistributionType, &out.InstanceRedistributionType
+		*out = new(GCPMachinePoolInstanceRedistributionType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolStrategy.
+func (in *GCPMachinePoolStrategy) DeepCopy() *GCPMachinePoolStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolTargetSizePolicy) DeepCopyInto(out *GCPMachinePoolTargetSizePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolTargetSizePolicy.
+func (in *GCPMachinePoolTargetSizePolicy) DeepCopy() *GCPMachinePoolTargetSizePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolTargetSizePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolTemplate) DeepCopyInto(out *GCPMachinePoolTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolTemplate.
+func (in *GCPMachinePoolTemplate) DeepCopy() *GCPMachinePoolTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachinePoolTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolTemplateList) DeepCopyInto(out *GCPMachinePoolTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPMachinePoolTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolTemplateList.
+func (in *GCPMachinePoolTemplateList) DeepCopy() *GCPMachinePoolTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachinePoolTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolTemplateResource) DeepCopyInto(out *GCPMachinePoolTemplateResource) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolTemplateResource.
+func (in *GCPMachinePoolTemplateResource) DeepCopy() *GCPMachinePoolTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachinePoolTemplateSpec) DeepCopyInto(out *GCPMachinePoolTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachinePoolTemplateSpec.
+func (in *GCPMachinePoolTemplateSpec) DeepCopy() *GCPMachinePoolTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachinePoolTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPManagedCluster) DeepCopyInto(out *GCPManagedCluster) {
 	*out = *in
@@ -628,11 +1593,31 @@ func (in *GCPManagedControlPlaneClassSpec) DeepCopyInto(out *GCPManagedControlPl
 		*out = new(ClusterSecurity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = new(ClusterAddons)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterAutoscaling != nil {
+		in, out := &in.ClusterAutoscaling, &out.ClusterAutoscaling
+		*out = new(ClusterAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableIdentityService != nil {
+		in, out := &in.EnableIdentityService, &out.EnableIdentityService
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ReleaseChannel != nil {
 		in, out := &in.ReleaseChannel, &out.ReleaseChannel
 		*out = new(ReleaseChannel)
 		**out = **in
 	}
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(MaintenancePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BinaryAuthorization != nil {
 		in, out := &in.BinaryAuthorization, &out.BinaryAuthorization
 		*out = new(BinaryAuthorization)
@@ -653,6 +1638,16 @@ func (in *GCPManagedControlPlaneClassSpec) DeepCopyInto(out *GCPManagedControlPl
 		*out = new(MonitoringService)
 		**out = **in
 	}
+	if in.UserKubeconfigMode != nil {
+		in, out := &in.UserKubeconfigMode, &out.UserKubeconfigMode
+		*out = new(UserKubeconfigMode)
+		**out = **in
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPManagedControlPlaneClassSpec.
@@ -701,6 +1696,16 @@ func (in *GCPManagedControlPlaneList) DeepCopyObject() runtime.Object {
 func (in *GCPManagedControlPlaneSpec) DeepCopyInto(out *GCPManagedControlPlaneSpec) {
 	*out = *in
 	in.GCPManagedControlPlaneClassSpec.DeepCopyInto(&out.GCPManagedControlPlaneClassSpec)
+	if in.Fleet != nil {
+		in, out := &in.Fleet, &out.Fleet
+		*out = new(Fleet)
+		**out = **in
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(Notifications)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ControlPlaneVersion != nil {
 		in, out := &in.ControlPlaneVersion, &out.ControlPlaneVersion
 		*out = new(string)
@@ -739,6 +1744,26 @@ func (in *GCPManagedControlPlaneStatus) DeepCopyInto(out *GCPManagedControlPlane
 		*out = new(string)
 		**out = **in
 	}
+	if in.DatabaseEncryption != nil {
+		in, out := &in.DatabaseEncryption, &out.DatabaseEncryption
+		*out = new(DatabaseEncryptionStatus)
+		**out = **in
+	}
+	if in.UpgradeTargets != nil {
+		in, out := &in.UpgradeTargets, &out.UpgradeTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialRotation != nil {
+		in, out := &in.CredentialRotation, &out.CredentialRotation
+		*out = new(CredentialRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedResourceLabels != nil {
+		in, out := &in.ManagedResourceLabels, &out.ManagedResourceLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPManagedControlPlaneStatus.
@@ -973,15 +1998,52 @@ func (in *GCPManagedMachinePoolClassSpec) DeepCopyInto(out *GCPManagedMachinePoo
 			(*out)[key] = val
 		}
 	}
-	if in.Management != nil {
-		in, out := &in.Management, &out.Management
-		*out = new(NodePoolManagement)
+	if in.Management != nil {
+		in, out := &in.Management, &out.Management
+		*out = new(NodePoolManagement)
+		**out = **in
+	}
+	if in.LinuxNodeConfig != nil {
+		in, out := &in.LinuxNodeConfig, &out.LinuxNodeConfig
+		*out = new(LinuxNodeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeletConfig != nil {
+		in, out := &in.KubeletConfig, &out.KubeletConfig
+		*out = new(KubeletConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerdConfig != nil {
+		in, out := &in.ContainerdConfig, &out.ContainerdConfig
+		*out = new(ContainerdConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeSettings != nil {
+		in, out := &in.UpgradeSettings, &out.UpgradeSettings
+		*out = new(NodePoolUpgradeSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Accelerators != nil {
+		in, out := &in.Accelerators, &out.Accelerators
+		*out = make([]Accelerator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReservationAffinity != nil {
+		in, out := &in.ReservationAffinity, &out.ReservationAffinity
+		*out = new(ReservationAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlacementPolicy != nil {
+		in, out := &in.PlacementPolicy, &out.PlacementPolicy
+		*out = new(PlacementPolicy)
 		**out = **in
 	}
-	if in.LinuxNodeConfig != nil {
-		in, out := &in.LinuxNodeConfig, &out.LinuxNodeConfig
-		*out = new(LinuxNodeConfig)
-		(*in).DeepCopyInto(*out)
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
 	}
 }
 
@@ -1058,6 +2120,11 @@ func (in *GCPManagedMachinePoolStatus) DeepCopyInto(out *GCPManagedMachinePoolSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BlueGreenUpgrade != nil {
+		in, out := &in.BlueGreenUpgrade, &out.BlueGreenUpgrade
+		*out = new(NodePoolBlueGreenUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPManagedMachinePoolStatus.
@@ -1176,6 +2243,101 @@ func (in *GCPManagedMachinePoolTemplateSpec) DeepCopy() *GCPManagedMachinePoolTe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUSharing) DeepCopyInto(out *GPUSharing) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUSharing.
+func (in *GPUSharing) DeepCopy() *GPUSharing {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugepagesConfig) DeepCopyInto(out *HugepagesConfig) {
+	*out = *in
+	if in.HugepageSize2M != nil {
+		in, out := &in.HugepageSize2M, &out.HugepageSize2M
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HugepageSize1G != nil {
+		in, out := &in.HugepageSize1G, &out.HugepageSize1G
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugepagesConfig.
+func (in *HugepagesConfig) DeepCopy() *HugepagesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HugepagesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	if in.CPUManagerPolicy != nil {
+		in, out := &in.CPUManagerPolicy, &out.CPUManagerPolicy
+		*out = new(CPUManagerPolicy)
+		**out = **in
+	}
+	if in.CPUCFSQuota != nil {
+		in, out := &in.CPUCFSQuota, &out.CPUCFSQuota
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CPUCFSQuotaPeriod != nil {
+		in, out := &in.CPUCFSQuotaPeriod, &out.CPUCFSQuotaPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PodPIDsLimit != nil {
+		in, out := &in.PodPIDsLimit, &out.PodPIDsLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ImageGCLowThresholdPercent != nil {
+		in, out := &in.ImageGCLowThresholdPercent, &out.ImageGCLowThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ImageGCHighThresholdPercent != nil {
+		in, out := &in.ImageGCHighThresholdPercent, &out.ImageGCHighThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InsecureKubeletReadonlyPortEnabled != nil {
+		in, out := &in.InsecureKubeletReadonlyPortEnabled, &out.InsecureKubeletReadonlyPortEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TopologyManager != nil {
+		in, out := &in.TopologyManager, &out.TopologyManager
+		*out = new(TopologyManager)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LinuxNodeConfig) DeepCopyInto(out *LinuxNodeConfig) {
 	*out = *in
@@ -1189,6 +2351,11 @@ func (in *LinuxNodeConfig) DeepCopyInto(out *LinuxNodeConfig) {
 		*out = new(ManagedNodePoolCgroupMode)
 		**out = **in
 	}
+	if in.Hugepages != nil {
+		in, out := &in.Hugepages, &out.Hugepages
+		*out = new(HugepagesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinuxNodeConfig.
@@ -1201,6 +2368,55 @@ func (in *LinuxNodeConfig) DeepCopy() *LinuxNodeConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceExclusion) DeepCopyInto(out *MaintenanceExclusion) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceExclusion.
+func (in *MaintenanceExclusion) DeepCopy() *MaintenanceExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicy) DeepCopyInto(out *MaintenancePolicy) {
+	*out = *in
+	if in.DailyMaintenanceWindow != nil {
+		in, out := &in.DailyMaintenanceWindow, &out.DailyMaintenanceWindow
+		*out = new(DailyMaintenanceWindow)
+		**out = **in
+	}
+	if in.RecurringWindow != nil {
+		in, out := &in.RecurringWindow, &out.RecurringWindow
+		*out = new(RecurringMaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceExclusions != nil {
+		in, out := &in.MaintenanceExclusions, &out.MaintenanceExclusions
+		*out = make([]MaintenanceExclusion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicy.
+func (in *MaintenancePolicy) DeepCopy() *MaintenancePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MasterAuthorizedNetworksConfig) DeepCopyInto(out *MasterAuthorizedNetworksConfig) {
 	*out = *in
@@ -1317,6 +2533,60 @@ func (in *NodePoolAutoScaling) DeepCopy() *NodePoolAutoScaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolBlueGreenSettings) DeepCopyInto(out *NodePoolBlueGreenSettings) {
+	*out = *in
+	if in.BatchNodeCount != nil {
+		in, out := &in.BatchNodeCount, &out.BatchNodeCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchPercentage != nil {
+		in, out := &in.BatchPercentage, &out.BatchPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchSoakDuration != nil {
+		in, out := &in.BatchSoakDuration, &out.BatchSoakDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NodePoolSoakDuration != nil {
+		in, out := &in.NodePoolSoakDuration, &out.NodePoolSoakDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolBlueGreenSettings.
+func (in *NodePoolBlueGreenSettings) DeepCopy() *NodePoolBlueGreenSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolBlueGreenSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolBlueGreenUpgradeStatus) DeepCopyInto(out *NodePoolBlueGreenUpgradeStatus) {
+	*out = *in
+	if in.BluePoolDeletionStartTime != nil {
+		in, out := &in.BluePoolDeletionStartTime, &out.BluePoolDeletionStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolBlueGreenUpgradeStatus.
+func (in *NodePoolBlueGreenUpgradeStatus) DeepCopy() *NodePoolBlueGreenUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolBlueGreenUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePoolManagement) DeepCopyInto(out *NodePoolManagement) {
 	*out = *in
@@ -1332,6 +2602,36 @@ func (in *NodePoolManagement) DeepCopy() *NodePoolManagement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolUpgradeSettings) DeepCopyInto(out *NodePoolUpgradeSettings) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BlueGreenSettings != nil {
+		in, out := &in.BlueGreenSettings, &out.BlueGreenSettings
+		*out = new(NodePoolBlueGreenSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolUpgradeSettings.
+func (in *NodePoolUpgradeSettings) DeepCopy() *NodePoolUpgradeSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolUpgradeSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeSecurityConfig) DeepCopyInto(out *NodeSecurityConfig) {
 	*out = *in
@@ -1363,6 +2663,41 @@ func (in *NodeSecurityConfig) DeepCopy() *NodeSecurityConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notifications) DeepCopyInto(out *Notifications) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = make([]NotificationType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notifications.
+func (in *Notifications) DeepCopy() *Notifications {
+	if in == nil {
+		return nil
+	}
+	out := new(Notifications)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicy.
+func (in *PlacementPolicy) DeepCopy() *PlacementPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateCluster) DeepCopyInto(out *PrivateCluster) {
 	*out = *in
@@ -1378,6 +2713,100 @@ func (in *PrivateCluster) DeepCopy() *PrivateCluster {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateRegistryAccess) DeepCopyInto(out *PrivateRegistryAccess) {
+	*out = *in
+	if in.CertificateAuthorityDomains != nil {
+		in, out := &in.CertificateAuthorityDomains, &out.CertificateAuthorityDomains
+		*out = make([]CertificateAuthorityDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateRegistryAccess.
+func (in *PrivateRegistryAccess) DeepCopy() *PrivateRegistryAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateRegistryAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecurringMaintenanceWindow) DeepCopyInto(out *RecurringMaintenanceWindow) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecurringMaintenanceWindow.
+func (in *RecurringMaintenanceWindow) DeepCopy() *RecurringMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(RecurringMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationAffinity) DeepCopyInto(out *ReservationAffinity) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationAffinity.
+func (in *ReservationAffinity) DeepCopy() *ReservationAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceLimit) DeepCopyInto(out *ResourceLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLimit.
+func (in *ResourceLimit) DeepCopy() *ResourceLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
 	*out = *in
@@ -1452,6 +2881,21 @@ func (in Taints) DeepCopy() Taints {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyManager) DeepCopyInto(out *TopologyManager) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyManager.
+func (in *TopologyManager) DeepCopy() *TopologyManager {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadIdentityConfig) DeepCopyInto(out *WorkloadIdentityConfig) {
 	*out = *in
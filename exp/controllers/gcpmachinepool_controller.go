@@ -20,15 +20,21 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"maps"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -42,13 +48,17 @@ import (
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/labels/format"
+	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/autoscalers"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/instancegroupmanagers"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/instancetemplates"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/resourceurl"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -62,6 +72,8 @@ type GCPMachinePoolReconciler struct {
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinepools,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinepools/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinepoolmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
 
@@ -103,7 +115,7 @@ func (r *GCPMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, nil
 	}
 
-	clusterScope, err := r.createScope(ctx, clusterObj, gcpMachinePool)
+	clusterScope, err := createClusterScope(ctx, r.Client, clusterObj, gcpMachinePool)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("getting infra provider cluster or control plane object: %w", err)
 	}
@@ -127,9 +139,13 @@ func (r *GCPMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	// Always close the scope when exiting this function so we can persist any GCPMachinePool changes.
 	defer func() {
 		// Compute the Ready condition from the other conditions
+		summaryConditionTypes := []string{string(expinfrav1.MIGReadyCondition), string(expinfrav1.InstanceTemplateReadyCondition)}
+		if machinePoolScope.ReplicasManagedByAutoscaler() {
+			summaryConditionTypes = append(summaryConditionTypes, string(expinfrav1.AutoscalerReadyCondition))
+		}
 		if err := conditions.SetSummaryCondition(machinePoolScope.GCPMachinePool, machinePoolScope.GCPMachinePool,
 			expinfrav1.ReadyCondition,
-			conditions.ForConditionTypes([]string{string(expinfrav1.MIGReadyCondition), string(expinfrav1.InstanceTemplateReadyCondition)}),
+			conditions.ForConditionTypes(summaryConditionTypes),
 		); err != nil && reterr == nil {
 			reterr = err
 		}
@@ -224,6 +240,11 @@ func (r *GCPMachinePoolReconciler) reconcile(ctx context.Context, machinePoolSco
 		Status: metav1.ConditionTrue,
 	})
 
+	// A disabled autoscaler is deleted before the instanceGroupManager is resized to the MachinePool replicas.
+	if err := r.deleteAutoscaler(ctx, machinePoolScope); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	igm, err := instancegroupmanagers.New(machinePoolScope).Reconcile(ctx, instanceTemplateKey)
 	if err != nil {
 		log.Error(err, "Error reconciling instanceGroupManager")
@@ -243,45 +264,503 @@ func (r *GCPMachinePoolReconciler) reconcile(ctx context.Context, machinePoolSco
 		Status: metav1.ConditionTrue,
 	})
 
-	igmInstances, err := instancegroupmanagers.New(machinePoolScope).ListInstances(ctx, igm)
+	// set the MIGUpToDateCondition condition, reporting rollout progress of the current instance template
+	conditions.Set(machinePoolScope.GCPMachinePool, migUpToDateCondition(igm))
+
+	if err := r.reconcileAutoscaler(ctx, machinePoolScope, igm); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Once the MIG has fully moved to the current instance template, delete superseded instance templates.
+	// Failures are not fatal, garbage collection is retried on the next reconcile.
+	if conditions.IsTrue(machinePoolScope.GCPMachinePool, string(expinfrav1.MIGUpToDateCondition)) {
+		if err := instancetemplates.New(machinePoolScope).GarbageCollect(ctx, instanceTemplateKey); err != nil {
+			log.Error(err, "Error deleting superseded instanceTemplates")
+			r.Recorder.Eventf(machinePoolScope.GCPMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete superseded instance templates: %v", err)
+		}
+	}
+
+	managedInstances, err := instancegroupmanagers.New(machinePoolScope).ListManagedInstances(ctx)
 	if err != nil {
 		log.Error(err, "Error listing instances in instanceGroupManager")
 		return ctrl.Result{}, err
 	}
 
-	providerIDList := make([]string, len(igmInstances))
+	previousDeleted, err := r.deletePreviousInstanceGroupManager(ctx, machinePoolScope, igm, managedInstances)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
-	for i, instance := range igmInstances {
-		var providerID string
+	providerIDList := make([]string, len(managedInstances))
 
-		// Convert instance URL to providerID format
-		u := instance.Instance
-		u = strings.TrimPrefix(u, "https://www.googleapis.com/compute/v1/")
-		tokens := strings.Split(u, "/")
-		if len(tokens) == 6 && tokens[0] == "projects" && tokens[2] == "zones" && tokens[4] == "instances" {
-			providerID = fmt.Sprintf("gce://%s/%s/%s", tokens[1], tokens[3], tokens[5])
-		} else {
-			return ctrl.Result{}, fmt.Errorf("unexpected instance URL format: %s", instance.Instance)
+	for i, instance := range managedInstances {
+		providerID, err := providerIDFromInstanceURL(instance.Instance)
+		if err != nil {
+			return ctrl.Result{}, err
 		}
 
 		providerIDList[i] = providerID
 	}
 
+	// Instances of a regional MIG are listed across zones, keep the list stable between reconciles.
+	sort.Strings(providerIDList)
+
 	machinePoolScope.GCPMachinePool.Spec.ProviderIDList = providerIDList
 	machinePoolScope.GCPMachinePool.Status.Replicas = int32(len(providerIDList))
 	machinePoolScope.GCPMachinePool.Status.Ready = true
 
+	instances, err := instancegroupmanagers.New(machinePoolScope).ListInstanceDetails(ctx, managedInstances)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	machinePoolScope.GCPMachinePool.Status.MachineTypes = machineTypesStatus(instances)
+
+	// Expose an infrastructure machine per instance, so that CAPI creates a Machine for each of them.
+	machinePoolScope.GCPMachinePool.Status.InfrastructureMachineKind = "GCPMachinePoolMachine"
+	if err := r.reconcileMachinePoolMachines(ctx, machinePoolScope, igm, managedInstances, instances); err != nil {
+		log.Error(err, "Error reconciling GCPMachinePoolMachines")
+		return ctrl.Result{}, err
+	}
+
 	// Requeue so that we can keep the spec.providerIDList and status in sync with the MIG.
 	// This is important for scaling up and down, as the CAPI MachinePool controller relies on
 	// the providerIDList to determine which machines belong to the MachinePool.
+	// While a rollout or a move to another instanceGroupManager is in progress, requeue sooner to report its progress.
+	if !previousDeleted || !conditions.IsTrue(machinePoolScope.GCPMachinePool, string(expinfrav1.MIGUpToDateCondition)) {
+		return ctrl.Result{RequeueAfter: 20 * time.Second}, nil
+	}
 	return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
 }
 
+// machineTypesStatus counts the instances per machine type and provisioning model.
+func machineTypesStatus(instances map[string]*compute.Instance) []expinfrav1.GCPMachinePoolMachineTypeStatus {
+	counts := map[expinfrav1.GCPMachinePoolMachineTypeStatus]int32{}
+	for _, instance := range instances {
+		provisioningModel := infrav1.ProvisioningModelStandard
+		if instance.Scheduling != nil && instance.Scheduling.ProvisioningModel == "SPOT" {
+			provisioningModel = infrav1.ProvisioningModelSpot
+		}
+		counts[expinfrav1.GCPMachinePoolMachineTypeStatus{
+			MachineType:       path.Base(instance.MachineType),
+			ProvisioningModel: provisioningModel,
+		}]++
+	}
+
+	machineTypes := make([]expinfrav1.GCPMachinePoolMachineTypeStatus, 0, len(counts))
+	for machineType, replicas := range counts {
+		machineType.Replicas = replicas
+		machineTypes = append(machineTypes, machineType)
+	}
+	sort.Slice(machineTypes, func(i, j int) bool {
+		if machineTypes[i].MachineType != machineTypes[j].MachineType {
+			return machineTypes[i].MachineType < machineTypes[j].MachineType
+		}
+		return machineTypes[i].ProvisioningModel < machineTypes[j].ProvisioningModel
+	})
+
+	return machineTypes
+}
+
+// providerIDFromInstanceURL converts an instance URL to the providerID format.
+func providerIDFromInstanceURL(instanceURL string) (string, error) {
+	u := strings.TrimPrefix(instanceURL, "https://www.googleapis.com/compute/v1/")
+	tokens := strings.Split(u, "/")
+	if len(tokens) == 6 && tokens[0] == "projects" && tokens[2] == "zones" && tokens[4] == "instances" {
+		return fmt.Sprintf("gce://%s/%s/%s", tokens[1], tokens[3], tokens[5]), nil
+	}
+
+	return "", fmt.Errorf("unexpected instance URL format: %s", instanceURL)
+}
+
+// reconcileMachinePoolMachines creates or updates a GCPMachinePoolMachine for each instance of the MIG.
+// GCPMachinePoolMachines whose instance is no longer part of the MIG are deleted, through their Machine if they have one.
+// The instances are the compute instances backing the managed instances, keyed by selfLink.
+func (r *GCPMachinePoolReconciler) reconcileMachinePoolMachines(ctx context.Context, machinePoolScope *scope.MachinePoolScope, igm *compute.InstanceGroupManager, managedInstances []*compute.ManagedInstance, instances map[string]*compute.Instance) error {
+	log := log.FromContext(ctx)
+
+	machineLabels := map[string]string{
+		clusterv1.MachinePoolNameLabel: format.MustFormatValue(machinePoolScope.MachinePool.Name),
+		clusterv1.ClusterNameLabel:     machinePoolScope.ClusterName(),
+	}
+
+	machineList := &expinfrav1.GCPMachinePoolMachineList{}
+	if err := r.Client.List(ctx, machineList, client.InNamespace(machinePoolScope.Namespace()), client.MatchingLabels(machineLabels)); err != nil {
+		return fmt.Errorf("listing GCPMachinePoolMachines: %w", err)
+	}
+
+	existing := make(map[string]*expinfrav1.GCPMachinePoolMachine, len(machineList.Items))
+	for i := range machineList.Items {
+		existing[machineList.Items[i].Name] = &machineList.Items[i]
+	}
+
+	for _, managedInstance := range managedInstances {
+		providerID, err := providerIDFromInstanceURL(managedInstance.Instance)
+		if err != nil {
+			return err
+		}
+
+		gcpMachinePoolMachine, ok := existing[managedInstance.Name]
+		delete(existing, managedInstance.Name)
+		if !ok {
+			// An instance leaving the MIG would get its GCPMachinePoolMachine recreated until GCE drops it.
+			if leavingInstanceGroup(managedInstance) {
+				continue
+			}
+
+			gcpMachinePoolMachine = &expinfrav1.GCPMachinePoolMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       managedInstance.Name,
+					Namespace:  machinePoolScope.Namespace(),
+					Labels:     maps.Clone(machineLabels),
+					Finalizers: []string{expinfrav1.MachinePoolMachineFinalizer},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: expinfrav1.GroupVersion.String(),
+							Kind:       "GCPMachinePool",
+							Name:       machinePoolScope.GCPMachinePool.Name,
+							UID:        machinePoolScope.GCPMachinePool.UID,
+						},
+					},
+				},
+				Spec: expinfrav1.GCPMachinePoolMachineSpec{
+					ProviderID: providerID,
+				},
+			}
+			log.Info("Creating GCPMachinePoolMachine", "name", managedInstance.Name)
+			if err := r.Client.Create(ctx, gcpMachinePoolMachine); err != nil {
+				return fmt.Errorf("creating GCPMachinePoolMachine %s: %w", managedInstance.Name, err)
+			}
+		}
+
+		if !gcpMachinePoolMachine.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		patchHelper, err := patch.NewHelper(gcpMachinePoolMachine, r.Client)
+		if err != nil {
+			return fmt.Errorf("init GCPMachinePoolMachine patch helper: %w", err)
+		}
+
+		if err := setMachinePoolMachineStatus(gcpMachinePoolMachine, igm, managedInstance, instances[managedInstance.Instance]); err != nil {
+			return err
+		}
+
+		if err := patchHelper.Patch(ctx, gcpMachinePoolMachine, patch.WithOwnedConditions{Conditions: []string{
+			expinfrav1.ReadyCondition,
+			string(expinfrav1.InstanceReadyCondition),
+		}}); err != nil {
+			return fmt.Errorf("patching GCPMachinePoolMachine %s: %w", managedInstance.Name, err)
+		}
+	}
+
+	// The remaining GCPMachinePoolMachines refer to instances that are gone, e.g. after a scale down.
+	for _, gcpMachinePoolMachine := range existing {
+		if !gcpMachinePoolMachine.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		machine, err := util.GetOwnerMachine(ctx, r.Client, gcpMachinePoolMachine.ObjectMeta)
+		if err != nil {
+			return err
+		}
+
+		if machine != nil {
+			log.Info("Deleting Machine of removed instance", "machine", klog.KObj(machine))
+			if err := r.Client.Delete(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("deleting Machine %s: %w", machine.Name, err)
+			}
+			continue
+		}
+
+		log.Info("Deleting GCPMachinePoolMachine of removed instance", "name", gcpMachinePoolMachine.Name)
+		if err := r.Client.Delete(ctx, gcpMachinePoolMachine); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting GCPMachinePoolMachine %s: %w", gcpMachinePoolMachine.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// leavingInstanceGroup returns whether the managed instance is being deleted or abandoned by the MIG.
+func leavingInstanceGroup(managedInstance *compute.ManagedInstance) bool {
+	return managedInstance.CurrentAction == "DELETING" || managedInstance.CurrentAction == "ABANDONING"
+}
+
+// setMachinePoolMachineStatus sets the status of the GCPMachinePoolMachine from its managed instance.
+// The instance may be nil while it is being created.
+func setMachinePoolMachineStatus(gcpMachinePoolMachine *expinfrav1.GCPMachinePoolMachine, igm *compute.InstanceGroupManager, managedInstance *compute.ManagedInstance, instance *compute.Instance) error {
+	gcpMachinePoolMachine.Spec.InstanceID = ""
+	if managedInstance.Id != 0 {
+		gcpMachinePoolMachine.Spec.InstanceID = strconv.FormatUint(managedInstance.Id, 10)
+	}
+
+	status := &gcpMachinePoolMachine.Status
+	status.CurrentAction = managedInstance.CurrentAction
+	status.InstanceStatus = nil
+	if managedInstance.InstanceStatus != "" {
+		status.InstanceStatus = ptr.To(infrav1.InstanceStatus(managedInstance.InstanceStatus))
+	}
+
+	status.Zone = ""
+	if u, err := resourceurl.Parse(managedInstance.Instance); err == nil {
+		status.Zone = u.Location
+	}
+
+	status.InstanceTemplate = ""
+	status.LatestModelApplied = false
+	if managedInstance.Version != nil {
+		status.InstanceTemplate = path.Base(managedInstance.Version.InstanceTemplate)
+		status.LatestModelApplied = status.InstanceTemplate == path.Base(igm.InstanceTemplate)
+	}
+
+	status.Addresses = nil
+	if instance != nil {
+		for _, nic := range instance.NetworkInterfaces {
+			status.Addresses = append(status.Addresses, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: nic.NetworkIP,
+			})
+			for _, config := range nic.AccessConfigs {
+				if config.NatIP != "" {
+					status.Addresses = append(status.Addresses, corev1.NodeAddress{
+						Type:    corev1.NodeExternalIP,
+						Address: config.NatIP,
+					})
+				}
+			}
+		}
+	}
+
+	status.Ready = managedInstance.InstanceStatus == "RUNNING" && managedInstance.CurrentAction == "NONE"
+	if status.Ready {
+		conditions.Set(gcpMachinePoolMachine, metav1.Condition{
+			Type:   string(expinfrav1.InstanceReadyCondition),
+			Status: metav1.ConditionTrue,
+			Reason: expinfrav1.InstanceRunningReason,
+		})
+	} else {
+		conditions.Set(gcpMachinePoolMachine, metav1.Condition{
+			Type:    string(expinfrav1.InstanceReadyCondition),
+			Status:  metav1.ConditionFalse,
+			Reason:  expinfrav1.InstanceNotReadyReason,
+			Message: fmt.Sprintf("Instance is %s, current action is %s", managedInstance.InstanceStatus, managedInstance.CurrentAction),
+		})
+	}
+
+	return conditions.SetSummaryCondition(gcpMachinePoolMachine, gcpMachinePoolMachine, expinfrav1.ReadyCondition,
+		conditions.ForConditionTypes([]string{string(expinfrav1.InstanceReadyCondition)}),
+	)
+}
+
+// migUpToDateCondition reports whether all instances of the MIG run the current instance template,
+// based on the versionTarget and isStable status of the MIG.
+func migUpToDateCondition(igm *compute.InstanceGroupManager) metav1.Condition {
+	condition := metav1.Condition{
+		Type:   string(expinfrav1.MIGUpToDateCondition),
+		Status: metav1.ConditionFalse,
+	}
+
+	switch status := igm.Status; {
+	case status == nil || status.VersionTarget == nil || !status.VersionTarget.IsReached:
+		condition.Reason = expinfrav1.MIGRolloutInProgressReason
+		condition.Message = "Instances are being updated to the current instance template"
+	case !status.IsStable:
+		condition.Reason = expinfrav1.MIGNotStableReason
+		condition.Message = "Instances run the current instance template, but the managed instance group is not stable yet"
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = expinfrav1.MIGUpToDateReason
+	}
+
+	return condition
+}
+
+// reconcileAutoscaler reconciles the autoscaler of the managed instance group.
+// While autoscaling is enabled, the MachinePool is annotated with cluster.x-k8s.io/replicas-managed-by and
+// its replicas follow the target size of the managed instance group, so that CAPI does not fight the autoscaler.
+func (r *GCPMachinePoolReconciler) reconcileAutoscaler(ctx context.Context, machinePoolScope *scope.MachinePoolScope, igm *compute.InstanceGroupManager) error {
+	log := log.FromContext(ctx)
+
+	if !machinePoolScope.ReplicasManagedByAutoscaler() {
+		return nil
+	}
+
+	autoscaler, err := autoscalers.New(machinePoolScope).Reconcile(ctx, igm)
+	if err != nil {
+		log.Error(err, "Error reconciling autoscaler")
+		conditions.Set(machinePoolScope.GCPMachinePool, metav1.Condition{
+			Type:    string(expinfrav1.AutoscalerReadyCondition),
+			Status:  metav1.ConditionFalse,
+			Reason:  expinfrav1.AutoscalerReconcileFailedReason,
+			Message: fmt.Sprintf("Error reconciling autoscaler: %v", err),
+		})
+		return err
+	}
+
+	machinePoolScope.GCPMachinePool.Status.Autoscaler = autoscalerStatus(autoscaler)
+	conditions.Set(machinePoolScope.GCPMachinePool, autoscalerReadyCondition(autoscaler))
+
+	machinePool := machinePoolScope.MachinePool
+	changed := false
+	if _, ok := machinePool.Annotations[clusterv1.ReplicasManagedByAnnotation]; !ok {
+		changed = annotations.AddAnnotations(machinePool, map[string]string{
+			clusterv1.ReplicasManagedByAnnotation: expinfrav1.ReplicasManagedByGCEAutoscaler,
+		})
+	}
+	if replicas := int32(igm.TargetSize); ptr.Deref(machinePool.Spec.Replicas, -1) != replicas {
+		log.V(2).Info("Updating MachinePool replicas to the managed instance group size", "replicas", replicas)
+		machinePool.Spec.Replicas = ptr.To(replicas)
+		changed = true
+	}
+	if changed {
+		if err := machinePoolScope.PatchCAPIMachinePoolObject(ctx); err != nil {
+			return fmt.Errorf("patching MachinePool: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteAutoscaler deletes the autoscaler once autoscaling is disabled, and hands the replicas back to the MachinePool.
+func (r *GCPMachinePoolReconciler) deleteAutoscaler(ctx context.Context, machinePoolScope *scope.MachinePoolScope) error {
+	log := log.FromContext(ctx)
+
+	if machinePoolScope.ReplicasManagedByAutoscaler() || machinePoolScope.GCPMachinePool.Status.Autoscaler == nil {
+		return nil
+	}
+
+	if err := autoscalers.New(machinePoolScope).Delete(ctx); err != nil {
+		log.Error(err, "Error deleting autoscaler")
+		r.Recorder.Eventf(machinePoolScope.GCPMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete autoscaler: %v", err)
+		return err
+	}
+	machinePoolScope.GCPMachinePool.Status.Autoscaler = nil
+	conditions.Delete(machinePoolScope.GCPMachinePool, string(expinfrav1.AutoscalerReadyCondition))
+
+	machinePool := machinePoolScope.MachinePool
+	if machinePool.Annotations[clusterv1.ReplicasManagedByAnnotation] == expinfrav1.ReplicasManagedByGCEAutoscaler {
+		delete(machinePool.Annotations, clusterv1.ReplicasManagedByAnnotation)
+		if err := machinePoolScope.PatchCAPIMachinePoolObject(ctx); err != nil {
+			return fmt.Errorf("patching MachinePool: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// autoscalerStatus returns the observed state of the autoscaler.
+func autoscalerStatus(autoscaler *compute.Autoscaler) *expinfrav1.GCPMachinePoolAutoscalerStatus {
+	messages := make([]string, 0, len(autoscaler.StatusDetails))
+	for _, details := range autoscaler.StatusDetails {
+		messages = append(messages, details.Message)
+	}
+
+	return &expinfrav1.GCPMachinePoolAutoscalerStatus{
+		RecommendedReplicas: int32(autoscaler.RecommendedSize),
+		Status:              autoscaler.Status,
+		Message:             strings.Join(messages, "; "),
+	}
+}
+
+// autoscalerReadyCondition returns the AutoscalerReady condition for the autoscaler.
+func autoscalerReadyCondition(autoscaler *compute.Autoscaler) metav1.Condition {
+	if autoscaler.Status == "ACTIVE" {
+		return metav1.Condition{
+			Type:   string(expinfrav1.AutoscalerReadyCondition),
+			Status: metav1.ConditionTrue,
+			Reason: expinfrav1.AutoscalerActiveReason,
+		}
+	}
+
+	message := fmt.Sprintf("Autoscaler is %s", autoscaler.Status)
+	if status := autoscalerStatus(autoscaler); status.Message != "" {
+		message += ": " + status.Message
+	}
+
+	return metav1.Condition{
+		Type:    string(expinfrav1.AutoscalerReadyCondition),
+		Status:  metav1.ConditionFalse,
+		Reason:  expinfrav1.AutoscalerNotActiveReason,
+		Message: message,
+	}
+}
+
+// deletePreviousInstanceGroupManager deletes the instanceGroupManager and the autoscaler the machine pool used before
+// it moved between a single zone and several zones, and records the current instanceGroupManager in the status.
+// When the current instanceGroupManager is passed, the previous one is kept until the current one is ready, so that
+// the machine pool keeps its capacity during the move, and false is returned. It is nil when the machine pool is deleted.
+func (r *GCPMachinePoolReconciler) deletePreviousInstanceGroupManager(ctx context.Context, machinePoolScope *scope.MachinePoolScope, igm *compute.InstanceGroupManager, managedInstances []*compute.ManagedInstance) (bool, error) {
+	log := log.FromContext(ctx)
+
+	if igm != nil {
+		previous, err := machinePoolScope.PreviousInstanceGroupManagerResourceName()
+		if err != nil {
+			return false, err
+		}
+		if previous != nil && !instanceGroupManagerReady(machinePoolScope.MachinePool, igm, managedInstances) {
+			log.Info("Waiting for the instanceGroupManager to be ready before deleting the previous one", "previous", previous.Name)
+			return false, nil
+		}
+	}
+
+	if err := autoscalers.New(machinePoolScope).DeletePrevious(ctx); err != nil {
+		log.Error(err, "Error deleting previous autoscaler")
+		r.Recorder.Eventf(machinePoolScope.GCPMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete previous autoscaler: %v", err)
+		return false, err
+	}
+	if err := instancegroupmanagers.New(machinePoolScope).DeletePrevious(ctx); err != nil {
+		log.Error(err, "Error deleting previous instanceGroupManager")
+		r.Recorder.Eventf(machinePoolScope.GCPMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete previous instancegroupmanager: %v", err)
+		return false, err
+	}
+
+	igmKey, err := machinePoolScope.InstanceGroupManagerResourceName()
+	if err != nil {
+		return false, err
+	}
+	machinePoolScope.SetInstanceGroupManagerResourceName(igmKey)
+
+	return true, nil
+}
+
+// instanceGroupManagerReady returns true if the instanceGroupManager is stable, runs as many instances as its target
+// size, and the nodes of the machine pool are ready.
+func instanceGroupManagerReady(machinePool *clusterv1.MachinePool, igm *compute.InstanceGroupManager, managedInstances []*compute.ManagedInstance) bool {
+	if igm.Status == nil || !igm.Status.IsStable {
+		return false
+	}
+
+	var running int64
+	for _, instance := range managedInstances {
+		if instance.InstanceStatus == "RUNNING" {
+			running++
+		}
+	}
+	if running < igm.TargetSize {
+		return false
+	}
+
+	return int64(ptr.Deref(machinePool.Status.ReadyReplicas, 0)) >= igm.TargetSize
+}
+
 func (r *GCPMachinePoolReconciler) reconcileDelete(ctx context.Context, machinePoolScope *scope.MachinePoolScope) error {
 	log := log.FromContext(ctx)
 
 	log.Info("Handling deleted GCPMachinePool")
 
+	// An instanceGroupManager left behind by a move between a single zone and several zones is deleted as well.
+	if _, err := r.deletePreviousInstanceGroupManager(ctx, machinePoolScope, nil, nil); err != nil {
+		return err
+	}
+
+	if machinePoolScope.ReplicasManagedByAutoscaler() || machinePoolScope.GCPMachinePool.Status.Autoscaler != nil {
+		if err := autoscalers.New(machinePoolScope).Delete(ctx); err != nil {
+			log.Error(err, "Error deleting autoscaler")
+			r.Recorder.Eventf(machinePoolScope.GCPMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete autoscaler: %v", err)
+			return err
+		}
+	}
+
 	if err := instancegroupmanagers.New(machinePoolScope).Delete(ctx); err != nil {
 		log.Error(err, "Error deleting instanceGroupManager")
 		r.Recorder.Eventf(machinePoolScope.GCPMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete instancegroupmanager: %v", err)
@@ -314,7 +793,7 @@ func (r *GCPMachinePoolReconciler) reconcileDelete(ctx context.Context, machineP
 	return nil
 }
 
-func (r *GCPMachinePoolReconciler) createScope(ctx context.Context, cluster *clusterv1.Cluster, gcpMachinePool *expinfrav1.GCPMachinePool) (*scope.ClusterScope, error) {
+func createClusterScope(ctx context.Context, c client.Client, cluster *clusterv1.Cluster, gcpMachinePool *expinfrav1.GCPMachinePool) (*scope.ClusterScope, error) {
 	gcpCluster := &infrav1.GCPCluster{}
 
 	gcpClusterKey := client.ObjectKey{
@@ -322,14 +801,14 @@ func (r *GCPMachinePoolReconciler) createScope(ctx context.Context, cluster *clu
 		Name:      cluster.Spec.InfrastructureRef.Name,
 	}
 
-	if err := r.Client.Get(ctx, gcpClusterKey, gcpCluster); err != nil {
+	if err := c.Get(ctx, gcpClusterKey, gcpCluster); err != nil {
 		// GCPCluster is not ready
 		return nil, nil //nolint:nilerr
 	}
 
 	// Create the cluster scope
 	clusterScope, err := scope.NewClusterScope(ctx, scope.ClusterScopeParams{
-		Client:     r.Client,
+		Client:     c,
 		Cluster:    cluster,
 		GCPCluster: gcpCluster,
 	})
@@ -0,0 +1,433 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestProviderIDFromInstanceURL(t *testing.T) {
+	g := NewWithT(t)
+
+	providerID, err := providerIDFromInstanceURL("https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/pool-abcd")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(providerID).To(Equal("gce://my-project/us-central1-a/pool-abcd"))
+
+	_, err = providerIDFromInstanceURL("https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instanceGroups/pool")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestSetMachinePoolMachineStatus(t *testing.T) {
+	igm := &compute.InstanceGroupManager{
+		InstanceTemplate: "https://www.googleapis.com/compute/v1/projects/my-project/global/instanceTemplates/pool-0123456789abcdef",
+	}
+	instanceURL := "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/pool-abcd"
+
+	tests := []struct {
+		name            string
+		managedInstance *compute.ManagedInstance
+		instance        *compute.Instance
+		wantStatus      expinfrav1.GCPMachinePoolMachineStatus
+		wantInstanceID  string
+		wantReady       metav1.ConditionStatus
+	}{
+		{
+			name: "running instance with the current template",
+			managedInstance: &compute.ManagedInstance{
+				Id:             1234,
+				Name:           "pool-abcd",
+				Instance:       instanceURL,
+				InstanceStatus: "RUNNING",
+				CurrentAction:  "NONE",
+				Version: &compute.ManagedInstanceVersion{
+					InstanceTemplate: "global/instanceTemplates/pool-0123456789abcdef",
+				},
+			},
+			instance: &compute.Instance{
+				SelfLink: instanceURL,
+				NetworkInterfaces: []*compute.NetworkInterface{
+					{
+						NetworkIP:     "10.0.0.2",
+						AccessConfigs: []*compute.AccessConfig{{NatIP: "34.1.2.3"}},
+					},
+				},
+			},
+			wantStatus: expinfrav1.GCPMachinePoolMachineStatus{
+				Ready:              true,
+				Zone:               "us-central1-a",
+				InstanceStatus:     ptr.To(infrav1.InstanceStatus("RUNNING")),
+				CurrentAction:      "NONE",
+				InstanceTemplate:   "pool-0123456789abcdef",
+				LatestModelApplied: true,
+				Addresses: []corev1.NodeAddress{
+					{Type: corev1.NodeInternalIP, Address: "10.0.0.2"},
+					{Type: corev1.NodeExternalIP, Address: "34.1.2.3"},
+				},
+			},
+			wantInstanceID: "1234",
+			wantReady:      metav1.ConditionTrue,
+		},
+		{
+			name: "instance being recreated with an outdated template",
+			managedInstance: &compute.ManagedInstance{
+				Id:             1234,
+				Name:           "pool-abcd",
+				Instance:       instanceURL,
+				InstanceStatus: "STOPPING",
+				CurrentAction:  "RECREATING",
+				Version: &compute.ManagedInstanceVersion{
+					InstanceTemplate: "global/instanceTemplates/pool-fedcba9876543210",
+				},
+			},
+			wantStatus: expinfrav1.GCPMachinePoolMachineStatus{
+				Zone:             "us-central1-a",
+				InstanceStatus:   ptr.To(infrav1.InstanceStatus("STOPPING")),
+				CurrentAction:    "RECREATING",
+				InstanceTemplate: "pool-fedcba9876543210",
+			},
+			wantInstanceID: "1234",
+			wantReady:      metav1.ConditionFalse,
+		},
+		{
+			name: "instance being created",
+			managedInstance: &compute.ManagedInstance{
+				Name:          "pool-abcd",
+				Instance:      instanceURL,
+				CurrentAction: "CREATING",
+			},
+			wantStatus: expinfrav1.GCPMachinePoolMachineStatus{
+				Zone:          "us-central1-a",
+				CurrentAction: "CREATING",
+			},
+			wantReady: metav1.ConditionFalse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			gcpMachinePoolMachine := &expinfrav1.GCPMachinePoolMachine{}
+			g.Expect(setMachinePoolMachineStatus(gcpMachinePoolMachine, igm, tt.managedInstance, tt.instance)).To(Succeed())
+
+			g.Expect(gcpMachinePoolMachine.Spec.InstanceID).To(Equal(tt.wantInstanceID))
+
+			status := gcpMachinePoolMachine.Status
+			status.Conditions = nil
+			g.Expect(status).To(Equal(tt.wantStatus))
+
+			g.Expect(conditions.Get(gcpMachinePoolMachine, string(expinfrav1.InstanceReadyCondition)).Status).To(Equal(tt.wantReady))
+			g.Expect(conditions.Get(gcpMachinePoolMachine, expinfrav1.ReadyCondition).Status).To(Equal(tt.wantReady))
+		})
+	}
+}
+
+func TestAutoscalerStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	autoscaler := &compute.Autoscaler{
+		RecommendedSize: 4,
+		Status:          "ACTIVE",
+	}
+	g.Expect(autoscalerStatus(autoscaler)).To(Equal(&expinfrav1.GCPMachinePoolAutoscalerStatus{RecommendedReplicas: 4, Status: "ACTIVE"}))
+	g.Expect(autoscalerReadyCondition(autoscaler).Status).To(Equal(metav1.ConditionTrue))
+
+	autoscaler = &compute.Autoscaler{
+		RecommendedSize: 10,
+		Status:          "ERROR",
+		StatusDetails: []*compute.AutoscalerStatusDetails{
+			{Type: "SCALING_TARGET_DOES_NOT_EXIST", Message: "The target does not exist."},
+			{Type: "CUSTOM_METRIC_INVALID", Message: "The custom metric is invalid."},
+		},
+	}
+	g.Expect(autoscalerStatus(autoscaler)).To(Equal(&expinfrav1.GCPMachinePoolAutoscalerStatus{
+		RecommendedReplicas: 10,
+		Status:              "ERROR",
+		Message:             "The target does not exist.; The custom metric is invalid.",
+	}))
+	condition := autoscalerReadyCondition(autoscaler)
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(expinfrav1.AutoscalerNotActiveReason))
+	g.Expect(condition.Message).To(Equal("Autoscaler is ERROR: The target does not exist.; The custom metric is invalid."))
+}
+
+func TestMachineTypesStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	machineType := func(name string) string {
+		return "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/machineTypes/" + name
+	}
+	instances := map[string]*compute.Instance{
+		"pool-a": {MachineType: machineType("n2-standard-4"), Scheduling: &compute.Scheduling{ProvisioningModel: "SPOT"}},
+		"pool-b": {MachineType: machineType("n2-standard-4"), Scheduling: &compute.Scheduling{ProvisioningModel: "STANDARD"}},
+		"pool-c": {MachineType: machineType("e2-standard-4"), Scheduling: &compute.Scheduling{ProvisioningModel: "SPOT"}},
+		"pool-d": {MachineType: machineType("n2-standard-4"), Scheduling: &compute.Scheduling{ProvisioningModel: "SPOT"}},
+	}
+
+	g.Expect(machineTypesStatus(instances)).To(Equal([]expinfrav1.GCPMachinePoolMachineTypeStatus{
+		{MachineType: "e2-standard-4", ProvisioningModel: infrav1.ProvisioningModelSpot, Replicas: 1},
+		{MachineType: "n2-standard-4", ProvisioningModel: infrav1.ProvisioningModelSpot, Replicas: 2},
+		{MachineType: "n2-standard-4", ProvisioningModel: infrav1.ProvisioningModelStandard, Replicas: 1},
+	}))
+}
+
+func newTestMachinePoolClient(g *WithT, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	g.Expect(expinfrav1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&expinfrav1.GCPMachinePoolMachine{}).
+		Build()
+}
+
+func newTestGCPMachinePoolMachine(name string) *expinfrav1.GCPMachinePoolMachine {
+	return &expinfrav1.GCPMachinePoolMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				clusterv1.MachinePoolNameLabel: "pool",
+				clusterv1.ClusterNameLabel:     "my-cluster",
+			},
+			Finalizers: []string{expinfrav1.MachinePoolMachineFinalizer},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: expinfrav1.GroupVersion.String(), Kind: "GCPMachinePool", Name: "pool"},
+			},
+		},
+	}
+}
+
+func TestReconcileMachinePoolMachines(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	instanceURL := func(name string) string {
+		return "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/" + name
+	}
+	managedInstances := []*compute.ManagedInstance{
+		{Name: "pool-running", Instance: instanceURL("pool-running"), InstanceStatus: "RUNNING", CurrentAction: "NONE"},
+		{Name: "pool-creating", Instance: instanceURL("pool-creating"), CurrentAction: "CREATING"},
+		{Name: "pool-deleting", Instance: instanceURL("pool-deleting"), InstanceStatus: "STOPPING", CurrentAction: "DELETING"},
+		{Name: "pool-abandoning", Instance: instanceURL("pool-abandoning"), InstanceStatus: "RUNNING", CurrentAction: "ABANDONING"},
+	}
+
+	r := &GCPMachinePoolReconciler{
+		Client: newTestMachinePoolClient(g,
+			newTestGCPMachinePoolMachine("pool-abandoning"),
+			newTestGCPMachinePoolMachine("pool-removed"),
+		),
+	}
+	machinePoolScope := &scope.MachinePoolScope{
+		ClusterGetter: &scope.ClusterScope{
+			Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"}},
+		},
+		MachinePool:    &clusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"}},
+		GCPMachinePool: &expinfrav1.GCPMachinePool{ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"}},
+	}
+	igm := &compute.InstanceGroupManager{InstanceTemplate: "global/instanceTemplates/pool-0123456789abcdef"}
+
+	g.Expect(r.reconcileMachinePoolMachines(ctx, machinePoolScope, igm, managedInstances, nil)).To(Succeed())
+
+	machines := &expinfrav1.GCPMachinePoolMachineList{}
+	g.Expect(r.Client.List(ctx, machines)).To(Succeed())
+	names := map[string]*expinfrav1.GCPMachinePoolMachine{}
+	for i := range machines.Items {
+		names[machines.Items[i].Name] = &machines.Items[i]
+	}
+
+	// Instances leaving the MIG don't get a new GCPMachinePoolMachine, existing ones are kept up to date.
+	g.Expect(names).To(HaveKey("pool-running"))
+	g.Expect(names).To(HaveKey("pool-creating"))
+	g.Expect(names).NotTo(HaveKey("pool-deleting"))
+	g.Expect(names).To(HaveKey("pool-abandoning"))
+	g.Expect(names["pool-abandoning"].Status.CurrentAction).To(Equal("ABANDONING"))
+	g.Expect(names["pool-running"].Spec.ProviderID).To(Equal("gce://my-project/us-central1-a/pool-running"))
+
+	// The GCPMachinePoolMachine of an instance removed from the MIG is deleted, it waits for its finalizer.
+	g.Expect(names).To(HaveKey("pool-removed"))
+	g.Expect(names["pool-removed"].DeletionTimestamp.IsZero()).To(BeFalse())
+}
+
+func TestGCPMachinePoolMachineReconcileDelete(t *testing.T) {
+	tests := []struct {
+		name           string
+		gcpMachinePool *expinfrav1.GCPMachinePool
+	}{
+		{
+			name: "GCPMachinePool is gone",
+		},
+		{
+			name: "Cluster is gone",
+			gcpMachinePool: &expinfrav1.GCPMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pool",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{
+						{APIVersion: clusterv1.GroupVersion.String(), Kind: "MachinePool", Name: "pool"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			gcpMachinePoolMachine := newTestGCPMachinePoolMachine("pool-abcd")
+			gcpMachinePoolMachine.DeletionTimestamp = ptr.To(metav1.Now())
+			objects := []client.Object{gcpMachinePoolMachine}
+			if tt.gcpMachinePool != nil {
+				objects = append(objects, tt.gcpMachinePool, &clusterv1.MachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pool",
+						Namespace: "default",
+						Labels:    map[string]string{clusterv1.ClusterNameLabel: "my-cluster"},
+					},
+				})
+			}
+			r := &GCPMachinePoolMachineReconciler{
+				Client:   newTestMachinePoolClient(g, objects...),
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(gcpMachinePoolMachine)})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			// Without its finalizer, the deleted GCPMachinePoolMachine is gone.
+			err = r.Client.Get(ctx, client.ObjectKeyFromObject(gcpMachinePoolMachine), &expinfrav1.GCPMachinePoolMachine{})
+			g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	}
+}
+
+func TestInstancesToDelete(t *testing.T) {
+	g := NewWithT(t)
+
+	managedInstances := []*compute.ManagedInstance{
+		{Name: "pool-abcd", Instance: "zones/us-central1-a/instances/pool-abcd", CurrentAction: "RECREATING"},
+		{Name: "pool-efgh", Instance: "zones/us-central1-a/instances/pool-efgh", CurrentAction: "NONE"},
+		{Name: "pool-ijkl", Instance: "zones/us-central1-a/instances/pool-ijkl", CurrentAction: "DELETING"},
+		{Name: "pool-mnop", Instance: "zones/us-central1-a/instances/pool-mnop", CurrentAction: "ABANDONING"},
+	}
+
+	g.Expect(instancesToDelete(managedInstances, "pool-abcd")).To(Equal([]string{"zones/us-central1-a/instances/pool-abcd"}))
+	// Instances already leaving the MIG or no longer part of it are not deleted again.
+	g.Expect(instancesToDelete(managedInstances, "pool-ijkl")).To(BeEmpty())
+	g.Expect(instancesToDelete(managedInstances, "pool-mnop")).To(BeEmpty())
+	g.Expect(instancesToDelete(managedInstances, "pool-qrst")).To(BeEmpty())
+}
+
+func TestDeletePreviousInstanceGroupManagerWaitsForReadiness(t *testing.T) {
+	instanceURL := func(name string) string {
+		return "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/" + name
+	}
+	running := []*compute.ManagedInstance{
+		{Name: "pool-abcd", Instance: instanceURL("pool-abcd"), InstanceStatus: "RUNNING", CurrentAction: "NONE"},
+		{Name: "pool-efgh", Instance: instanceURL("pool-efgh"), InstanceStatus: "RUNNING", CurrentAction: "NONE"},
+	}
+
+	tests := []struct {
+		name             string
+		igm              *compute.InstanceGroupManager
+		managedInstances []*compute.ManagedInstance
+		readyReplicas    int32
+	}{
+		{
+			name:             "instanceGroupManager is not stable",
+			igm:              &compute.InstanceGroupManager{TargetSize: 2, Status: &compute.InstanceGroupManagerStatus{IsStable: false}},
+			managedInstances: running,
+			readyReplicas:    2,
+		},
+		{
+			name: "instances are still being created",
+			igm:  &compute.InstanceGroupManager{TargetSize: 2, Status: &compute.InstanceGroupManagerStatus{IsStable: true}},
+			managedInstances: []*compute.ManagedInstance{
+				running[0],
+				{Name: "pool-efgh", Instance: instanceURL("pool-efgh"), InstanceStatus: "STAGING", CurrentAction: "CREATING"},
+			},
+			readyReplicas: 1,
+		},
+		{
+			name:             "nodes are not ready",
+			igm:              &compute.InstanceGroupManager{TargetSize: 2, Status: &compute.InstanceGroupManagerStatus{IsStable: true}},
+			managedInstances: running,
+			readyReplicas:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			previous := "zones/us-central1-a/instanceGroupManagers/my-cluster-pool"
+			machinePoolScope := &scope.MachinePoolScope{
+				ClusterGetter: &scope.ClusterScope{
+					Cluster:    &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"}},
+					GCPCluster: &infrav1.GCPCluster{Spec: infrav1.GCPClusterSpec{Region: "us-central1"}},
+				},
+				MachinePool: &clusterv1.MachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+					Spec:       clusterv1.MachinePoolSpec{FailureDomains: []string{"us-central1-a", "us-central1-b"}},
+					Status:     clusterv1.MachinePoolStatus{ReadyReplicas: ptr.To(tt.readyReplicas)},
+				},
+				GCPMachinePool: &expinfrav1.GCPMachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+					Status:     expinfrav1.GCPMachinePoolStatus{InstanceGroupManager: previous},
+				},
+			}
+			r := &GCPMachinePoolReconciler{Recorder: record.NewFakeRecorder(10)}
+
+			// The machine pool moved to a regional instanceGroupManager, the zonal one keeps serving until it is ready.
+			deleted, err := r.deletePreviousInstanceGroupManager(context.Background(), machinePoolScope, tt.igm, tt.managedInstances)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(deleted).To(BeFalse())
+			g.Expect(machinePoolScope.GCPMachinePool.Status.InstanceGroupManager).To(Equal(previous))
+		})
+	}
+}
+
+func TestInstanceGroupManagerReady(t *testing.T) {
+	g := NewWithT(t)
+
+	machinePool := &clusterv1.MachinePool{Status: clusterv1.MachinePoolStatus{ReadyReplicas: ptr.To[int32](1)}}
+	igm := &compute.InstanceGroupManager{TargetSize: 1, Status: &compute.InstanceGroupManagerStatus{IsStable: true}}
+	managedInstances := []*compute.ManagedInstance{{Name: "pool-abcd", InstanceStatus: "RUNNING", CurrentAction: "NONE"}}
+
+	g.Expect(instanceGroupManagerReady(machinePool, igm, managedInstances)).To(BeTrue())
+	g.Expect(instanceGroupManagerReady(machinePool, &compute.InstanceGroupManager{TargetSize: 1}, managedInstances)).To(BeFalse())
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/instancegroupmanagers"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+// GCPMachinePoolMachineReconciler reconciles a GCPMachinePoolMachine object.
+// GCPMachinePoolMachines are created and kept up to date by the GCPMachinePoolReconciler,
+// this reconciler deletes the instance from the managed instance group when a GCPMachinePoolMachine is deleted.
+type GCPMachinePoolMachineReconciler struct {
+	Client           client.Client
+	Recorder         record.EventRecorder
+	WatchFilterValue string
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinepoolmachines,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinepoolmachines/status,verbs=get;update;patch
+
+// Reconcile is the reconciliation loop for GCPMachinePoolMachine.
+func (r *GCPMachinePoolMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	gcpMachinePoolMachine := &expinfrav1.GCPMachinePoolMachine{}
+	if err := r.Client.Get(ctx, req.NamespacedName, gcpMachinePoolMachine); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("getting GCPMachinePoolMachine %v: %w", req.NamespacedName, err)
+	}
+
+	// Only deletion is handled here, everything else is reconciled from the GCPMachinePool.
+	if gcpMachinePoolMachine.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(gcpMachinePoolMachine, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("init GCPMachinePoolMachine patch helper: %w", err)
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, gcpMachinePoolMachine); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	return ctrl.Result{}, r.reconcileDelete(ctx, gcpMachinePoolMachine)
+}
+
+func (r *GCPMachinePoolMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&expinfrav1.GCPMachinePoolMachine{}).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(mgr.GetScheme(), log.FromContext(ctx), r.WatchFilterValue)).
+		Complete(r)
+}
+
+func (r *GCPMachinePoolMachineReconciler) reconcileDelete(ctx context.Context, gcpMachinePoolMachine *expinfrav1.GCPMachinePoolMachine) error {
+	log := log.FromContext(ctx)
+
+	log.Info("Handling deleted GCPMachinePoolMachine")
+
+	machinePoolScope, err := r.machinePoolScope(ctx, gcpMachinePoolMachine)
+	if err != nil {
+		return err
+	}
+
+	// The instance is deleted along with the managed instance group if the GCPMachinePool is gone or being deleted.
+	if machinePoolScope == nil || !machinePoolScope.GCPMachinePool.DeletionTimestamp.IsZero() {
+		log.Info("GCPMachinePool is gone or being deleted, skipping instance deletion")
+		controllerutil.RemoveFinalizer(gcpMachinePoolMachine, expinfrav1.MachinePoolMachineFinalizer)
+		return nil
+	}
+
+	igmService := instancegroupmanagers.New(machinePoolScope)
+	managedInstances, err := igmService.ListManagedInstances(ctx)
+	if err != nil {
+		log.Error(err, "Error listing instances in instanceGroupManager")
+		return err
+	}
+
+	if instanceURLs := instancesToDelete(managedInstances, gcpMachinePoolMachine.Name); len(instanceURLs) > 0 {
+		if err := igmService.DeleteInstances(ctx, instanceURLs...); err != nil {
+			log.Error(err, "Error deleting instance from instanceGroupManager")
+			r.Recorder.Eventf(gcpMachinePoolMachine, corev1.EventTypeWarning, "FailedDelete", "Failed to delete instance: %v", err)
+			return err
+		}
+		r.Recorder.Eventf(gcpMachinePoolMachine, corev1.EventTypeNormal, "SuccessfulDelete", "Deleted instance %q", gcpMachinePoolMachine.Name)
+	}
+
+	controllerutil.RemoveFinalizer(gcpMachinePoolMachine, expinfrav1.MachinePoolMachineFinalizer)
+
+	return nil
+}
+
+// instancesToDelete returns the URL of the managed instance with the given name. The instance is only deleted if it is
+// still part of the managed instance group and not already leaving it, so that the target size of the managed
+// instance group is not decreased for an instance that is already gone.
+func instancesToDelete(managedInstances []*compute.ManagedInstance, name string) []string {
+	var instanceURLs []string
+	for _, managedInstance := range managedInstances {
+		if managedInstance.Name == name && !leavingInstanceGroup(managedInstance) {
+			instanceURLs = append(instanceURLs, managedInstance.Instance)
+		}
+	}
+
+	return instanceURLs
+}
+
+// machinePoolScope returns the scope of the GCPMachinePool owning the GCPMachinePoolMachine.
+// It returns nil if the GCPMachinePool, its MachinePool or Cluster no longer exist.
+func (r *GCPMachinePoolMachineReconciler) machinePoolScope(ctx context.Context, gcpMachinePoolMachine *expinfrav1.GCPMachinePoolMachine) (*scope.MachinePoolScope, error) {
+	log := log.FromContext(ctx)
+
+	var gcpMachinePoolName string
+	for _, ref := range gcpMachinePoolMachine.OwnerReferences {
+		if ref.Kind == "GCPMachinePool" && ref.APIVersion == expinfrav1.GroupVersion.String() {
+			gcpMachinePoolName = ref.Name
+		}
+	}
+	if gcpMachinePoolName == "" {
+		log.Info("GCPMachinePoolMachine is not owned by a GCPMachinePool")
+		return nil, nil
+	}
+
+	gcpMachinePool := &expinfrav1.GCPMachinePool{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: gcpMachinePoolMachine.Namespace, Name: gcpMachinePoolName}, gcpMachinePool); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting GCPMachinePool %s: %w", gcpMachinePoolName, err)
+	}
+
+	machinePool, err := util.GetOwnerMachinePool(ctx, r.Client, gcpMachinePool.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+	if machinePool == nil {
+		return nil, nil
+	}
+	log = log.WithValues("machinePool", klog.KObj(machinePool))
+
+	clusterObj, err := util.GetClusterFromMetadata(ctx, r.Client, machinePool.ObjectMeta)
+	if err != nil {
+		log.Info("MachinePool is missing cluster label or cluster does not exist")
+		return nil, nil
+	}
+
+	clusterScope, err := createClusterScope(ctx, r.Client, clusterObj, gcpMachinePool)
+	if err != nil {
+		return nil, fmt.Errorf("getting infra provider cluster object: %w", err)
+	}
+	if clusterScope == nil {
+		return nil, nil
+	}
+
+	return scope.NewMachinePoolScope(scope.MachinePoolScopeParams{
+		ClusterGetter:  clusterScope,
+		Client:         r.Client,
+		MachinePool:    machinePool,
+		GCPMachinePool: gcpMachinePool,
+	})
+}
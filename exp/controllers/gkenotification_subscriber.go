@@ -0,0 +1,316 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
+	"cloud.google.com/go/resourcemanager/apiv3/resourcemanagerpb"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	"google.golang.org/api/pubsub/v1"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
+	"sigs.k8s.io/cluster-api/util/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// PubsubEmulatorHostEnv is the environment variable pointing the GKE notification subscriber to a Pub/Sub
+	// emulator, for example localhost:8085.
+	PubsubEmulatorHostEnv = "PUBSUB_EMULATOR_HOST"
+
+	// DefaultGKENotificationPullInterval is how long the GKE notification subscriber waits between two pulls without
+	// messages.
+	DefaultGKENotificationPullInterval = 10 * time.Second
+
+	// maxPulledGKENotifications is the maximum number of messages pulled at once.
+	maxPulledGKENotifications = 100
+
+	// gkeNotificationResourceMaster is the resource type of the notifications about the control plane.
+	gkeNotificationResourceMaster = "MASTER"
+)
+
+// gkeNotification is a notification published by GKE, see
+// https://cloud.google.com/kubernetes-engine/docs/concepts/cluster-notifications.
+type gkeNotification struct {
+	// projectNumber is the number of the project of the cluster, GKE doesn't publish its ID.
+	projectNumber   string
+	clusterName     string
+	clusterLocation string
+	eventType       infrav1exp.NotificationType
+	payload         gkeNotificationPayload
+}
+
+// gkeNotificationPayload holds the fields of the notification payloads the subscriber reports.
+type gkeNotificationPayload struct {
+	ResourceType           string `json:"resourceType"`
+	ResourceTypeAffected   string `json:"resourceTypeAffected"`
+	Resource               string `json:"resource"`
+	Version                string `json:"version"`
+	CurrentVersion         string `json:"currentVersion"`
+	TargetVersion          string `json:"targetVersion"`
+	BulletinID             string `json:"bulletinId"`
+	Severity               string `json:"severity"`
+	BulletinURI            string `json:"bulletinUri"`
+	BriefDescription       string `json:"briefDescription"`
+	SuggestedUpgradeTarget string `json:"suggestedUpgradeTarget"`
+	Description            string `json:"description"`
+}
+
+// GKENotificationSubscriber pulls the notifications of the GKE clusters from a Pub/Sub subscription of the topic of
+// their notifications, and reports them as conditions and events of the matching GCPManagedControlPlanes.
+type GKENotificationSubscriber struct {
+	Client client.Client
+	// Subscription is the Pub/Sub subscription, in the format projects/{project}/subscriptions/{subscription}.
+	Subscription string
+	// PullInterval is how long the subscriber waits between two pulls without messages.
+	PullInterval time.Duration
+
+	pubsubService *pubsub.Service
+	// projectsClient resolves the numbers of the projects, it is created on first use.
+	projectsClient *resourcemanager.ProjectsClient
+	// projectNumbers caches the numbers of the projects by ID.
+	projectNumbers map[string]string
+}
+
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpmanagedcontrolplanes,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpmanagedcontrolplanes/status,verbs=get;update;patch
+
+// SetupWithManager adds the subscriber to the Manager, which runs it on the leader. The subscriber uses the default
+// credentials, or the Pub/Sub emulator when the PUBSUB_EMULATOR_HOST environment variable is set. The numbers of the
+// projects of the clusters are always resolved with the default credentials.
+func (r *GKENotificationSubscriber) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	pubsubService, err := newPubsubService(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error creating Pub/Sub client")
+	}
+	r.pubsubService = pubsubService
+	if r.PullInterval == 0 {
+		r.PullInterval = DefaultGKENotificationPullInterval
+	}
+
+	return mgr.Add(r)
+}
+
+func newPubsubService(ctx context.Context) (*pubsub.Service, error) {
+	if emulatorHost := os.Getenv(PubsubEmulatorHostEnv); emulatorHost != "" {
+		return pubsub.NewService(ctx, option.WithEndpoint("http://"+emulatorHost+"/"), option.WithoutAuthentication())
+	}
+	return pubsub.NewService(ctx)
+}
+
+// Start pulls the notifications until the context is done.
+func (r *GKENotificationSubscriber) Start(ctx context.Context) error {
+	log := log.FromContext(ctx).WithValues("subscriber", "GKENotification", "subscription", r.Subscription)
+	log.Info("Starting GKE notification subscriber")
+	defer func() {
+		if r.projectsClient != nil {
+			r.projectsClient.Close()
+		}
+	}()
+
+	for {
+		handled, err := r.pull(ctx, &log)
+		if err != nil {
+			log.Error(err, "Failed to pull GKE notifications")
+		}
+		// Keep pulling while messages are handled, there may be more.
+		if handled > 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.PullInterval):
+		}
+	}
+}
+
+// pull handles the pending notifications and acknowledges the handled ones, the others are delivered again later. It
+// returns the number of handled notifications.
+func (r *GKENotificationSubscriber) pull(ctx context.Context, log *logr.Logger) (int, error) {
+	response, err := r.pubsubService.Projects.Subscriptions.Pull(r.Subscription, &pubsub.PullRequest{
+		MaxMessages: maxPulledGKENotifications,
+	}).Context(ctx).Do()
+	if err != nil {
+		return 0, fmt.Errorf("pulling messages: %w", err)
+	}
+
+	var ackIDs []string
+	for _, received := range response.ReceivedMessages {
+		if err := r.handleNotification(ctx, received.Message, log); err != nil {
+			log.Error(err, "Failed to handle GKE notification", "messageId", received.Message.MessageId)
+			continue
+		}
+		ackIDs = append(ackIDs, received.AckId)
+	}
+	if len(ackIDs) == 0 {
+		return 0, nil
+	}
+	_, err = r.pubsubService.Projects.Subscriptions.Acknowledge(r.Subscription, &pubsub.AcknowledgeRequest{
+		AckIds: ackIDs,
+	}).Context(ctx).Do()
+	if err != nil {
+		return 0, fmt.Errorf("acknowledging messages: %w", err)
+	}
+
+	return len(ackIDs), nil
+}
+
+// handleNotification reports the notification on the GCPManagedControlPlanes of its cluster, matched by project,
+// location and name. Messages that aren't GKE notifications are ignored.
+func (r *GKENotificationSubscriber) handleNotification(ctx context.Context, message *pubsub.PubsubMessage, log *logr.Logger) error {
+	notification, err := parseGKENotification(message)
+	if err != nil {
+		log.Info("Ignoring message", "messageId", message.MessageId, "reason", err.Error())
+		return nil
+	}
+
+	controlPlanes := &infrav1exp.GCPManagedControlPlaneList{}
+	if err := r.Client.List(ctx, controlPlanes); err != nil {
+		return fmt.Errorf("listing GCPManagedControlPlanes: %w", err)
+	}
+	for i := range controlPlanes.Items {
+		controlPlane := &controlPlanes.Items[i]
+		if controlPlane.Spec.ClusterName != notification.clusterName || controlPlane.Spec.Location != notification.clusterLocation {
+			continue
+		}
+		projectNumber, err := r.projectNumber(ctx, controlPlane.Spec.Project)
+		if err != nil {
+			return err
+		}
+		if projectNumber != notification.projectNumber {
+			continue
+		}
+		log.V(2).Info("Reporting GKE notification", "type", notification.eventType, "namespace", controlPlane.Namespace, "name", controlPlane.Name)
+
+		patchHelper, err := v1beta1patch.NewHelper(controlPlane, r.Client)
+		if err != nil {
+			return err
+		}
+		reportGKENotification(controlPlane, notification)
+		if err := patchHelper.Patch(ctx, controlPlane, v1beta1patch.WithOwnedConditions{Conditions: []clusterv1beta1.ConditionType{
+			infrav1exp.GKEControlPlaneUpgradeAvailableCondition,
+			infrav1exp.GKEControlPlaneSecurityBulletinCondition,
+		}}); err != nil {
+			return fmt.Errorf("patching GCPManagedControlPlane %s/%s: %w", controlPlane.Namespace, controlPlane.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// projectNumber returns the number of the project, which identifies the project of the GKE notifications. The numbers
+// are cached as they never change.
+func (r *GKENotificationSubscriber) projectNumber(ctx context.Context, project string) (string, error) {
+	if number, ok := r.projectNumbers[project]; ok {
+		return number, nil
+	}
+
+	if r.projectsClient == nil {
+		projectsClient, err := resourcemanager.NewProjectsClient(ctx)
+		if err != nil {
+			return "", fmt.Errorf("creating Resource Manager client: %w", err)
+		}
+		r.projectsClient = projectsClient
+	}
+	p, err := r.projectsClient.GetProject(ctx, &resourcemanagerpb.GetProjectRequest{
+		Name: "projects/" + project,
+	})
+	if err != nil {
+		return "", fmt.Errorf("getting project %s: %w", project, err)
+	}
+
+	if r.projectNumbers == nil {
+		r.projectNumbers = map[string]string{}
+	}
+	// The name of a project is projects/{project number}.
+	r.projectNumbers[project] = strings.TrimPrefix(p.GetName(), "projects/")
+
+	return r.projectNumbers[project], nil
+}
+
+func parseGKENotification(message *pubsub.PubsubMessage) (*gkeNotification, error) {
+	typeURL := message.Attributes["type_url"]
+	if typeURL == "" || message.Attributes["cluster_name"] == "" {
+		return nil, errors.New("not a GKE notification")
+	}
+	notification := &gkeNotification{
+		// The project_id attribute holds the project number.
+		projectNumber:   message.Attributes["project_id"],
+		clusterName:     message.Attributes["cluster_name"],
+		clusterLocation: message.Attributes["cluster_location"],
+		// For example type.googleapis.com/google.container.v1beta1.UpgradeAvailableEvent.
+		eventType: infrav1exp.NotificationType(typeURL[strings.LastIndex(typeURL, ".")+1:]),
+	}
+	if err := json.Unmarshal([]byte(message.Attributes["payload"]), &notification.payload); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	return notification, nil
+}
+
+// reportGKENotification records the notification as an event of the control plane. Available upgrades and security
+// bulletins are also reported as conditions, until the control plane is upgraded.
+func reportGKENotification(controlPlane *infrav1exp.GCPManagedControlPlane, notification *gkeNotification) {
+	payload := notification.payload
+	switch notification.eventType {
+	case infrav1exp.UpgradeAvailableNotification:
+		record.Eventf(controlPlane, "GKEUpgradeAvailable", "Version %s is available for %s", payload.Version, notificationResource(payload.ResourceType, payload.Resource))
+		if payload.ResourceType == gkeNotificationResourceMaster {
+			v1beta1conditions.MarkTrueWithNegativePolarity(controlPlane, infrav1exp.GKEControlPlaneUpgradeAvailableCondition, infrav1exp.GKEControlPlaneUpgradeAvailableReason, clusterv1beta1.ConditionSeverityInfo, "version %s is available", payload.Version)
+		}
+	case infrav1exp.UpgradeNotification:
+		record.Eventf(controlPlane, "GKEUpgradeStarted", "Upgrade of %s from %s to %s started", notificationResource(payload.ResourceType, payload.Resource), payload.CurrentVersion, payload.TargetVersion)
+		if payload.ResourceType != gkeNotificationResourceMaster {
+			return
+		}
+		for _, conditionType := range []clusterv1beta1.ConditionType{infrav1exp.GKEControlPlaneUpgradeAvailableCondition, infrav1exp.GKEControlPlaneSecurityBulletinCondition} {
+			if v1beta1conditions.Has(controlPlane, conditionType) {
+				v1beta1conditions.MarkFalseWithNegativePolarity(controlPlane, conditionType)
+			}
+		}
+	case infrav1exp.SecurityBulletinNotification:
+		record.Warnf(controlPlane, "GKESecurityBulletin", "Security bulletin %s (%s severity): %s See %s", payload.BulletinID, payload.Severity, payload.BriefDescription, payload.BulletinURI)
+		severity := clusterv1beta1.ConditionSeverityInfo
+		if payload.Severity == "HIGH" || payload.Severity == "CRITICAL" {
+			severity = clusterv1beta1.ConditionSeverityWarning
+		}
+		v1beta1conditions.MarkTrueWithNegativePolarity(controlPlane, infrav1exp.GKEControlPlaneSecurityBulletinCondition, infrav1exp.GKEControlPlaneSecurityBulletinReason, severity, "security bulletin %s (%s severity), suggested upgrade target %s, see %s", payload.BulletinID, payload.Severity, payload.SuggestedUpgradeTarget, payload.BulletinURI)
+	case infrav1exp.UpgradeInfoNotification:
+		record.Eventf(controlPlane, "GKEUpgradeInfo", "%s", payload.Description)
+	}
+}
+
+func notificationResource(resourceType, resource string) string {
+	if resourceType == gkeNotificationResourceMaster {
+		return "the control plane"
+	}
+	return "node pool " + resource[strings.LastIndex(resource, "/")+1:]
+}
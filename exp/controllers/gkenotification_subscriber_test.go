@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/pubsub/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+const (
+	testUpgradeAvailablePayload = `{"resourceType":"MASTER","resource":"projects/my-project/locations/us-central1/clusters/my-cluster","version":"1.32.1-gke.1200000","releaseChannel":{"channel":"REGULAR"}}`
+	testSecurityBulletinPayload = `{"resourceTypeAffected":"RESOURCE_TYPE_CONTROLPLANE","bulletinId":"GCP-2026-001","severity":"HIGH","bulletinUri":"https://cloud.google.com/kubernetes-engine/security-bulletins#gcp-2026-001","briefDescription":"A vulnerability was discovered.","suggestedUpgradeTarget":"1.32.1-gke.1200000"}`
+	testUpgradePayload          = `{"resourceType":"MASTER","resource":"projects/my-project/locations/us-central1/clusters/my-cluster","currentVersion":"1.31.5-gke.1023000","targetVersion":"1.32.1-gke.1200000"}`
+)
+
+func testGKENotificationMessage(eventType infrav1exp.NotificationType, payload string) *pubsub.PubsubMessage {
+	return &pubsub.PubsubMessage{
+		MessageId: "1",
+		Attributes: map[string]string{
+			"cluster_name":     "my-cluster",
+			"cluster_location": "us-central1",
+			"project_id":       "123456789",
+			"type_url":         "type.googleapis.com/google.container.v1beta1." + string(eventType),
+			"payload":          payload,
+		},
+	}
+}
+
+// testGKENotificationProjectNumbers returns the project numbers of the test control planes, which are otherwise
+// resolved with Resource Manager.
+func testGKENotificationProjectNumbers() map[string]string {
+	return map[string]string{
+		"my-project":    "123456789",
+		"other-project": "987654321",
+	}
+}
+
+func newTestGKENotificationControlPlane(name, clusterName string) *infrav1exp.GCPManagedControlPlane {
+	return &infrav1exp.GCPManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			GCPManagedControlPlaneClassSpec: infrav1exp.GCPManagedControlPlaneClassSpec{
+				Project:  "my-project",
+				Location: "us-central1",
+			},
+			ClusterName: clusterName,
+		},
+	}
+}
+
+func TestParseGKENotification(t *testing.T) {
+	g := NewWithT(t)
+
+	notification, err := parseGKENotification(testGKENotificationMessage(infrav1exp.UpgradeAvailableNotification, testUpgradeAvailablePayload))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(notification.projectNumber).To(Equal("123456789"))
+	g.Expect(notification.clusterName).To(Equal("my-cluster"))
+	g.Expect(notification.clusterLocation).To(Equal("us-central1"))
+	g.Expect(notification.eventType).To(Equal(infrav1exp.UpgradeAvailableNotification))
+	g.Expect(notification.payload.ResourceType).To(Equal("MASTER"))
+	g.Expect(notification.payload.Version).To(Equal("1.32.1-gke.1200000"))
+
+	_, err = parseGKENotification(&pubsub.PubsubMessage{Data: "aGVsbG8="})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = parseGKENotification(testGKENotificationMessage(infrav1exp.UpgradeNotification, "{"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestReportGKENotification(t *testing.T) {
+	g := NewWithT(t)
+	controlPlane := newTestGKENotificationControlPlane("my-control-plane", "my-cluster")
+
+	report := func(eventType infrav1exp.NotificationType, payload string) {
+		notification, err := parseGKENotification(testGKENotificationMessage(eventType, payload))
+		g.Expect(err).NotTo(HaveOccurred())
+		reportGKENotification(controlPlane, notification)
+	}
+
+	report(infrav1exp.UpgradeAvailableNotification, testUpgradeAvailablePayload)
+	condition := v1beta1conditions.Get(controlPlane, infrav1exp.GKEControlPlaneUpgradeAvailableCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	g.Expect(condition.Severity).To(Equal(clusterv1beta1.ConditionSeverityInfo))
+	g.Expect(condition.Message).To(ContainSubstring("1.32.1-gke.1200000"))
+
+	// Node pool upgrades aren't reported as conditions.
+	report(infrav1exp.UpgradeAvailableNotification, `{"resourceType":"NODE_POOL","resource":"projects/my-project/locations/us-central1/clusters/my-cluster/nodePools/my-pool","version":"1.32.1-gke.1200000"}`)
+	g.Expect(v1beta1conditions.Get(controlPlane, infrav1exp.GKEControlPlaneUpgradeAvailableCondition).Message).To(Equal(condition.Message))
+
+	report(infrav1exp.SecurityBulletinNotification, testSecurityBulletinPayload)
+	condition = v1beta1conditions.Get(controlPlane, infrav1exp.GKEControlPlaneSecurityBulletinCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	g.Expect(condition.Severity).To(Equal(clusterv1beta1.ConditionSeverityWarning))
+	g.Expect(condition.Message).To(ContainSubstring("GCP-2026-001"))
+
+	report(infrav1exp.UpgradeNotification, testUpgradePayload)
+	g.Expect(v1beta1conditions.IsFalse(controlPlane, infrav1exp.GKEControlPlaneUpgradeAvailableCondition)).To(BeTrue())
+	g.Expect(v1beta1conditions.IsFalse(controlPlane, infrav1exp.GKEControlPlaneSecurityBulletinCondition)).To(BeTrue())
+
+	// Upgrade info is only reported as an event.
+	report(infrav1exp.UpgradeInfoNotification, `{"resourceType":"MASTER","description":"Upgrade of the control plane completed."}`)
+	g.Expect(controlPlane.GetConditions()).To(HaveLen(2))
+}
+
+func TestHandleGKENotification(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	log := logr.Discard()
+
+	matching := newTestGKENotificationControlPlane("matching", "my-cluster")
+	other := newTestGKENotificationControlPlane("other", "other-cluster")
+	otherProject := newTestGKENotificationControlPlane("other-project", "my-cluster")
+	otherProject.Spec.Project = "other-project"
+	r := &GKENotificationSubscriber{
+		Client:         newTestGKENotificationClient(g, matching, other, otherProject),
+		projectNumbers: testGKENotificationProjectNumbers(),
+	}
+
+	g.Expect(r.handleNotification(ctx, testGKENotificationMessage(infrav1exp.UpgradeAvailableNotification, testUpgradeAvailablePayload), &log)).To(Succeed())
+	// Messages that aren't GKE notifications are acknowledged and ignored.
+	g.Expect(r.handleNotification(ctx, &pubsub.PubsubMessage{MessageId: "2"}, &log)).To(Succeed())
+
+	g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(matching), matching)).To(Succeed())
+	g.Expect(v1beta1conditions.IsTrue(matching, infrav1exp.GKEControlPlaneUpgradeAvailableCondition)).To(BeTrue())
+	g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(other), other)).To(Succeed())
+	g.Expect(v1beta1conditions.Has(other, infrav1exp.GKEControlPlaneUpgradeAvailableCondition)).To(BeFalse())
+	// A cluster with the same name and location in another project isn't notified.
+	g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(otherProject), otherProject)).To(Succeed())
+	g.Expect(v1beta1conditions.Has(otherProject, infrav1exp.GKEControlPlaneUpgradeAvailableCondition)).To(BeFalse())
+}
+
+// TestGKENotificationSubscriberWithEmulator runs against the Pub/Sub emulator, started for example with
+// gcloud beta emulators pubsub start --host-port=localhost:8085 and PUBSUB_EMULATOR_HOST=localhost:8085.
+func TestGKENotificationSubscriberWithEmulator(t *testing.T) {
+	if os.Getenv(PubsubEmulatorHostEnv) == "" {
+		t.Skipf("%s is not set", PubsubEmulatorHostEnv)
+	}
+	g := NewWithT(t)
+	ctx := context.Background()
+	log := logr.Discard()
+
+	pubsubService, err := newPubsubService(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	suffix := time.Now().UnixNano()
+	topic := fmt.Sprintf("projects/test-project/topics/gke-notifications-%d", suffix)
+	subscription := fmt.Sprintf("projects/test-project/subscriptions/gke-notifications-%d", suffix)
+	_, err = pubsubService.Projects.Topics.Create(topic, &pubsub.Topic{}).Context(ctx).Do()
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = pubsubService.Projects.Subscriptions.Create(subscription, &pubsub.Subscription{Topic: topic}).Context(ctx).Do()
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = pubsubService.Projects.Topics.Publish(topic, &pubsub.PublishRequest{
+		Messages: []*pubsub.PubsubMessage{testGKENotificationMessage(infrav1exp.SecurityBulletinNotification, testSecurityBulletinPayload)},
+	}).Context(ctx).Do()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	controlPlane := newTestGKENotificationControlPlane("my-control-plane", "my-cluster")
+	r := &GKENotificationSubscriber{
+		Client:         newTestGKENotificationClient(g, controlPlane),
+		Subscription:   subscription,
+		pubsubService:  pubsubService,
+		projectNumbers: testGKENotificationProjectNumbers(),
+	}
+	g.Eventually(func() (int, error) {
+		return r.pull(ctx, &log)
+	}, 10*time.Second).Should(Equal(1))
+
+	g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(controlPlane), controlPlane)).To(Succeed())
+	g.Expect(v1beta1conditions.IsTrue(controlPlane, infrav1exp.GKEControlPlaneSecurityBulletinCondition)).To(BeTrue())
+
+	// The handled message was acknowledged.
+	g.Expect(r.pull(ctx, &log)).To(Equal(0))
+}
+
+func newTestGKENotificationClient(g *WithT, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	g.Expect(infrav1exp.AddToScheme(scheme)).To(Succeed())
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(objects...).
+		Build()
+}
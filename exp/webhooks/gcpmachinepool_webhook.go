@@ -19,9 +19,16 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/google/go-cmp/cmp"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -43,7 +50,7 @@ func (r *GCPMachinePool) SetupWebhookWithManager(mgr ctrl.Manager) error {
 // GCPMachinePool implements a validating webhook for GCPMachinePool.
 type GCPMachinePool struct{}
 
-//+kubebuilder:webhook:verbs=update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-gcpmachinepool,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinepools,versions=v1beta1,name=validation.gcpmachinepool.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-gcpmachinepool,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinepools,versions=v1beta1,name=validation.gcpmachinepool.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1
 
 var _ webhook.CustomValidator = &GCPMachinePool{}
 
@@ -56,13 +63,11 @@ func (*GCPMachinePool) ValidateCreate(_ context.Context, obj runtime.Object) (ad
 
 	gcpMachinePoolLog.Info("Validating GCPMachinePool create", "name", r.Name)
 
-	// Add custom validation logic upon creation if needed.
-
-	return nil, nil
+	return nil, validateGCPMachinePool(r)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (*GCPMachinePool) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+func (*GCPMachinePool) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	r, ok := newObj.(*expinfrav1.GCPMachinePool)
 	if !ok {
 		return nil, fmt.Errorf("expected a GCPMachinePool object but got %T", r)
@@ -70,9 +75,22 @@ func (*GCPMachinePool) ValidateUpdate(_ context.Context, _, newObj runtime.Objec
 
 	gcpMachinePoolLog.Info("Validating GCPMachinePool update", "name", r.Name)
 
-	// Add custom validation logic upon update if needed.
+	allErrs := validateGCPMachinePoolSpec(&r.Spec, field.NewPath("spec"))
+	old := oldObj.(*expinfrav1.GCPMachinePool)
 
-	return nil, nil
+	// The target size policy is set when the managed instance group is created.
+	if !cmp.Equal(r.Spec.TargetSizePolicy, old.Spec.TargetSizePolicy) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "targetSizePolicy"),
+				r.Spec.TargetSizePolicy, "field is immutable"),
+		)
+	}
+
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+
+	return nil, apierrors.NewInvalid(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -88,3 +106,190 @@ func (*GCPMachinePool) ValidateDelete(_ context.Context, obj runtime.Object) (ad
 
 	return nil, nil
 }
+
+func validateGCPMachinePool(r *expinfrav1.GCPMachinePool) error {
+	allErrs := validateGCPMachinePoolSpec(&r.Spec, field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(
+		r.GroupVersionKind().GroupKind(),
+		r.Name,
+		allErrs,
+	)
+}
+
+// validateGCPMachinePoolSpec validates a GCPMachinePoolSpec, it is shared with GCPMachinePoolTemplate.
+func validateGCPMachinePoolSpec(spec *expinfrav1.GCPMachinePoolSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.Strategy != nil {
+		allErrs = append(allErrs, validateMachinePoolStrategy(spec.Strategy, fldPath.Child("strategy"))...)
+	}
+
+	// Balanced and Any target shapes require proactive instance redistribution to be disabled.
+	if shape := spec.DistributionTargetShape; shape != nil && *shape != expinfrav1.EvenGCPMachinePoolDistributionTargetShape {
+		if spec.Strategy != nil && spec.Strategy.InstanceRedistributionType != nil &&
+			*spec.Strategy.InstanceRedistributionType == expinfrav1.ProactiveGCPMachinePoolInstanceRedistributionType {
+			allErrs = append(allErrs, field.Invalid(
+				fldPath.Child("strategy", "instanceRedistributionType"),
+				*spec.Strategy.InstanceRedistributionType,
+				fmt.Sprintf("must be None when distributionTargetShape is %s", *shape),
+			))
+		}
+	}
+
+	if spec.AutoHealing != nil {
+		allErrs = append(allErrs, validateMachinePoolHealthCheck(&spec.AutoHealing.HealthCheck, fldPath.Child("autoHealing", "healthCheck"))...)
+	}
+
+	if spec.Stateful != nil && spec.Strategy != nil {
+		allErrs = append(allErrs, validateStatefulMachinePoolStrategy(spec.Strategy, fldPath.Child("strategy"))...)
+	}
+
+	if spec.Autoscaling != nil {
+		allErrs = append(allErrs, validateMachinePoolAutoscaling(spec.Autoscaling, fldPath.Child("autoscaling"))...)
+
+		// GCP does not support autoscaling groups with a stateful configuration.
+		if spec.Stateful != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoscaling"), "is not supported when stateful is set"))
+		}
+	}
+
+	allErrs = append(allErrs, validateMachinePoolInstanceSelections(spec.InstanceSelections, fldPath.Child("instanceSelections"))...)
+
+	if spec.ProvisioningModelMix != nil && ptr.Deref(spec.ProvisioningModel, "") != infrav1.ProvisioningModelSpot {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("provisioningModelMix"), "requires provisioningModel to be Spot"))
+	}
+
+	return allErrs
+}
+
+func validateMachinePoolStrategy(strategy *expinfrav1.GCPMachinePoolStrategy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := validateFixedOrPercent(strategy.MaxSurge, fldPath.Child("maxSurge")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateFixedOrPercent(strategy.MaxUnavailable, fldPath.Child("maxUnavailable")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	// GCP requires maxSurge to be 0 when instance names are preserved, it defaults to 0 when omitted.
+	if strategy.ReplacementMethod != nil && *strategy.ReplacementMethod == expinfrav1.RecreateGCPMachinePoolReplacementMethod &&
+		strategy.MaxSurge != nil && (strategy.MaxSurge.Type != intstr.Int || strategy.MaxSurge.IntVal != 0) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSurge"), strategy.MaxSurge.String(), "must be 0 when replacementMethod is Recreate"))
+	}
+
+	return allErrs
+}
+
+func validateMachinePoolHealthCheck(healthCheck *expinfrav1.GCPMachinePoolHealthCheck, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if healthCheck.RequestPath != nil && healthCheck.Type != expinfrav1.HTTPGCPMachinePoolHealthCheckType && healthCheck.Type != expinfrav1.HTTPSGCPMachinePoolHealthCheckType {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("requestPath"), "is only supported for HTTP and HTTPS health checks"))
+	}
+	if healthCheck.RequestPath != nil && !strings.HasPrefix(*healthCheck.RequestPath, "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("requestPath"), *healthCheck.RequestPath, "must start with /"))
+	}
+
+	// GCP requires the timeout to not exceed the check interval, both have defaults.
+	checkInterval := ptr.Deref(healthCheck.CheckIntervalSec, 10)
+	if timeout := ptr.Deref(healthCheck.TimeoutSec, 5); timeout > checkInterval {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeoutSec"), timeout, fmt.Sprintf("must not exceed checkIntervalSec (%d)", checkInterval)))
+	}
+
+	return allErrs
+}
+
+// validateStatefulMachinePoolStrategy validates the strategy of a stateful machine pool.
+// Stateful instances are matched with their preserved state by name, so they must be recreated in place.
+func validateStatefulMachinePoolStrategy(strategy *expinfrav1.GCPMachinePoolStrategy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if strategy.ReplacementMethod != nil && *strategy.ReplacementMethod != expinfrav1.RecreateGCPMachinePoolReplacementMethod {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replacementMethod"), *strategy.ReplacementMethod, "must be Recreate when stateful is set"))
+	}
+	if strategy.MaxSurge != nil && (strategy.MaxSurge.Type != intstr.Int || strategy.MaxSurge.IntVal != 0) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSurge"), strategy.MaxSurge.String(), "must be 0 when stateful is set"))
+	}
+	if strategy.InstanceRedistributionType != nil && *strategy.InstanceRedistributionType == expinfrav1.ProactiveGCPMachinePoolInstanceRedistributionType {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("instanceRedistributionType"), *strategy.InstanceRedistributionType, "must be None when stateful is set"))
+	}
+
+	return allErrs
+}
+
+func validateMachinePoolAutoscaling(autoscaling *expinfrav1.GCPMachinePoolAutoscaling, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if autoscaling.MinReplicas > autoscaling.MaxReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), autoscaling.MinReplicas, fmt.Sprintf("must not exceed maxReplicas (%d)", autoscaling.MaxReplicas)))
+	}
+
+	for i, metric := range autoscaling.CustomMetrics {
+		metricPath := fldPath.Child("customMetrics").Index(i)
+		switch {
+		case metric.Target == nil && metric.SingleInstanceAssignment == nil:
+			allErrs = append(allErrs, field.Required(metricPath, "one of target or singleInstanceAssignment must be set"))
+		case metric.Target != nil && metric.SingleInstanceAssignment != nil:
+			allErrs = append(allErrs, field.Forbidden(metricPath, "only one of target or singleInstanceAssignment may be set"))
+		}
+		if metric.TargetType != nil && metric.Target == nil {
+			allErrs = append(allErrs, field.Forbidden(metricPath.Child("targetType"), "is only supported with target"))
+		}
+		if metric.Target != nil && metric.Target.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(metricPath.Child("target"), metric.Target.String(), "must be greater than zero"))
+		}
+		if metric.SingleInstanceAssignment != nil && metric.SingleInstanceAssignment.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(metricPath.Child("singleInstanceAssignment"), metric.SingleInstanceAssignment.String(), "must be greater than zero"))
+		}
+	}
+
+	if autoscaling.ScaleIn != nil {
+		if err := validateFixedOrPercent(&autoscaling.ScaleIn.MaxScaledInReplicas, fldPath.Child("scaleIn", "maxScaledInReplicas")); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
+	return allErrs
+}
+
+// validateMachinePoolInstanceSelections validates that each machine type is part of a single instance selection.
+func validateMachinePoolInstanceSelections(selections []expinfrav1.GCPMachinePoolInstanceSelection, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := map[string]bool{}
+	for i, selection := range selections {
+		for j, machineType := range selection.MachineTypes {
+			if seen[machineType] {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("machineTypes").Index(j), machineType))
+			}
+			seen[machineType] = true
+		}
+	}
+
+	return allErrs
+}
+
+func validateFixedOrPercent(value *intstr.IntOrString, fldPath *field.Path) *field.Error {
+	if value == nil {
+		return nil
+	}
+
+	if value.Type == intstr.Int {
+		if value.IntVal < 0 {
+			return field.Invalid(fldPath, value.IntVal, "must be greater or equal zero")
+		}
+		return nil
+	}
+
+	percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+	if err != nil || !strings.HasSuffix(value.StrVal, "%") || percent < 0 || percent > 100 {
+		return field.Invalid(fldPath, value.StrVal, "must be an integer or a percentage between 0% and 100%")
+	}
+
+	return nil
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestGCPMachinePoolValidatingWebhookCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        expinfrav1.GCPMachinePoolSpec
+		expectError bool
+	}{
+		{
+			name:        "no strategy",
+			spec:        expinfrav1.GCPMachinePoolSpec{},
+			expectError: false,
+		},
+		{
+			name: "strategy with fixed and percent values",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					Type:           expinfrav1.ProactiveGCPMachinePoolStrategyType,
+					MaxSurge:       ptr.To(intstr.FromInt32(2)),
+					MaxUnavailable: ptr.To(intstr.FromString("10%")),
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "strategy with negative maxSurge",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					MaxSurge: ptr.To(intstr.FromInt32(-1)),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "strategy with invalid maxUnavailable percentage",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					MaxUnavailable: ptr.To(intstr.FromString("ten")),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "recreate replacement method with maxSurge 0",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					MaxSurge:          ptr.To(intstr.FromInt32(0)),
+					ReplacementMethod: ptr.To(expinfrav1.RecreateGCPMachinePoolReplacementMethod),
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "recreate replacement method without maxSurge",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					ReplacementMethod: ptr.To(expinfrav1.RecreateGCPMachinePoolReplacementMethod),
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "recreate replacement method with maxSurge",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					MaxSurge:          ptr.To(intstr.FromInt32(1)),
+					ReplacementMethod: ptr.To(expinfrav1.RecreateGCPMachinePoolReplacementMethod),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "balanced distribution target shape",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				DistributionTargetShape: ptr.To(expinfrav1.BalancedGCPMachinePoolDistributionTargetShape),
+			},
+			expectError: false,
+		},
+		{
+			name: "any distribution target shape with proactive redistribution",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				DistributionTargetShape: ptr.To(expinfrav1.AnyGCPMachinePoolDistributionTargetShape),
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					InstanceRedistributionType: ptr.To(expinfrav1.ProactiveGCPMachinePoolInstanceRedistributionType),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "http autohealing health check with request path",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				AutoHealing: &expinfrav1.GCPMachinePoolAutoHealing{
+					HealthCheck: expinfrav1.GCPMachinePoolHealthCheck{
+						Type:        expinfrav1.HTTPGCPMachinePoolHealthCheckType,
+						Port:        ptr.To[int32](10256),
+						RequestPath: ptr.To("/healthz"),
+					},
+					InitialDelaySec: ptr.To[int32](600),
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "tcp autohealing health check with request path",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				AutoHealing: &expinfrav1.GCPMachinePoolAutoHealing{
+					HealthCheck: expinfrav1.GCPMachinePoolHealthCheck{
+						Type:        expinfrav1.TCPGCPMachinePoolHealthCheckType,
+						RequestPath: ptr.To("/healthz"),
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "autohealing health check timeout exceeding the default check interval",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				AutoHealing: &expinfrav1.GCPMachinePoolAutoHealing{
+					HealthCheck: expinfrav1.GCPMachinePoolHealthCheck{
+						TimeoutSec: ptr.To[int32](30),
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "stateful without strategy",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Stateful: &expinfrav1.GCPMachinePoolStatefulPolicy{
+					Disks:       []expinfrav1.GCPMachinePoolStatefulDisk{{DeviceName: "persistent-disk-1"}},
+					InternalIPs: []expinfrav1.GCPMachinePoolStatefulIP{{InterfaceName: "nic0"}},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "stateful with substitute replacement method",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Stateful: &expinfrav1.GCPMachinePoolStatefulPolicy{
+					Disks: []expinfrav1.GCPMachinePoolStatefulDisk{{DeviceName: "persistent-disk-1"}},
+				},
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					ReplacementMethod: ptr.To(expinfrav1.SubstituteGCPMachinePoolReplacementMethod),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "stateful with recreate replacement method without maxSurge",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Stateful: &expinfrav1.GCPMachinePoolStatefulPolicy{
+					Disks: []expinfrav1.GCPMachinePoolStatefulDisk{{DeviceName: "persistent-disk-1"}},
+				},
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					ReplacementMethod: ptr.To(expinfrav1.RecreateGCPMachinePoolReplacementMethod),
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "stateful with maxSurge",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Stateful: &expinfrav1.GCPMachinePoolStatefulPolicy{
+					Disks: []expinfrav1.GCPMachinePoolStatefulDisk{{DeviceName: "persistent-disk-1"}},
+				},
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					MaxSurge: ptr.To(intstr.FromInt32(1)),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "autoscaling",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Autoscaling: &expinfrav1.GCPMachinePoolAutoscaling{
+					MinReplicas:    1,
+					MaxReplicas:    10,
+					CPUUtilization: &expinfrav1.GCPMachinePoolCPUUtilization{TargetPercent: 70},
+					CustomMetrics: []expinfrav1.GCPMachinePoolCustomMetric{
+						{Metric: "custom.googleapis.com/queue_depth", Target: ptr.To(resource.MustParse("100"))},
+						{Metric: "custom.googleapis.com/jobs", SingleInstanceAssignment: ptr.To(resource.MustParse("2.5"))},
+					},
+					ScaleIn: &expinfrav1.GCPMachinePoolScaleInControl{
+						MaxScaledInReplicas: intstr.FromString("10%"),
+						TimeWindowSec:       600,
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "autoscaling with minReplicas above maxReplicas",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Autoscaling: &expinfrav1.GCPMachinePoolAutoscaling{
+					MinReplicas: 5,
+					MaxReplicas: 3,
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "autoscaling custom metric without target",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Autoscaling: &expinfrav1.GCPMachinePoolAutoscaling{
+					MaxReplicas:   3,
+					CustomMetrics: []expinfrav1.GCPMachinePoolCustomMetric{{Metric: "custom.googleapis.com/queue_depth"}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "autoscaling with invalid maxScaledInReplicas",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Autoscaling: &expinfrav1.GCPMachinePoolAutoscaling{
+					MaxReplicas: 3,
+					ScaleIn: &expinfrav1.GCPMachinePoolScaleInControl{
+						MaxScaledInReplicas: intstr.FromString("ten"),
+						TimeWindowSec:       600,
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "instance selections with a Spot provisioning model mix",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				ProvisioningModel: ptr.To(infrav1.ProvisioningModelSpot),
+				InstanceSelections: []expinfrav1.GCPMachinePoolInstanceSelection{
+					{Name: "preferred", MachineTypes: []string{"n2-standard-4", "n2d-standard-4"}},
+					{Name: "fallback", MachineTypes: []string{"e2-standard-4"}, Rank: 1},
+				},
+				ProvisioningModelMix: &expinfrav1.GCPMachinePoolProvisioningModelMix{
+					StandardCapacityBase:             ptr.To[int32](2),
+					StandardCapacityPercentAboveBase: ptr.To[int32](20),
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "machine type in several instance selections",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				InstanceSelections: []expinfrav1.GCPMachinePoolInstanceSelection{
+					{Name: "preferred", MachineTypes: []string{"n2-standard-4"}},
+					{Name: "fallback", MachineTypes: []string{"n2-standard-4"}, Rank: 1},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "provisioning model mix without Spot",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				ProvisioningModelMix: &expinfrav1.GCPMachinePoolProvisioningModelMix{
+					StandardCapacityBase: ptr.To[int32](2),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "autoscaling with stateful",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				Autoscaling: &expinfrav1.GCPMachinePoolAutoscaling{
+					MaxReplicas: 3,
+				},
+				Stateful: &expinfrav1.GCPMachinePoolStatefulPolicy{
+					Disks: []expinfrav1.GCPMachinePoolStatefulDisk{{DeviceName: "persistent-disk-1"}},
+				},
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mp := &expinfrav1.GCPMachinePool{
+				Spec: tc.spec,
+			}
+			warn, err := (&GCPMachinePool{}).ValidateCreate(t.Context(), mp)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			// Nothing emits warnings yet
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
+
+func TestGCPMachinePoolValidatingWebhookUpdate(t *testing.T) {
+	bulk := &expinfrav1.GCPMachinePoolTargetSizePolicy{Mode: expinfrav1.BulkGCPMachinePoolTargetSizeMode}
+	tests := []struct {
+		name        string
+		oldSpec     expinfrav1.GCPMachinePoolSpec
+		spec        expinfrav1.GCPMachinePoolSpec
+		expectError bool
+	}{
+		{
+			name:        "unchanged target size policy",
+			oldSpec:     expinfrav1.GCPMachinePoolSpec{TargetSizePolicy: bulk},
+			spec:        expinfrav1.GCPMachinePoolSpec{TargetSizePolicy: bulk, InstanceType: "n2-standard-4"},
+			expectError: false,
+		},
+		{
+			name:        "setting the target size policy",
+			oldSpec:     expinfrav1.GCPMachinePoolSpec{},
+			spec:        expinfrav1.GCPMachinePoolSpec{TargetSizePolicy: bulk},
+			expectError: true,
+		},
+		{
+			name:        "invalid spec",
+			oldSpec:     expinfrav1.GCPMachinePoolSpec{},
+			spec:        expinfrav1.GCPMachinePoolSpec{Strategy: &expinfrav1.GCPMachinePoolStrategy{MaxSurge: ptr.To(intstr.FromInt32(-1))}},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			oldMP := &expinfrav1.GCPMachinePool{Spec: tc.oldSpec}
+			mp := &expinfrav1.GCPMachinePool{Spec: tc.spec}
+			warn, err := (&GCPMachinePool{}).ValidateUpdate(t.Context(), oldMP, mp)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var gcpMachinePoolTemplateLog = logf.Log.WithName("gcpmachinepooltemplate-resource")
+
+// SetupWebhookWithManager sets up and registers the webhook with the manager.
+func (r *GCPMachinePoolTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&expinfrav1.GCPMachinePoolTemplate{}).
+		WithValidator(r).
+		Complete()
+}
+
+// GCPMachinePoolTemplate implements a validating webhook for GCPMachinePoolTemplate.
+type GCPMachinePoolTemplate struct{}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-gcpmachinepooltemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinepooltemplates,versions=v1beta1,name=validation.gcpmachinepooltemplate.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1
+
+var _ webhook.CustomValidator = &GCPMachinePoolTemplate{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (*GCPMachinePoolTemplate) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	r, ok := obj.(*expinfrav1.GCPMachinePoolTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a GCPMachinePoolTemplate but got a %T", obj))
+	}
+
+	gcpMachinePoolTemplateLog.Info("Validating GCPMachinePoolTemplate create", "name", r.Name)
+
+	return nil, validateGCPMachinePoolTemplate(r)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (*GCPMachinePoolTemplate) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*expinfrav1.GCPMachinePoolTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a GCPMachinePoolTemplate but got a %T", oldObj))
+	}
+
+	r, ok := newObj.(*expinfrav1.GCPMachinePoolTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a GCPMachinePoolTemplate but got a %T", newObj))
+	}
+
+	gcpMachinePoolTemplateLog.Info("Validating GCPMachinePoolTemplate update", "name", r.Name)
+
+	// Templates are immutable: changes are rolled out by creating a new template and referencing it from the
+	// ClusterClass. The topology controller dry-runs changes to templates, which must be allowed.
+	if !isTopologyDryRunRequest(ctx, r) && !reflect.DeepEqual(old.Spec.Template.Spec, r.Spec.Template.Spec) {
+		return nil, apierrors.NewInvalid(r.GroupVersionKind().GroupKind(), r.Name, field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "template", "spec"), "cannot be modified, create a new GCPMachinePoolTemplate instead"),
+		})
+	}
+
+	return nil, validateGCPMachinePoolTemplate(r)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (*GCPMachinePoolTemplate) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateGCPMachinePoolTemplate(r *expinfrav1.GCPMachinePoolTemplate) error {
+	fldPath := field.NewPath("spec", "template", "spec")
+	allErrs := validateGCPMachinePoolSpec(&r.Spec.Template.Spec, fldPath)
+
+	// Provider IDs are set by the controller on the GCPMachinePool cloned from the template.
+	if len(r.Spec.Template.Spec.ProviderIDList) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("providerIDList"), "cannot be set in a template"))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(
+		r.GroupVersionKind().GroupKind(),
+		r.Name,
+		allErrs,
+	)
+}
+
+// isTopologyDryRunRequest returns true if the admission request is a dry-run issued by the topology controller.
+func isTopologyDryRunRequest(ctx context.Context, obj metav1.Object) bool {
+	if _, ok := obj.GetAnnotations()[clusterv1.TopologyDryRunAnnotation]; !ok {
+		return false
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return false
+	}
+
+	return req.DryRun != nil && *req.DryRun
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestGCPMachinePoolTemplateValidatingWebhookCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        expinfrav1.GCPMachinePoolSpec
+		expectError bool
+	}{
+		{
+			name: "valid spec",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				InstanceType: "n1-standard-2",
+			},
+			expectError: false,
+		},
+		{
+			name: "spec is validated like a GCPMachinePool",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				InstanceType: "n1-standard-2",
+				Strategy: &expinfrav1.GCPMachinePoolStrategy{
+					MaxSurge: ptr.To(intstr.FromInt32(-1)),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "providerIDList is set",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				InstanceType:   "n1-standard-2",
+				ProviderIDList: []string{"gce://project/us-west1-a/instance"},
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mpt := &expinfrav1.GCPMachinePoolTemplate{
+				Spec: expinfrav1.GCPMachinePoolTemplateSpec{
+					Template: expinfrav1.GCPMachinePoolTemplateResource{Spec: tc.spec},
+				},
+			}
+			warn, err := (&GCPMachinePoolTemplate{}).ValidateCreate(t.Context(), mpt)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			// Nothing emits warnings yet
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
+
+func TestGCPMachinePoolTemplateValidatingWebhookUpdate(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        expinfrav1.GCPMachinePoolSpec
+		annotations map[string]string
+		dryRun      bool
+		expectError bool
+	}{
+		{
+			name: "spec is not mutated",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				InstanceType: "n1-standard-2",
+			},
+			expectError: false,
+		},
+		{
+			name: "spec is mutated",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				InstanceType: "n1-standard-4",
+			},
+			expectError: true,
+		},
+		{
+			name: "spec is mutated by a topology dry-run",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				InstanceType: "n1-standard-4",
+			},
+			annotations: map[string]string{clusterv1.TopologyDryRunAnnotation: ""},
+			dryRun:      true,
+			expectError: false,
+		},
+		{
+			name: "spec is mutated with the dry-run annotation outside of a dry-run",
+			spec: expinfrav1.GCPMachinePoolSpec{
+				InstanceType: "n1-standard-4",
+			},
+			annotations: map[string]string{clusterv1.TopologyDryRunAnnotation: ""},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			oldMPT := &expinfrav1.GCPMachinePoolTemplate{
+				Spec: expinfrav1.GCPMachinePoolTemplateSpec{
+					Template: expinfrav1.GCPMachinePoolTemplateResource{
+						Spec: expinfrav1.GCPMachinePoolSpec{InstanceType: "n1-standard-2"},
+					},
+				},
+			}
+			newMPT := &expinfrav1.GCPMachinePoolTemplate{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec: expinfrav1.GCPMachinePoolTemplateSpec{
+					Template: expinfrav1.GCPMachinePoolTemplateResource{Spec: tc.spec},
+				},
+			}
+
+			ctx := admission.NewContextWithRequest(t.Context(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{DryRun: ptr.To(tc.dryRun)},
+			})
+			warn, err := (&GCPMachinePoolTemplate{}).ValidateUpdate(ctx, oldMPT, newMPT)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			// Nothing emits warnings yet
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
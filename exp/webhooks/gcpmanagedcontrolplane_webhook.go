@@ -19,16 +19,23 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/gkeversion"
 	"sigs.k8s.io/cluster-api-provider-gcp/util/hash"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -38,6 +45,31 @@ import (
 const (
 	maxClusterNameLength = 40
 	resourcePrefix       = "capg-"
+
+	maxMaintenanceExclusions = 20
+	// maxNoUpgradesExclusionDuration and maxNoMinorUpgradesExclusionDuration are the longest
+	// maintenance exclusions GKE accepts for each scope.
+	maxNoUpgradesExclusionDuration      = 30 * 24 * time.Hour
+	maxNoMinorUpgradesExclusionDuration = 180 * 24 * time.Hour
+)
+
+var (
+	rruleFrequencies = sets.New("SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY")
+	rruleWeekdays    = sets.New("MO", "TU", "WE", "TH", "FR", "SA", "SU")
+	rruleWeekday     = regexp.MustCompile(`^[+-]?[0-9]{0,2}(MO|TU|WE|TH|FR|SA|SU)$`)
+	rruleUntil       = regexp.MustCompile(`^[0-9]{8}(T[0-9]{6}Z?)?$`)
+	// rruleNumericParts maps the numeric list parts of an RRULE to the range of values they accept.
+	// Negative values count from the end of the period, so zero is only valid where no negative values are.
+	rruleNumericParts = map[string][2]int{
+		"BYSECOND":   {0, 60},
+		"BYMINUTE":   {0, 59},
+		"BYHOUR":     {0, 23},
+		"BYMONTHDAY": {-31, 31},
+		"BYYEARDAY":  {-366, 366},
+		"BYWEEKNO":   {-53, 53},
+		"BYMONTH":    {1, 12},
+		"BYSETPOS":   {-366, 366},
+	}
 )
 
 // log is for logging in this package.
@@ -67,7 +99,7 @@ func (*GCPManagedControlPlane) Default(_ context.Context, obj runtime.Object) er
 
 	gcpmanagedcontrolplanelog.Info("default", "name", r.Name)
 
-	if r.Spec.ClusterName == "" {
+	if r.Spec.ClusterName == "" && !r.Spec.Import {
 		gcpmanagedcontrolplanelog.Info("ClusterName is empty, generating name")
 		name, err := generateGKEName(r.Name, r.Namespace, maxClusterNameLength)
 		if err != nil {
@@ -81,7 +113,7 @@ func (*GCPManagedControlPlane) Default(_ context.Context, obj runtime.Object) er
 	return nil
 }
 
-//+kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-gcpmanagedcontrolplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=gcpmanagedcontrolplanes,verbs=create;update,versions=v1beta1,name=vgcpmanagedcontrolplane.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-gcpmanagedcontrolplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=gcpmanagedcontrolplanes,verbs=create;update;delete,versions=v1beta1,name=vgcpmanagedcontrolplane.kb.io,admissionReviewVersions=v1
 
 var _ webhook.CustomValidator = &GCPManagedControlPlane{}
 
@@ -103,7 +135,12 @@ func (*GCPManagedControlPlane) ValidateCreate(_ context.Context, obj runtime.Obj
 		)
 	}
 
-	if r.Spec.EnableAutopilot && r.Spec.ReleaseChannel == nil {
+	if r.Spec.Import && r.Spec.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "ClusterName"), "cluster name is required to import an existing cluster"))
+	}
+
+	// The release channel of an imported cluster is read from the existing cluster.
+	if r.Spec.EnableAutopilot && r.Spec.ReleaseChannel == nil && !r.Spec.Import {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "ReleaseChannel"), "Release channel is required for an autopilot enabled cluster"))
 	}
 
@@ -126,6 +163,27 @@ func (*GCPManagedControlPlane) ValidateCreate(_ context.Context, obj runtime.Obj
 		}
 	}
 
+	allErrs = append(allErrs, validateVersions(&r.Spec)...)
+
+	if r.Spec.MaintenancePolicy != nil {
+		allErrs = append(allErrs, validateMaintenancePolicy(r.Spec.MaintenancePolicy, field.NewPath("spec", "maintenancePolicy"))...)
+	}
+
+	if r.Spec.ClusterNetwork != nil {
+		allErrs = append(allErrs, validateClusterNetwork(r.Spec.ClusterNetwork, r.Spec.Addons, field.NewPath("spec", "clusterNetwork"))...)
+	}
+
+	if r.Spec.ClusterAutoscaling != nil {
+		if r.Spec.EnableAutopilot {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "clusterAutoscaling"), "can't be set when autopilot is enabled"))
+		}
+		allErrs = append(allErrs, validateClusterAutoscaling(r.Spec.ClusterAutoscaling, field.NewPath("spec", "clusterAutoscaling"))...)
+	}
+
+	if r.Spec.ClusterSecurity != nil && r.Spec.ClusterSecurity.DatabaseEncryption != nil {
+		allErrs = append(allErrs, validateDatabaseEncryption(r.Spec.ClusterSecurity.DatabaseEncryption, field.NewPath("spec", "clusterSecurity", "databaseEncryption"))...)
+	}
+
 	if len(allErrs) == 0 {
 		return allWarns, nil
 	}
@@ -165,13 +223,30 @@ func (*GCPManagedControlPlane) ValidateUpdate(_ context.Context, oldObj, newObj
 		)
 	}
 
-	if !cmp.Equal(r.Spec.EnableAutopilot, old.Spec.EnableAutopilot) {
+	if !cmp.Equal(r.Spec.Import, old.Spec.Import) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "import"),
+				r.Spec.Import, "field is immutable"),
+		)
+	}
+
+	// The settings of an imported cluster are read into the spec by the controller until the import completes.
+	importing := old.Spec.Import && !v1beta1conditions.IsTrue(old, expinfrav1.GKEControlPlaneImportedCondition)
+
+	if !importing && !cmp.Equal(r.Spec.EnableAutopilot, old.Spec.EnableAutopilot) {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "EnableAutopilot"),
 				r.Spec.EnableAutopilot, "field is immutable"),
 		)
 	}
 
+	if !importing && !cmp.Equal(datapathProvider(r.Spec.ClusterNetwork), datapathProvider(old.Spec.ClusterNetwork)) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "clusterNetwork", "datapathProvider"),
+				datapathProvider(r.Spec.ClusterNetwork), "field is immutable"),
+		)
+	}
+
 	if old.Spec.EnableAutopilot && r.Spec.LoggingService != nil {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "LoggingService"),
 			r.Spec.LoggingService, "can't be set when autopilot is enabled"))
@@ -187,6 +262,8 @@ func (*GCPManagedControlPlane) ValidateUpdate(_ context.Context, oldObj, newObj
 			r.Spec.LoggingService, "spec.ControlPlaneVersion and spec.Version cannot be set at the same time: please use spec.Version"))
 	}
 
+	allErrs = append(allErrs, validateVersions(&r.Spec)...)
+
 	if r.Spec.LoggingService != nil {
 		err := r.Spec.LoggingService.Validate()
 		if err != nil {
@@ -203,6 +280,25 @@ func (*GCPManagedControlPlane) ValidateUpdate(_ context.Context, oldObj, newObj
 		}
 	}
 
+	if r.Spec.MaintenancePolicy != nil {
+		allErrs = append(allErrs, validateMaintenancePolicy(r.Spec.MaintenancePolicy, field.NewPath("spec", "maintenancePolicy"))...)
+	}
+
+	if r.Spec.ClusterNetwork != nil {
+		allErrs = append(allErrs, validateClusterNetwork(r.Spec.ClusterNetwork, r.Spec.Addons, field.NewPath("spec", "clusterNetwork"))...)
+	}
+
+	if r.Spec.ClusterAutoscaling != nil {
+		if r.Spec.EnableAutopilot {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "clusterAutoscaling"), "can't be set when autopilot is enabled"))
+		}
+		allErrs = append(allErrs, validateClusterAutoscaling(r.Spec.ClusterAutoscaling, field.NewPath("spec", "clusterAutoscaling"))...)
+	}
+
+	if r.Spec.ClusterSecurity != nil && r.Spec.ClusterSecurity.DatabaseEncryption != nil {
+		allErrs = append(allErrs, validateDatabaseEncryption(r.Spec.ClusterSecurity.DatabaseEncryption, field.NewPath("spec", "clusterSecurity", "databaseEncryption"))...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -211,10 +307,232 @@ func (*GCPManagedControlPlane) ValidateUpdate(_ context.Context, oldObj, newObj
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
-func (*GCPManagedControlPlane) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+func (*GCPManagedControlPlane) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	r, ok := obj.(*expinfrav1.GCPManagedControlPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected an GCPManagedControlPlane object but got %T", r)
+	}
+
+	gcpmanagedcontrolplanelog.Info("validate delete", "name", r.Name)
+
+	if r.DeletionProtected() {
+		return nil, apierrors.NewForbidden(expinfrav1.GroupVersion.WithResource("gcpmanagedcontrolplanes").GroupResource(), r.Name,
+			fmt.Errorf("deletion protection is enabled: set the %s annotation to \"true\" to delete it", expinfrav1.AllowDeletionAnnotation))
+	}
+
 	return nil, nil
 }
 
+func validateClusterNetwork(cn *expinfrav1.ClusterNetwork, addons *expinfrav1.ClusterAddons, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	advancedDatapath := datapathProvider(cn) == expinfrav1.AdvancedDatapath
+	if ptr.Deref(cn.EnableNetworkPolicy, false) {
+		if advancedDatapath {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableNetworkPolicy"), "network policy enforcement is built into advanced_datapath and can't be enabled"))
+		}
+		if addons != nil && addons.NetworkPolicy != nil && !*addons.NetworkPolicy {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableNetworkPolicy"), "network policy enforcement requires the networkPolicy add-on"))
+		}
+	}
+	if ptr.Deref(cn.EnableMultiNetworking, false) && !advancedDatapath {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableMultiNetworking"), "multi-networking requires advanced_datapath"))
+	}
+
+	if dns := cn.DNS; dns != nil {
+		dnsPath := fldPath.Child("dns")
+		if dns.Scope != "" && dns.Provider != expinfrav1.ClusterDNSProviderCloudDNS {
+			allErrs = append(allErrs, field.Forbidden(dnsPath.Child("scope"), "scope can only be set when provider is cloud_dns"))
+		}
+		if dns.Scope == expinfrav1.ClusterDNSScopeVPC && dns.Domain == "" {
+			allErrs = append(allErrs, field.Required(dnsPath.Child("domain"), "domain is required when scope is vpc_scope"))
+		}
+		if dns.Domain != "" && dns.Scope != expinfrav1.ClusterDNSScopeVPC {
+			allErrs = append(allErrs, field.Forbidden(dnsPath.Child("domain"), "domain can only be set when scope is vpc_scope"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateClusterAutoscaling(autoscaling *expinfrav1.ClusterAutoscaling, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	limitsPath := fldPath.Child("resourceLimits")
+	limitTypes := map[string]bool{}
+	for i, limit := range autoscaling.ResourceLimits {
+		limitTypes[limit.ResourceType] = true
+		if limit.Maximum < limit.Minimum {
+			allErrs = append(allErrs, field.Invalid(limitsPath.Index(i).Child("maximum"), limit.Maximum, "maximum must be greater than or equal to minimum"))
+		}
+	}
+
+	if autoscaling.EnableNodeAutoprovisioning {
+		for _, resourceType := range []string{"cpu", "memory"} {
+			if !limitTypes[resourceType] {
+				allErrs = append(allErrs, field.Required(limitsPath, fmt.Sprintf("a %s resource limit is required when node auto-provisioning is enabled", resourceType)))
+			}
+		}
+	} else {
+		if autoscaling.AutoprovisioningNodePoolDefaults != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoprovisioningNodePoolDefaults"), "can only be set when node auto-provisioning is enabled"))
+		}
+		if len(autoscaling.AutoprovisioningLocations) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoprovisioningLocations"), "can only be set when node auto-provisioning is enabled"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDatabaseEncryption(encryption *expinfrav1.DatabaseEncryption, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if encryption.State != expinfrav1.DatabaseEncryptionStateDecrypted && encryption.KeyName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("keyName"), "keyName is required when the secrets are encrypted"))
+	}
+
+	return allErrs
+}
+
+// datapathProvider returns the datapath provider of the cluster network, or an empty string when it is not set.
+func datapathProvider(cn *expinfrav1.ClusterNetwork) expinfrav1.DatapathProvider {
+	if cn == nil || cn.DatapathProvider == nil {
+		return ""
+	}
+	return *cn.DatapathProvider
+}
+
+// validateVersions checks the syntax of the control plane version, whether it is available in the location and the
+// release channel of the cluster is checked on reconcile.
+func validateVersions(spec *expinfrav1.GCPManagedControlPlaneSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.Version != nil {
+		if err := gkeversion.Validate(*spec.Version); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "version"), *spec.Version, err.Error()))
+		}
+	}
+	if spec.ControlPlaneVersion != nil { //nolint:staticcheck
+		if err := gkeversion.Validate(*spec.ControlPlaneVersion); err != nil { //nolint:staticcheck
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneVersion"), *spec.ControlPlaneVersion, err.Error())) //nolint:staticcheck
+		}
+	}
+
+	return allErrs
+}
+
+func validateMaintenancePolicy(policy *expinfrav1.MaintenancePolicy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if policy.DailyMaintenanceWindow != nil && policy.RecurringWindow != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("recurringWindow"), "only one of dailyMaintenanceWindow and recurringWindow can be specified"))
+	}
+
+	if window := policy.RecurringWindow; window != nil {
+		windowPath := fldPath.Child("recurringWindow")
+		if !window.EndTime.After(window.StartTime.Time) {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("endTime"), window.EndTime, "must be after startTime"))
+		}
+		if err := validateRecurrence(window.Recurrence); err != nil {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("recurrence"), window.Recurrence, err.Error()))
+		}
+	}
+
+	exclusionsPath := fldPath.Child("maintenanceExclusions")
+	if len(policy.MaintenanceExclusions) > maxMaintenanceExclusions {
+		allErrs = append(allErrs, field.TooMany(exclusionsPath, len(policy.MaintenanceExclusions), maxMaintenanceExclusions))
+	}
+	names := sets.New[string]()
+	for i, exclusion := range policy.MaintenanceExclusions {
+		exclusionPath := exclusionsPath.Index(i)
+		if names.Has(exclusion.Name) {
+			allErrs = append(allErrs, field.Duplicate(exclusionPath.Child("name"), exclusion.Name))
+		}
+		names.Insert(exclusion.Name)
+
+		if !exclusion.EndTime.After(exclusion.StartTime.Time) {
+			allErrs = append(allErrs, field.Invalid(exclusionPath.Child("endTime"), exclusion.EndTime, "must be after startTime"))
+			continue
+		}
+		maxDuration := maxNoUpgradesExclusionDuration
+		if exclusion.Scope == expinfrav1.MaintenanceExclusionScopeNoMinorUpgrades {
+			maxDuration = maxNoMinorUpgradesExclusionDuration
+		}
+		if exclusion.EndTime.Sub(exclusion.StartTime.Time) > maxDuration {
+			allErrs = append(allErrs, field.Invalid(exclusionPath.Child("endTime"), exclusion.EndTime,
+				fmt.Sprintf("exclusion with scope %q cannot last longer than %d days", exclusion.Scope, int(maxDuration.Hours()/24))))
+		}
+	}
+
+	return allErrs
+}
+
+// validateRecurrence validates an RFC 5545 RRULE, such as FREQ=WEEKLY;BYDAY=SA,SU.
+func validateRecurrence(recurrence string) error {
+	if recurrence == "" {
+		return errors.New("recurrence is required")
+	}
+
+	parts := map[string]string{}
+	for _, part := range strings.Split(strings.TrimPrefix(recurrence, "RRULE:"), ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return fmt.Errorf("invalid rule part %q: expected NAME=VALUE", part)
+		}
+		if _, found := parts[name]; found {
+			return fmt.Errorf("rule part %s specified more than once", name)
+		}
+		parts[name] = value
+
+		switch name {
+		case "FREQ":
+			if !rruleFrequencies.Has(value) {
+				return fmt.Errorf("invalid FREQ %q: expected one of %s", value, strings.Join(sets.List(rruleFrequencies), ","))
+			}
+		case "UNTIL":
+			if !rruleUntil.MatchString(value) {
+				return fmt.Errorf("invalid UNTIL %q: expected a date or date-time", value)
+			}
+		case "COUNT", "INTERVAL":
+			if n, err := strconv.Atoi(value); err != nil || n < 1 {
+				return fmt.Errorf("invalid %s %q: expected a positive integer", name, value)
+			}
+		case "WKST":
+			if !rruleWeekdays.Has(value) {
+				return fmt.Errorf("invalid WKST %q: expected a weekday", value)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				if !rruleWeekday.MatchString(day) {
+					return fmt.Errorf("invalid BYDAY value %q: expected a weekday", day)
+				}
+			}
+		default:
+			valueRange, ok := rruleNumericParts[name]
+			if !ok {
+				return fmt.Errorf("unsupported rule part %s", name)
+			}
+			for _, v := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(v)
+				if err != nil || n < valueRange[0] || n > valueRange[1] || (n == 0 && valueRange[0] < 0) {
+					return fmt.Errorf("invalid %s value %q", name, v)
+				}
+			}
+		}
+	}
+
+	if _, ok := parts["FREQ"]; !ok {
+		return errors.New("FREQ is required")
+	}
+	_, hasUntil := parts["UNTIL"]
+	_, hasCount := parts["COUNT"]
+	if hasUntil && hasCount {
+		return errors.New("only one of UNTIL and COUNT can be specified")
+	}
+
+	return nil
+}
+
 func generateGKEName(resourceName, namespace string, maxLength int) (string, error) {
 	escapedName := strings.ReplaceAll(resourceName, ".", "-")
 	gkeName := fmt.Sprintf("%s-%s", namespace, escapedName)
@@ -19,15 +19,19 @@ package webhooks
 import (
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 var (
 	vV1_32_5       = "v1.32.5"
 	releaseChannel = expinfrav1.Rapid
+	maintenanceDay = time.Date(2025, time.January, 4, 2, 0, 0, 0, time.UTC)
 )
 
 func TestGCPManagedControlPlaneDefaultingWebhook(t *testing.T) {
@@ -97,6 +101,15 @@ func TestGCPManagedControlPlaneDefaultingWebhook(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "no cluster name should not be generated when importing",
+			resourceName: "cluster1",
+			resourceNS:   "default",
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				Import: true,
+			},
+			expectSpec: expinfrav1.GCPManagedControlPlaneSpec{Import: true},
+		},
 	}
 
 	for _, tc := range tests {
@@ -114,7 +127,9 @@ func TestGCPManagedControlPlaneDefaultingWebhook(t *testing.T) {
 			g.Expect(err).NotTo(HaveOccurred())
 
 			g.Expect(mcp.Spec).ToNot(BeNil())
-			g.Expect(mcp.Spec.ClusterName).ToNot(BeEmpty())
+			if !tc.spec.Import {
+				g.Expect(mcp.Spec.ClusterName).ToNot(BeEmpty())
+			}
 
 			if tc.expectHash {
 				g.Expect(strings.HasPrefix(mcp.Spec.ClusterName, "capg-")).To(BeTrue())
@@ -165,6 +180,26 @@ func TestGCPManagedControlPlaneValidatingWebhookCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "import without cluster name should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				Import: true,
+			},
+		},
+		{
+			name:        "import of an autopilot cluster without release channel",
+			expectError: false,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				ClusterName: "existing-cluster",
+				Import:      true,
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					EnableAutopilot: true,
+				},
+			},
+		},
 		{
 			name:        "using deprecated ControlPlaneVersion should cause a warning",
 			expectError: false,
@@ -184,6 +219,302 @@ func TestGCPManagedControlPlaneValidatingWebhookCreate(t *testing.T) {
 				Version:             &vV1_32_5,
 			},
 		},
+		{
+			name:        "minor version alias",
+			expectError: false,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				Version: ptr.To("1.32"),
+			},
+		},
+		{
+			name:        "latest version alias",
+			expectError: false,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				Version: ptr.To("latest"),
+			},
+		},
+		{
+			name:        "invalid version should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				Version: ptr.To("1.32.x"),
+			},
+		},
+		{
+			name:        "invalid deprecated ControlPlaneVersion should cause an error",
+			expectError: true,
+			expectWarn:  true,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				ControlPlaneVersion: ptr.To("stable"),
+			},
+		},
+		{
+			name:        "recurring maintenance window with a valid RRULE",
+			expectError: false,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					MaintenancePolicy: &expinfrav1.MaintenancePolicy{
+						RecurringWindow: &expinfrav1.RecurringMaintenanceWindow{
+							StartTime:  metav1.NewTime(maintenanceDay),
+							EndTime:    metav1.NewTime(maintenanceDay.Add(8 * time.Hour)),
+							Recurrence: "FREQ=WEEKLY;BYDAY=SA,SU",
+						},
+						MaintenanceExclusions: []expinfrav1.MaintenanceExclusion{
+							{
+								Name:      "end-of-year",
+								StartTime: metav1.NewTime(maintenanceDay),
+								EndTime:   metav1.NewTime(maintenanceDay.Add(14 * 24 * time.Hour)),
+								Scope:     expinfrav1.MaintenanceExclusionScopeNoUpgrades,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "recurring maintenance window with an invalid RRULE should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					MaintenancePolicy: &expinfrav1.MaintenancePolicy{
+						RecurringWindow: &expinfrav1.RecurringMaintenanceWindow{
+							StartTime:  metav1.NewTime(maintenanceDay),
+							EndTime:    metav1.NewTime(maintenanceDay.Add(8 * time.Hour)),
+							Recurrence: "FREQ=WEEKLY;BYDAY=SATURDAY",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "daily and recurring maintenance windows should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					MaintenancePolicy: &expinfrav1.MaintenancePolicy{
+						DailyMaintenanceWindow: &expinfrav1.DailyMaintenanceWindow{
+							StartTime: "03:00",
+						},
+						RecurringWindow: &expinfrav1.RecurringMaintenanceWindow{
+							StartTime:  metav1.NewTime(maintenanceDay),
+							EndTime:    metav1.NewTime(maintenanceDay.Add(8 * time.Hour)),
+							Recurrence: "FREQ=DAILY",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "no upgrades maintenance exclusion longer than 30 days should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					MaintenancePolicy: &expinfrav1.MaintenancePolicy{
+						MaintenanceExclusions: []expinfrav1.MaintenanceExclusion{
+							{
+								Name:      "freeze",
+								StartTime: metav1.NewTime(maintenanceDay),
+								EndTime:   metav1.NewTime(maintenanceDay.Add(31 * 24 * time.Hour)),
+								Scope:     expinfrav1.MaintenanceExclusionScopeNoUpgrades,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "no minor upgrades maintenance exclusion longer than 30 days",
+			expectError: false,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					MaintenancePolicy: &expinfrav1.MaintenancePolicy{
+						MaintenanceExclusions: []expinfrav1.MaintenanceExclusion{
+							{
+								Name:      "freeze",
+								StartTime: metav1.NewTime(maintenanceDay),
+								EndTime:   metav1.NewTime(maintenanceDay.Add(90 * 24 * time.Hour)),
+								Scope:     expinfrav1.MaintenanceExclusionScopeNoMinorUpgrades,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "maintenance exclusion ending before it starts should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					MaintenancePolicy: &expinfrav1.MaintenancePolicy{
+						MaintenanceExclusions: []expinfrav1.MaintenanceExclusion{
+							{
+								Name:      "freeze",
+								StartTime: metav1.NewTime(maintenanceDay),
+								EndTime:   metav1.NewTime(maintenanceDay.Add(-time.Hour)),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "advanced datapath with multi-networking",
+			expectError: false,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterNetwork: &expinfrav1.ClusterNetwork{
+						DatapathProvider:      ptr.To(expinfrav1.AdvancedDatapath),
+						EnableMultiNetworking: ptr.To(true),
+						DNS: &expinfrav1.ClusterDNS{
+							Provider: expinfrav1.ClusterDNSProviderCloudDNS,
+							Scope:    expinfrav1.ClusterDNSScopeVPC,
+							Domain:   "cluster.example.com",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "network policy with advanced datapath should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterNetwork: &expinfrav1.ClusterNetwork{
+						DatapathProvider:    ptr.To(expinfrav1.AdvancedDatapath),
+						EnableNetworkPolicy: ptr.To(true),
+					},
+				},
+			},
+		},
+		{
+			name:        "multi-networking without advanced datapath should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterNetwork: &expinfrav1.ClusterNetwork{
+						EnableMultiNetworking: ptr.To(true),
+					},
+				},
+			},
+		},
+		{
+			name:        "node auto-provisioning with cpu and memory limits",
+			expectError: false,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterAutoscaling: &expinfrav1.ClusterAutoscaling{
+						EnableNodeAutoprovisioning: true,
+						ResourceLimits: []expinfrav1.ResourceLimit{
+							{ResourceType: "cpu", Maximum: 64},
+							{ResourceType: "memory", Maximum: 256},
+						},
+						AutoprovisioningNodePoolDefaults: &expinfrav1.AutoprovisioningNodePoolDefaults{
+							ServiceAccount: "nodes@test-project.iam.gserviceaccount.com",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "node auto-provisioning without memory limit should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterAutoscaling: &expinfrav1.ClusterAutoscaling{
+						EnableNodeAutoprovisioning: true,
+						ResourceLimits: []expinfrav1.ResourceLimit{
+							{ResourceType: "cpu", Maximum: 64},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "resource limit maximum lower than minimum should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterAutoscaling: &expinfrav1.ClusterAutoscaling{
+						ResourceLimits: []expinfrav1.ResourceLimit{
+							{ResourceType: "cpu", Minimum: 8, Maximum: 4},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "cluster autoscaling with autopilot enabled should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					EnableAutopilot: true,
+					ReleaseChannel:  &releaseChannel,
+					ClusterAutoscaling: &expinfrav1.ClusterAutoscaling{
+						AutoscalingProfile: ptr.To(expinfrav1.AutoscalingProfileOptimizeUtilization),
+					},
+				},
+			},
+		},
+		{
+			name:        "VPC scope DNS without domain should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterNetwork: &expinfrav1.ClusterNetwork{
+						DNS: &expinfrav1.ClusterDNS{
+							Provider: expinfrav1.ClusterDNSProviderCloudDNS,
+							Scope:    expinfrav1.ClusterDNSScopeVPC,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "encrypted database with a KMS key",
+			expectError: false,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterSecurity: &expinfrav1.ClusterSecurity{
+						DatabaseEncryption: &expinfrav1.DatabaseEncryption{
+							State:   expinfrav1.DatabaseEncryptionStateEncrypted,
+							KeyName: "projects/my-project/locations/us-central1/keyRings/gke/cryptoKeys/secrets",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "encrypted database without a KMS key should cause an error",
+			expectError: true,
+			expectWarn:  false,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterSecurity: &expinfrav1.ClusterSecurity{
+						DatabaseEncryption: &expinfrav1.DatabaseEncryption{
+							State: expinfrav1.DatabaseEncryptionStateEncrypted,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -213,6 +544,8 @@ func TestGCPManagedControlPlaneValidatingWebhookUpdate(t *testing.T) {
 	tests := []struct {
 		name        string
 		expectError bool
+		oldImport   bool
+		imported    bool
 		spec        expinfrav1.GCPManagedControlPlaneSpec
 	}{
 		{
@@ -252,6 +585,84 @@ func TestGCPManagedControlPlaneValidatingWebhookUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "request to change datapath provider should cause an error",
+			expectError: true,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterNetwork: &expinfrav1.ClusterNetwork{
+						PrivateCluster: &expinfrav1.PrivateCluster{
+							EnablePrivateEndpoint: true,
+						},
+						DatapathProvider: ptr.To(expinfrav1.AdvancedDatapath),
+					},
+				},
+			},
+		},
+		{
+			name:        "request to change import should cause an error",
+			expectError: true,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				Import:      true,
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterNetwork: &expinfrav1.ClusterNetwork{
+						PrivateCluster: &expinfrav1.PrivateCluster{
+							EnablePrivateEndpoint: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "importing autopilot and datapath provider should not cause an error",
+			expectError: false,
+			oldImport:   true,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				Import:      true,
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					EnableAutopilot: true,
+					ReleaseChannel:  &releaseChannel,
+					ClusterNetwork: &expinfrav1.ClusterNetwork{
+						PrivateCluster: &expinfrav1.PrivateCluster{
+							EnablePrivateEndpoint: true,
+						},
+						DatapathProvider: ptr.To(expinfrav1.AdvancedDatapath),
+					},
+				},
+			},
+		},
+		{
+			name:        "request to enable/disable autopilot on an imported cluster should cause an error",
+			expectError: true,
+			oldImport:   true,
+			imported:    true,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				Import:      true,
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					EnableAutopilot: true,
+					ReleaseChannel:  &releaseChannel,
+				},
+			},
+		},
+		{
+			name:        "request to change the version to an invalid version should cause an error",
+			expectError: true,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				Version:     ptr.To("v1.32.5-gke"),
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					ClusterNetwork: &expinfrav1.ClusterNetwork{
+						PrivateCluster: &expinfrav1.PrivateCluster{
+							EnablePrivateEndpoint: true,
+						},
+					},
+				},
+			},
+		},
 		{
 			name:        "request to change network should not cause an error",
 			expectError: false,
@@ -278,6 +689,7 @@ func TestGCPManagedControlPlaneValidatingWebhookUpdate(t *testing.T) {
 			oldMCP := &expinfrav1.GCPManagedControlPlane{
 				Spec: expinfrav1.GCPManagedControlPlaneSpec{
 					ClusterName: "default_cluster1",
+					Import:      tc.oldImport,
 					GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
 						ClusterNetwork: &expinfrav1.ClusterNetwork{
 							PrivateCluster: &expinfrav1.PrivateCluster{
@@ -288,6 +700,10 @@ func TestGCPManagedControlPlaneValidatingWebhookUpdate(t *testing.T) {
 				},
 			}
 
+			if tc.imported {
+				v1beta1conditions.MarkTrue(oldMCP, expinfrav1.GKEControlPlaneImportedCondition)
+			}
+
 			warn, err := (&GCPManagedControlPlane{}).ValidateUpdate(t.Context(), oldMCP, newMCP)
 
 			if tc.expectError {
@@ -300,3 +716,103 @@ func TestGCPManagedControlPlaneValidatingWebhookUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestGCPManagedControlPlaneValidatingWebhookDelete(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectError bool
+		annotations map[string]string
+		spec        expinfrav1.GCPManagedControlPlaneSpec
+	}{
+		{
+			name:        "deleting an unprotected cluster should not cause an error",
+			expectError: false,
+		},
+		{
+			name:        "deleting a protected cluster should cause an error",
+			expectError: true,
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					DeletionProtection: true,
+				},
+			},
+		},
+		{
+			name:        "deleting a protected cluster with the allow deletion annotation should not cause an error",
+			expectError: false,
+			annotations: map[string]string{expinfrav1.AllowDeletionAnnotation: "true"},
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					DeletionProtection: true,
+				},
+			},
+		},
+		{
+			name:        "deleting a protected cluster with the allow deletion annotation set to false should cause an error",
+			expectError: true,
+			annotations: map[string]string{expinfrav1.AllowDeletionAnnotation: "false"},
+			spec: expinfrav1.GCPManagedControlPlaneSpec{
+				GCPManagedControlPlaneClassSpec: expinfrav1.GCPManagedControlPlaneClassSpec{
+					DeletionProtection: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &expinfrav1.GCPManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cluster1",
+					Annotations: tc.annotations,
+				},
+				Spec: tc.spec,
+			}
+			warn, err := (&GCPManagedControlPlane{}).ValidateDelete(t.Context(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
+
+func TestValidateRecurrence(t *testing.T) {
+	tests := []struct {
+		recurrence  string
+		expectError bool
+	}{
+		{recurrence: "FREQ=DAILY"},
+		{recurrence: "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"},
+		{recurrence: "RRULE:FREQ=MONTHLY;BYDAY=1SA"},
+		{recurrence: "FREQ=MONTHLY;BYMONTHDAY=-1;INTERVAL=2;UNTIL=20261231T000000Z"},
+		{recurrence: "", expectError: true},
+		{recurrence: "BYDAY=SA", expectError: true},
+		{recurrence: "FREQ=FORTNIGHTLY", expectError: true},
+		{recurrence: "FREQ=DAILY;FREQ=WEEKLY", expectError: true},
+		{recurrence: "FREQ=DAILY;INTERVAL=0", expectError: true},
+		{recurrence: "FREQ=MONTHLY;BYMONTHDAY=0", expectError: true},
+		{recurrence: "FREQ=DAILY;COUNT=3;UNTIL=20261231", expectError: true},
+		{recurrence: "FREQ=DAILY;BYDAY", expectError: true},
+		{recurrence: "FREQ=DAILY;FOO=BAR", expectError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.recurrence, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := validateRecurrence(tc.recurrence)
+
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
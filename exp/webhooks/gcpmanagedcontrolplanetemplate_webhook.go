@@ -96,6 +96,25 @@ func (*GCPManagedControlPlaneTemplate) ValidateCreate(_ context.Context, obj run
 			r.Spec.Template.Spec.LoggingService, "can't be set when autopilot is enabled"))
 	}
 
+	if r.Spec.Template.Spec.MaintenancePolicy != nil {
+		allErrs = append(allErrs, validateMaintenancePolicy(r.Spec.Template.Spec.MaintenancePolicy, field.NewPath("spec", "template", "spec", "maintenancePolicy"))...)
+	}
+
+	if r.Spec.Template.Spec.ClusterNetwork != nil {
+		allErrs = append(allErrs, validateClusterNetwork(r.Spec.Template.Spec.ClusterNetwork, r.Spec.Template.Spec.Addons, field.NewPath("spec", "template", "spec", "clusterNetwork"))...)
+	}
+
+	if r.Spec.Template.Spec.ClusterAutoscaling != nil {
+		if r.Spec.Template.Spec.EnableAutopilot {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "clusterAutoscaling"), "can't be set when autopilot is enabled"))
+		}
+		allErrs = append(allErrs, validateClusterAutoscaling(r.Spec.Template.Spec.ClusterAutoscaling, field.NewPath("spec", "template", "spec", "clusterAutoscaling"))...)
+	}
+
+	if r.Spec.Template.Spec.ClusterSecurity != nil && r.Spec.Template.Spec.ClusterSecurity.DatabaseEncryption != nil {
+		allErrs = append(allErrs, validateDatabaseEncryption(r.Spec.Template.Spec.ClusterSecurity.DatabaseEncryption, field.NewPath("spec", "template", "spec", "clusterSecurity", "databaseEncryption"))...)
+	}
+
 	if len(allErrs) == 0 {
 		return allWarns, nil
 	}
@@ -165,6 +184,25 @@ func (*GCPManagedControlPlaneTemplate) ValidateUpdate(_ context.Context, oldObj,
 		}
 	}
 
+	if r.Spec.Template.Spec.MaintenancePolicy != nil {
+		allErrs = append(allErrs, validateMaintenancePolicy(r.Spec.Template.Spec.MaintenancePolicy, field.NewPath("spec", "template", "spec", "maintenancePolicy"))...)
+	}
+
+	if r.Spec.Template.Spec.ClusterNetwork != nil {
+		allErrs = append(allErrs, validateClusterNetwork(r.Spec.Template.Spec.ClusterNetwork, r.Spec.Template.Spec.Addons, field.NewPath("spec", "template", "spec", "clusterNetwork"))...)
+	}
+
+	if r.Spec.Template.Spec.ClusterAutoscaling != nil {
+		if r.Spec.Template.Spec.EnableAutopilot {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "clusterAutoscaling"), "can't be set when autopilot is enabled"))
+		}
+		allErrs = append(allErrs, validateClusterAutoscaling(r.Spec.Template.Spec.ClusterAutoscaling, field.NewPath("spec", "template", "spec", "clusterAutoscaling"))...)
+	}
+
+	if r.Spec.Template.Spec.ClusterSecurity != nil && r.Spec.Template.Spec.ClusterSecurity.DatabaseEncryption != nil {
+		allErrs = append(allErrs, validateDatabaseEncryption(r.Spec.Template.Spec.ClusterSecurity.DatabaseEncryption, field.NewPath("spec", "template", "spec", "clusterSecurity", "databaseEncryption"))...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
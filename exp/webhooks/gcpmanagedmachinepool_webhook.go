@@ -19,10 +19,13 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
 	webhookutils "sigs.k8s.io/cluster-api-provider-gcp/util/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -108,6 +111,138 @@ func validateScaling(scaling *expinfrav1.NodePoolAutoScaling, minField, maxField
 	return allErrs
 }
 
+func validateUpgradeSettings(upgradeSettings *expinfrav1.NodePoolUpgradeSettings, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if upgradeSettings.Strategy == expinfrav1.NodePoolUpgradeStrategyBlueGreen {
+		if upgradeSettings.MaxSurge != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxSurge"), "maxSurge can only be specified with the surge strategy"))
+		}
+		if upgradeSettings.MaxUnavailable != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxUnavailable"), "maxUnavailable can only be specified with the surge strategy"))
+		}
+	} else {
+		if upgradeSettings.BlueGreenSettings != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("blueGreenSettings"), "blueGreenSettings can only be specified with the blue_green strategy"))
+		}
+		// surge upgrades need at least one node to be added or removed at a time
+		if upgradeSettings.MaxSurge != nil && upgradeSettings.MaxUnavailable != nil && *upgradeSettings.MaxSurge+*upgradeSettings.MaxUnavailable == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), *upgradeSettings.MaxUnavailable, "maxSurge and maxUnavailable cannot both be zero"))
+		}
+	}
+
+	if blueGreenSettings := upgradeSettings.BlueGreenSettings; blueGreenSettings != nil {
+		if blueGreenSettings.BatchNodeCount != nil && blueGreenSettings.BatchPercentage != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("blueGreenSettings", "batchPercentage"), "only one of batchNodeCount and batchPercentage can be specified"))
+		}
+	}
+
+	return allErrs
+}
+
+// acceleratorMachineFamilies maps the GPU types to the machine families they can be attached to.
+// GPU types that are not listed are not validated.
+var acceleratorMachineFamilies = map[string]string{
+	"nvidia-tesla-t4":       "n1",
+	"nvidia-tesla-p4":       "n1",
+	"nvidia-tesla-p100":     "n1",
+	"nvidia-tesla-v100":     "n1",
+	"nvidia-tesla-a100":     "a2",
+	"nvidia-a100-80gb":      "a2",
+	"nvidia-l4":             "g2",
+	"nvidia-h100-80gb":      "a3",
+	"nvidia-h100-mega-80gb": "a3",
+	"nvidia-h200-141gb":     "a3",
+	"nvidia-b200":           "a4",
+}
+
+// nodePoolMachineType returns the machine type of the node pool, instanceType taking precedence over machineType.
+func nodePoolMachineType(spec *expinfrav1.GCPManagedMachinePoolClassSpec) string {
+	if spec.InstanceType != nil {
+		return *spec.InstanceType
+	}
+	return ptr.Deref(spec.MachineType, "")
+}
+
+func validateNodePoolProvisioning(spec *expinfrav1.GCPManagedMachinePoolClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	machineType := nodePoolMachineType(spec)
+	machineFamily, _, _ := strings.Cut(machineType, "-")
+	for i, accelerator := range spec.Accelerators {
+		acceleratorPath := fldPath.Child("accelerators").Index(i)
+		if family, ok := acceleratorMachineFamilies[accelerator.Type]; ok && family != machineFamily {
+			allErrs = append(allErrs, field.Invalid(acceleratorPath.Child("type"), accelerator.Type,
+				fmt.Sprintf("requires a %s machine type, got %q", family, machineType)))
+		}
+		if accelerator.GPUPartitionSize != "" && accelerator.GPUSharing != nil && accelerator.GPUSharing.Strategy == expinfrav1.GPUSharingStrategyMPS {
+			allErrs = append(allErrs, field.Forbidden(acceleratorPath.Child("gpuSharing", "strategy"), "mps can't be combined with gpuPartitionSize"))
+		}
+	}
+
+	if spec.Spot && spec.Preemptible {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("preemptible"), "only one of spot and preemptible can be set"))
+	}
+
+	if reservationAffinity := spec.ReservationAffinity; reservationAffinity != nil {
+		if reservationAffinity.ConsumeReservationType == expinfrav1.ReservationAffinityTypeSpecificReservation {
+			if reservationAffinity.Key == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("reservationAffinity", "key"), "key is required with specific_reservation"))
+			}
+			if len(reservationAffinity.Values) == 0 {
+				allErrs = append(allErrs, field.Required(fldPath.Child("reservationAffinity", "values"), "values are required with specific_reservation"))
+			}
+		} else if reservationAffinity.Key != "" || len(reservationAffinity.Values) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("reservationAffinity", "key"), "key and values can only be set with specific_reservation"))
+		}
+	}
+
+	if placementPolicy := spec.PlacementPolicy; placementPolicy != nil {
+		// TPU machine types belong to the ct* machine families.
+		if placementPolicy.TPUTopology != "" && !strings.HasPrefix(machineFamily, "ct") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("placementPolicy", "tpuTopology"), placementPolicy.TPUTopology,
+				fmt.Sprintf("requires a TPU machine type, got %q", machineType)))
+		}
+	}
+
+	if spec.EnableQueuedProvisioning {
+		if spec.Scaling == nil || !ptr.Deref(spec.Scaling.EnableAutoscaling, true) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableQueuedProvisioning"), "queued provisioning requires autoscaling"))
+		}
+		if spec.ReservationAffinity != nil && spec.ReservationAffinity.ConsumeReservationType != expinfrav1.ReservationAffinityTypeNoReservation {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableQueuedProvisioning"), "queued provisioning can't consume reservations"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNodeSystemConfig(spec *expinfrav1.GCPManagedMachinePoolClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if kubeletConfig := spec.KubeletConfig; kubeletConfig != nil {
+		kubeletConfigPath := fldPath.Child("kubeletConfig")
+		if period := kubeletConfig.CPUCFSQuotaPeriod; period != nil && (period.Duration < time.Millisecond || period.Duration > time.Second) {
+			allErrs = append(allErrs, field.Invalid(kubeletConfigPath.Child("cpuCFSQuotaPeriod"), period.Duration.String(), "must be between 1ms and 1s"))
+		}
+		if kubeletConfig.ImageGCLowThresholdPercent != nil && kubeletConfig.ImageGCHighThresholdPercent != nil &&
+			*kubeletConfig.ImageGCHighThresholdPercent <= *kubeletConfig.ImageGCLowThresholdPercent {
+			allErrs = append(allErrs, field.Invalid(kubeletConfigPath.Child("imageGCHighThresholdPercent"), *kubeletConfig.ImageGCHighThresholdPercent,
+				"must be greater than field "+kubeletConfigPath.Child("imageGCLowThresholdPercent").String()))
+		}
+	}
+
+	if containerdConfig := spec.ContainerdConfig; containerdConfig != nil {
+		privateRegistryAccess := containerdConfig.PrivateRegistryAccess
+		if privateRegistryAccess != nil && !privateRegistryAccess.Enabled && len(privateRegistryAccess.CertificateAuthorityDomains) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("containerdConfig", "privateRegistryAccess", "certificateAuthorityDomains"),
+				"certificateAuthorityDomains can only be specified when private registry access is enabled"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (*GCPManagedMachinePool) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
 	r, ok := obj.(*expinfrav1.GCPManagedMachinePool)
@@ -136,6 +271,13 @@ func (*GCPManagedMachinePool) ValidateCreate(_ context.Context, obj runtime.Obje
 		}
 	}
 
+	if r.Spec.UpgradeSettings != nil {
+		allErrs = append(allErrs, validateUpgradeSettings(r.Spec.UpgradeSettings, field.NewPath("spec", "upgradeSettings"))...)
+	}
+
+	allErrs = append(allErrs, validateNodePoolProvisioning(&r.Spec.GCPManagedMachinePoolClassSpec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateNodeSystemConfig(&r.Spec.GCPManagedMachinePoolClassSpec, field.NewPath("spec"))...)
+
 	if err := webhookutils.ValidateNonNegative(
 		field.NewPath("spec", "template", "spec", "diskSizeGb"),
 		r.Spec.DiskSizeGb,
@@ -195,6 +337,13 @@ func (*GCPManagedMachinePool) ValidateUpdate(_ context.Context, oldObj, newObj r
 		}
 	}
 
+	if r.Spec.UpgradeSettings != nil {
+		allErrs = append(allErrs, validateUpgradeSettings(r.Spec.UpgradeSettings, field.NewPath("spec", "upgradeSettings"))...)
+	}
+
+	allErrs = append(allErrs, validateNodePoolProvisioning(&r.Spec.GCPManagedMachinePoolClassSpec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateNodeSystemConfig(&r.Spec.GCPManagedMachinePoolClassSpec, field.NewPath("spec"))...)
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "instanceType"),
 		old.Spec.InstanceType,
@@ -230,6 +379,13 @@ func (*GCPManagedMachinePool) ValidateUpdate(_ context.Context, oldObj, newObj r
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "bootDiskKmsKey"),
+		old.Spec.BootDiskKMSKey,
+		r.Spec.BootDiskKMSKey); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "localSsdCount"),
 		old.Spec.LocalSsdCount,
@@ -279,6 +435,48 @@ func (*GCPManagedMachinePool) ValidateUpdate(_ context.Context, oldObj, newObj r
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "accelerators"),
+		old.Spec.Accelerators,
+		r.Spec.Accelerators); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "spot"),
+		old.Spec.Spot,
+		r.Spec.Spot); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "preemptible"),
+		old.Spec.Preemptible,
+		r.Spec.Preemptible); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "reservationAffinity"),
+		old.Spec.ReservationAffinity,
+		r.Spec.ReservationAffinity); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "placementPolicy"),
+		old.Spec.PlacementPolicy,
+		r.Spec.PlacementPolicy); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "enableQueuedProvisioning"),
+		old.Spec.EnableQueuedProvisioning,
+		r.Spec.EnableQueuedProvisioning); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -19,8 +19,11 @@ package webhooks
 import (
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
 )
@@ -36,6 +39,10 @@ var (
 	invalidDiskSizeGb = int32(-200)
 	invalidMaxPods    = int64(-10)
 	invalidLocalSsds  = int32(-0)
+	maxSurge          = int32(1)
+	maxUnavailable    = int32(0)
+	batchNodeCount    = int32(1)
+	batchPercentage   = int32(50)
 )
 
 func TestGCPManagedMachinePoolValidatingWebhookCreate(t *testing.T) {
@@ -139,6 +146,259 @@ func TestGCPManagedMachinePoolValidatingWebhookCreate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "valid surge upgrade settings",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					UpgradeSettings: &expinfrav1.NodePoolUpgradeSettings{
+						Strategy:       expinfrav1.NodePoolUpgradeStrategySurge,
+						MaxSurge:       &maxSurge,
+						MaxUnavailable: &maxUnavailable,
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "surge upgrade settings with zero surge and unavailable",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					UpgradeSettings: &expinfrav1.NodePoolUpgradeSettings{
+						Strategy:       expinfrav1.NodePoolUpgradeStrategySurge,
+						MaxSurge:       &maxUnavailable,
+						MaxUnavailable: &maxUnavailable,
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "surge upgrade settings with blue-green settings",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					UpgradeSettings: &expinfrav1.NodePoolUpgradeSettings{
+						Strategy: expinfrav1.NodePoolUpgradeStrategySurge,
+						BlueGreenSettings: &expinfrav1.NodePoolBlueGreenSettings{
+							BatchNodeCount: &batchNodeCount,
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid blue-green upgrade settings",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					UpgradeSettings: &expinfrav1.NodePoolUpgradeSettings{
+						Strategy: expinfrav1.NodePoolUpgradeStrategyBlueGreen,
+						BlueGreenSettings: &expinfrav1.NodePoolBlueGreenSettings{
+							BatchPercentage: &batchPercentage,
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "blue-green upgrade settings with max surge",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					UpgradeSettings: &expinfrav1.NodePoolUpgradeSettings{
+						Strategy: expinfrav1.NodePoolUpgradeStrategyBlueGreen,
+						MaxSurge: &maxSurge,
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "blue-green upgrade settings with both batch node count and percentage",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					UpgradeSettings: &expinfrav1.NodePoolUpgradeSettings{
+						Strategy: expinfrav1.NodePoolUpgradeStrategyBlueGreen,
+						BlueGreenSettings: &expinfrav1.NodePoolBlueGreenSettings{
+							BatchNodeCount:  &batchNodeCount,
+							BatchPercentage: &batchPercentage,
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid GPU node pool",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					MachineType:  ptr.To("g2-standard-8"),
+					Accelerators: []expinfrav1.Accelerator{
+						{
+							Type:             "nvidia-l4",
+							Count:            1,
+							GPUDriverVersion: ptr.To(expinfrav1.GPUDriverVersionLatest),
+							GPUSharing: &expinfrav1.GPUSharing{
+								Strategy:               expinfrav1.GPUSharingStrategyTimeSharing,
+								MaxSharedClientsPerGPU: 4,
+							},
+						},
+					},
+					Spot: true,
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "GPU attached to an incompatible machine family",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					MachineType:  ptr.To("e2-standard-8"),
+					Accelerators: []expinfrav1.Accelerator{
+						{Type: "nvidia-tesla-t4", Count: 1},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "spot and preemptible nodes",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					Spot:         true,
+					Preemptible:  true,
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "specific reservation without values",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					ReservationAffinity: &expinfrav1.ReservationAffinity{
+						ConsumeReservationType: expinfrav1.ReservationAffinityTypeSpecificReservation,
+						Key:                    "compute.googleapis.com/reservation-name",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "TPU topology with a TPU machine type",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					MachineType:  ptr.To("ct5lp-hightpu-4t"),
+					PlacementPolicy: &expinfrav1.PlacementPolicy{
+						Type:        expinfrav1.PlacementPolicyTypeCompact,
+						TPUTopology: "2x4",
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "TPU topology without a TPU machine type",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					MachineType:  ptr.To("n2-standard-8"),
+					PlacementPolicy: &expinfrav1.PlacementPolicy{
+						TPUTopology: "2x4",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "queued provisioning without autoscaling",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName:             "nodepool1",
+					EnableQueuedProvisioning: true,
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid kubelet and containerd config",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					KubeletConfig: &expinfrav1.KubeletConfig{
+						CPUManagerPolicy:            ptr.To(expinfrav1.CPUManagerPolicyStatic),
+						CPUCFSQuotaPeriod:           &metav1.Duration{Duration: 50 * time.Millisecond},
+						ImageGCLowThresholdPercent:  ptr.To[int32](70),
+						ImageGCHighThresholdPercent: ptr.To[int32](80),
+					},
+					ContainerdConfig: &expinfrav1.ContainerdConfig{
+						PrivateRegistryAccess: &expinfrav1.PrivateRegistryAccess{
+							Enabled: true,
+							CertificateAuthorityDomains: []expinfrav1.CertificateAuthorityDomain{
+								{
+									FQDNs:     []string{"registry.example.com"},
+									SecretURI: "projects/my-project/secrets/registry-ca/versions/1",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "CPU CFS quota period out of range",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					KubeletConfig: &expinfrav1.KubeletConfig{
+						CPUCFSQuotaPeriod: &metav1.Duration{Duration: 2 * time.Second},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "image GC high threshold below low threshold",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					KubeletConfig: &expinfrav1.KubeletConfig{
+						ImageGCLowThresholdPercent:  ptr.To[int32](80),
+						ImageGCHighThresholdPercent: ptr.To[int32](70),
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "certificate authority domains with private registry access disabled",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					ContainerdConfig: &expinfrav1.ContainerdConfig{
+						PrivateRegistryAccess: &expinfrav1.PrivateRegistryAccess{
+							CertificateAuthorityDomains: []expinfrav1.CertificateAuthorityDomain{
+								{
+									FQDNs:     []string{"registry.example.com"},
+									SecretURI: "projects/my-project/secrets/registry-ca/versions/1",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -188,6 +448,16 @@ func TestGCPManagedMachinePoolValidatingWebhookUpdate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "immutable field spot is mutated",
+			spec: expinfrav1.GCPManagedMachinePoolSpec{
+				GCPManagedMachinePoolClassSpec: expinfrav1.GCPManagedMachinePoolClassSpec{
+					NodePoolName: "nodepool1",
+					Spot:         true,
+				},
+			},
+			expectError: true,
+		},
 		{
 			name: "immutable field disk size is mutated",
 			spec: expinfrav1.GCPManagedMachinePoolSpec{
@@ -92,6 +92,13 @@ func (*GCPManagedMachinePoolTemplate) ValidateCreate(_ context.Context, obj runt
 		}
 	}
 
+	if r.Spec.Template.Spec.UpgradeSettings != nil {
+		allErrs = append(allErrs, validateUpgradeSettings(r.Spec.Template.Spec.UpgradeSettings, field.NewPath("spec", "template", "spec", "upgradeSettings"))...)
+	}
+
+	allErrs = append(allErrs, validateNodePoolProvisioning(&r.Spec.Template.Spec.GCPManagedMachinePoolClassSpec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateNodeSystemConfig(&r.Spec.Template.Spec.GCPManagedMachinePoolClassSpec, field.NewPath("spec", "template", "spec"))...)
+
 	if err := webhookutils.ValidateNonNegative(
 		field.NewPath("spec", "template", "spec", "diskSizeGb"),
 		r.Spec.Template.Spec.DiskSizeGb,
@@ -151,6 +158,13 @@ func (*GCPManagedMachinePoolTemplate) ValidateUpdate(_ context.Context, oldObj,
 		}
 	}
 
+	if r.Spec.Template.Spec.UpgradeSettings != nil {
+		allErrs = append(allErrs, validateUpgradeSettings(r.Spec.Template.Spec.UpgradeSettings, field.NewPath("spec", "template", "spec", "upgradeSettings"))...)
+	}
+
+	allErrs = append(allErrs, validateNodePoolProvisioning(&r.Spec.Template.Spec.GCPManagedMachinePoolClassSpec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateNodeSystemConfig(&r.Spec.Template.Spec.GCPManagedMachinePoolClassSpec, field.NewPath("spec", "template", "spec"))...)
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "template", "spec", "instanceType"),
 		old.Spec.Template.Spec.InstanceType,
@@ -186,6 +200,13 @@ func (*GCPManagedMachinePoolTemplate) ValidateUpdate(_ context.Context, oldObj,
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "template", "spec", "bootDiskKmsKey"),
+		old.Spec.Template.Spec.BootDiskKMSKey,
+		r.Spec.Template.Spec.BootDiskKMSKey); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "template", "spec", "localSsdCount"),
 		old.Spec.Template.Spec.LocalSsdCount,
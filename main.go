@@ -90,6 +90,8 @@ var (
 	leaderElectionLeaseDuration time.Duration
 	leaderElectionRenewDeadline time.Duration
 	leaderElectionRetryPeriod   time.Duration
+	gkeNotificationSubscription string
+	gkeNotificationPullInterval time.Duration
 )
 
 // Add RBAC for the authorized diagnostics endpoint.
@@ -221,6 +223,14 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) error {
 		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: gcpMachinePoolConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 			return fmt.Errorf("creating GCPMachinePool controller: %w", err)
 		}
+
+		if err := (&expcontrollers.GCPMachinePoolMachineReconciler{
+			Client:           mgr.GetClient(),
+			Recorder:         mgr.GetEventRecorderFor("gcpmachinepoolmachine-controller"),
+			WatchFilterValue: watchFilterValue,
+		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: gcpMachinePoolConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
+			return fmt.Errorf("creating GCPMachinePoolMachine controller: %w", err)
+		}
 	}
 
 	if feature.Gates.Enabled(feature.GKE) {
@@ -259,6 +269,16 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) error {
 				return fmt.Errorf("setting up GKEConfig controller: %w", err)
 			}
 		}
+
+		if gkeNotificationSubscription != "" {
+			if err := (&expcontrollers.GKENotificationSubscriber{
+				Client:       mgr.GetClient(),
+				Subscription: gkeNotificationSubscription,
+				PullInterval: gkeNotificationPullInterval,
+			}).SetupWithManager(ctx, mgr); err != nil {
+				return fmt.Errorf("setting up GKE notification subscriber: %w", err)
+			}
+		}
 	}
 
 	return nil
@@ -284,6 +304,9 @@ func setupWebhooks(mgr ctrl.Manager) error {
 		if err := (&expwebhooks.GCPMachinePool{}).SetupWebhookWithManager(mgr); err != nil {
 			return fmt.Errorf("creating GCPMachinePool webhook: %w", err)
 		}
+		if err := (&expwebhooks.GCPMachinePoolTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+			return fmt.Errorf("creating GCPMachinePoolTemplate webhook: %w", err)
+		}
 	}
 
 	if feature.Gates.Enabled(feature.GKE) {
@@ -429,6 +452,18 @@ func initFlags(fs *pflag.FlagSet) {
 		"The maximum duration a reconcile loop can run (e.g. 90m)",
 	)
 
+	fs.StringVar(&gkeNotificationSubscription,
+		"gke-notifications-subscription",
+		"",
+		"Pub/Sub subscription to the topic of the notifications of the GKE clusters, in the format projects/{project}/subscriptions/{subscription}. If unspecified, the notifications aren't reported on the GCPManagedControlPlanes.",
+	)
+
+	fs.DurationVar(&gkeNotificationPullInterval,
+		"gke-notifications-pull-interval",
+		expcontrollers.DefaultGKENotificationPullInterval,
+		"The interval at which the GKE notifications subscription is pulled when it has no messages (e.g. 30s)",
+	)
+
 	flags.AddManagerOptions(fs, &managerOptions)
 
 	feature.MutableGates.AddFlag(fs)
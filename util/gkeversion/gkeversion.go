@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gkeversion implements GKE version parsing and matching utilities.
+// Examples of GKE versions:
+// 1.30.5-gke.1014000 (GKE version).
+// 1.30.5 (Kubernetes version, any GKE version of the patch).
+// 1.30 (minor version, any patch of the minor).
+// latest (the latest available version).
+package gkeversion
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/pkg/errors"
+	"golang.org/x/mod/semver"
+)
+
+// Latest is the alias of the latest available version.
+const Latest = "latest"
+
+var versionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)(\.\d+(-gke\.\d+)?)?$`)
+
+// Normalize removes the "v" prefix of Kubernetes versions.
+func Normalize(version string) string {
+	return strings.TrimPrefix(version, "v")
+}
+
+// Validate returns an error if the version is neither a GKE version, a Kubernetes version, a minor version nor the
+// latest alias.
+func Validate(version string) error {
+	if version == Latest || versionRegexp.MatchString(Normalize(version)) {
+		return nil
+	}
+	return errors.Errorf("invalid version %q: must be a version like 1.30.5-gke.1014000, 1.30.5 or 1.30, or %q", version, Latest)
+}
+
+// Matches returns whether the GKE version matches the version or alias. GKE versions match the versions of the same
+// Kubernetes patch regardless of the GKE build, which GKE upgrades automatically. The latest alias doesn't match any
+// version, as it has to be resolved first.
+func Matches(alias, version string) bool {
+	alias, version = Normalize(alias), Normalize(version)
+	if alias == Latest {
+		return false
+	}
+	alias = strings.Split(alias, "-")[0]
+	version = strings.Split(version, "-")[0]
+	if strings.Count(alias, ".") == 1 {
+		return strings.HasPrefix(version, alias+".")
+	}
+	return version == alias
+}
+
+// Resolve returns the version of versions that the version or alias refers to: the version itself if it is listed,
+// otherwise the highest matching version. It returns false if no version matches.
+func Resolve(alias string, versions []string) (string, bool) {
+	alias = Normalize(alias)
+	var resolved string
+	for _, version := range versions {
+		if version == alias {
+			return version, true
+		}
+		if (alias == Latest || Matches(alias, version)) && (resolved == "" || Compare(version, resolved) > 0) {
+			resolved = version
+		}
+	}
+
+	return resolved, resolved != ""
+}
+
+// UpgradeTargets returns the versions of versions that a control plane at the current version can be upgraded to:
+// the higher versions of the same or of the next minor version.
+func UpgradeTargets(current string, versions []string) []string {
+	var targets []string
+	for _, version := range versions {
+		if Compare(version, current) > 0 && MinorSkew(version, current) <= 1 {
+			targets = append(targets, version)
+		}
+	}
+
+	return targets
+}
+
+// ValidMasterVersions returns the control plane versions of the server config that are available in the release
+// channel, or without a release channel.
+func ValidMasterVersions(config *containerpb.ServerConfig, channel containerpb.ReleaseChannel_Channel) []string {
+	if versions, ok := channelVersions(config, channel); ok {
+		return versions
+	}
+	return config.GetValidMasterVersions()
+}
+
+// ValidNodeVersions returns the node versions of the server config that are available in the release channel, or
+// without a release channel.
+func ValidNodeVersions(config *containerpb.ServerConfig, channel containerpb.ReleaseChannel_Channel) []string {
+	if versions, ok := channelVersions(config, channel); ok {
+		return versions
+	}
+	return config.GetValidNodeVersions()
+}
+
+// ReleaseChannel returns the release channel of the spec, or the one of the existing cluster when unspecified, so
+// that the versions of an existing cluster are looked up in the release channel it is enrolled in.
+func ReleaseChannel(channel containerpb.ReleaseChannel_Channel, existingCluster *containerpb.Cluster) containerpb.ReleaseChannel_Channel {
+	if channel != containerpb.ReleaseChannel_UNSPECIFIED {
+		return channel
+	}
+	return existingCluster.GetReleaseChannel().GetChannel()
+}
+
+func channelVersions(config *containerpb.ServerConfig, channel containerpb.ReleaseChannel_Channel) ([]string, bool) {
+	if channel == containerpb.ReleaseChannel_UNSPECIFIED {
+		return nil, false
+	}
+	for _, channelConfig := range config.GetChannels() {
+		if channelConfig.GetChannel() == channel {
+			return channelConfig.GetValidVersions(), true
+		}
+	}
+	return nil, false
+}
+
+// Compare returns -1, 0 or 1 whether the GKE version a is lower, equal or higher than b.
+func Compare(a, b string) int {
+	return semver.Compare("v"+Normalize(a), "v"+Normalize(b))
+}
+
+// MinorSkew returns by how many minor versions the GKE version a is ahead of b.
+func MinorSkew(a, b string) int {
+	return minor(a) - minor(b)
+}
+
+func minor(version string) int {
+	match := versionRegexp.FindStringSubmatch(Normalize(version))
+	if match == nil {
+		return 0
+	}
+	minor, _ := strconv.Atoi(match[2])
+	return minor
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gkeversion
+
+import (
+	"slices"
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+)
+
+var versions = []string{
+	"1.31.1-gke.1678000",
+	"1.30.5-gke.1014000",
+	"1.30.5-gke.1355000",
+	"1.30.4-gke.1348000",
+	"1.29.9-gke.1177000",
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		version string
+		valid   bool
+	}{
+		{version: "1.30.5-gke.1014000", valid: true},
+		{version: "v1.30.5", valid: true},
+		{version: "1.30", valid: true},
+		{version: "latest", valid: true},
+		{version: "1", valid: false},
+		{version: "1.30.x", valid: false},
+		{version: "1.30.5-gke", valid: false},
+		{version: "stable", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if err := Validate(tt.version); (err == nil) != tt.valid {
+				t.Errorf("Validate(%q) = %v, want valid %v", tt.version, err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		alias    string
+		version  string
+		expected bool
+	}{
+		{alias: "1.30", version: "1.30.5-gke.1014000", expected: true},
+		{alias: "1.3", version: "1.30.5-gke.1014000", expected: false},
+		{alias: "v1.30.5", version: "1.30.5-gke.1014000", expected: true},
+		{alias: "1.30.5-gke.1355000", version: "1.30.5-gke.1014000", expected: true},
+		{alias: "1.30.4", version: "1.30.5-gke.1014000", expected: false},
+		{alias: "latest", version: "1.31.1-gke.1678000", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alias+"/"+tt.version, func(t *testing.T) {
+			if got := Matches(tt.alias, tt.version); got != tt.expected {
+				t.Errorf("Matches(%q, %q) = %v, want %v", tt.alias, tt.version, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		alias    string
+		expected string
+		found    bool
+	}{
+		{alias: "latest", expected: "1.31.1-gke.1678000", found: true},
+		{alias: "1.30", expected: "1.30.5-gke.1355000", found: true},
+		{alias: "v1.30.4", expected: "1.30.4-gke.1348000", found: true},
+		{alias: "1.30.5-gke.1014000", expected: "1.30.5-gke.1014000", found: true},
+		{alias: "1.28", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alias, func(t *testing.T) {
+			got, found := Resolve(tt.alias, versions)
+			if got != tt.expected || found != tt.found {
+				t.Errorf("Resolve(%q) = %q, %v, want %q, %v", tt.alias, got, found, tt.expected, tt.found)
+			}
+		})
+	}
+}
+
+func TestMinorSkew(t *testing.T) {
+	if got := MinorSkew("1.31.1-gke.1678000", "v1.29.9"); got != 2 {
+		t.Errorf("MinorSkew() = %d, want 2", got)
+	}
+}
+
+func TestUpgradeTargets(t *testing.T) {
+	got := UpgradeTargets("1.30.4-gke.1348000", versions)
+	expected := []string{"1.31.1-gke.1678000", "1.30.5-gke.1014000", "1.30.5-gke.1355000"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("UpgradeTargets() = %v, want %v", got, expected)
+	}
+	if got := UpgradeTargets("1.29.9-gke.1177000", versions); !slices.Equal(got, []string{"1.30.5-gke.1014000", "1.30.5-gke.1355000", "1.30.4-gke.1348000"}) {
+		t.Errorf("UpgradeTargets() = %v, should skip the versions more than one minor version ahead", got)
+	}
+}
+
+func TestValidMasterVersions(t *testing.T) {
+	config := &containerpb.ServerConfig{
+		ValidMasterVersions: versions,
+		Channels: []*containerpb.ServerConfig_ReleaseChannelConfig{
+			{Channel: containerpb.ReleaseChannel_STABLE, ValidVersions: []string{"1.29.9-gke.1177000"}},
+		},
+	}
+
+	if got := ValidMasterVersions(config, containerpb.ReleaseChannel_UNSPECIFIED); !slices.Equal(got, versions) {
+		t.Errorf("ValidMasterVersions() without release channel = %v, want %v", got, versions)
+	}
+	if got := ValidMasterVersions(config, containerpb.ReleaseChannel_STABLE); !slices.Equal(got, []string{"1.29.9-gke.1177000"}) {
+		t.Errorf("ValidMasterVersions() in the stable channel = %v, want the versions of the channel", got)
+	}
+}
+
+func TestReleaseChannel(t *testing.T) {
+	cluster := &containerpb.Cluster{ReleaseChannel: &containerpb.ReleaseChannel{Channel: containerpb.ReleaseChannel_REGULAR}}
+
+	if got := ReleaseChannel(containerpb.ReleaseChannel_STABLE, cluster); got != containerpb.ReleaseChannel_STABLE {
+		t.Errorf("ReleaseChannel() = %s, want the channel of the spec", got)
+	}
+	if got := ReleaseChannel(containerpb.ReleaseChannel_UNSPECIFIED, cluster); got != containerpb.ReleaseChannel_REGULAR {
+		t.Errorf("ReleaseChannel() = %s, want the channel of the existing cluster", got)
+	}
+	if got := ReleaseChannel(containerpb.ReleaseChannel_UNSPECIFIED, nil); got != containerpb.ReleaseChannel_UNSPECIFIED {
+		t.Errorf("ReleaseChannel() = %s, want no channel for a new cluster", got)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/providerid"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -73,6 +74,9 @@ func (*GCPMachine) ValidateCreate(_ context.Context, obj runtime.Object) (admiss
 	if err := validateConfidentialCompute(m.Spec); err != nil {
 		return nil, err
 	}
+	if err := validateProviderID(m.Spec); err != nil {
+		return nil, err
+	}
 	return nil, validateCustomerEncryptionKey(m.Spec)
 }
 
@@ -192,3 +196,14 @@ func validateCustomerEncryptionKey(spec infrav1.GCPMachineSpec) error {
 	}
 	return nil
 }
+
+// validateProviderID checks that a providerID set at creation, used to adopt an existing instance, is well formed.
+func validateProviderID(spec infrav1.GCPMachineSpec) error {
+	if spec.ProviderID == nil || *spec.ProviderID == "" {
+		return nil
+	}
+	if _, err := providerid.Parse(*spec.ProviderID); err != nil {
+		return field.Invalid(field.NewPath("spec", "providerID"), *spec.ProviderID, err.Error())
+	}
+	return nil
+}
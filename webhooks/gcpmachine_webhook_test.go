@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 )
 
@@ -269,6 +270,24 @@ func TestGCPMachine_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "GCPMachine with a valid ProviderID",
+			GCPMachine: &infrav1.GCPMachine{
+				Spec: infrav1.GCPMachineSpec{
+					ProviderID: ptr.To[string]("gce://my-proj/us-central1-c/my-instance"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachine with a malformed ProviderID",
+			GCPMachine: &infrav1.GCPMachine{
+				Spec: infrav1.GCPMachineSpec{
+					ProviderID: ptr.To[string]("gce://my-proj/my-instance"),
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {